@@ -14,15 +14,173 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for BudgetPeriod.
+const (
+	BudgetPeriodMonth   BudgetPeriod = "month"
+	BudgetPeriodQuarter BudgetPeriod = "quarter"
+	BudgetPeriodWeek    BudgetPeriod = "week"
+)
+
+// Defines values for CampaignKind.
+const (
+	CampaignKindFixed   CampaignKind = "fixed"
+	CampaignKindPercent CampaignKind = "percent"
+)
+
+// Defines values for LeaderboardKind.
+const (
+	LeaderboardKindReceivers  LeaderboardKind = "receivers"
+	LeaderboardKindRecipients LeaderboardKind = "recipients"
+	LeaderboardKindSenders    LeaderboardKind = "senders"
+	LeaderboardKindSpenders   LeaderboardKind = "spenders"
+)
+
+// Defines values for PaymentRequestStatus.
+const (
+	PaymentRequestStatusAccepted  PaymentRequestStatus = "accepted"
+	PaymentRequestStatusApproving PaymentRequestStatus = "approving"
+	PaymentRequestStatusDeclined  PaymentRequestStatus = "declined"
+	PaymentRequestStatusExpired   PaymentRequestStatus = "expired"
+	PaymentRequestStatusPending   PaymentRequestStatus = "pending"
+)
+
+// Defines values for PromoCodeKind.
+const (
+	PromoCodeKindCoins   PromoCodeKind = "coins"
+	PromoCodeKindFixed   PromoCodeKind = "fixed"
+	PromoCodeKindPercent PromoCodeKind = "percent"
+)
+
+// Defines values for ScheduledTransferStatus.
+const (
+	ScheduledTransferStatusActive    ScheduledTransferStatus = "active"
+	ScheduledTransferStatusCancelled ScheduledTransferStatus = "cancelled"
+	ScheduledTransferStatusCompleted ScheduledTransferStatus = "completed"
+	ScheduledTransferStatusFailed    ScheduledTransferStatus = "failed"
+)
+
+// Defines values for TeamHistoryKind.
+const (
+	TeamHistoryKindFund TeamHistoryKind = "fund"
+	TeamHistoryKindSend TeamHistoryKind = "send"
+)
+
+// Defines values for TeamRole.
+const (
+	TeamRoleManager TeamRole = "manager"
+	TeamRoleMember  TeamRole = "member"
+)
+
+// Defines values for TransferStatus.
+const (
+	TransferStatusCompleted TransferStatus = "completed"
+	TransferStatusExpired   TransferStatus = "expired"
+	TransferStatusPending   TransferStatus = "pending"
+	TransferStatusRejected  TransferStatus = "rejected"
+)
+
+// Defines values for WebhookEventKind.
+const (
+	WebhookEventKindAchievementBadgeAwarded WebhookEventKind = "achievement.badge_awarded"
+	WebhookEventKindPurchaseBought          WebhookEventKind = "purchase.bought"
+	WebhookEventKindPurchaseGiftReceived    WebhookEventKind = "purchase.gift_received"
+	WebhookEventKindTransferReceived        WebhookEventKind = "transfer.received"
+	WebhookEventKindTransferSent            WebhookEventKind = "transfer.sent"
+)
+
+// Defines values for GetAPILeaderboardsKindParamsPeriod.
+const (
+	GetAPILeaderboardsKindParamsPeriodAll     GetAPILeaderboardsKindParamsPeriod = "all"
+	GetAPILeaderboardsKindParamsPeriodMonth   GetAPILeaderboardsKindParamsPeriod = "month"
+	GetAPILeaderboardsKindParamsPeriodQuarter GetAPILeaderboardsKindParamsPeriod = "quarter"
+	GetAPILeaderboardsKindParamsPeriodWeek    GetAPILeaderboardsKindParamsPeriod = "week"
+	GetAPILeaderboardsKindParamsPeriodYear    GetAPILeaderboardsKindParamsPeriod = "year"
+)
+
+// AddWishlistItemRequest defines model for AddWishlistItemRequest.
+type AddWishlistItemRequest struct {
+	// Item Тип предмета.
+	Item string `json:"item"`
+}
+
+// Auction defines model for Auction.
+type Auction struct {
+	// BidCount Количество ставок.
+	BidCount *int `json:"bidCount,omitempty"`
+
+	// ClosedAt Время закрытия или отмены аукциона.
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// CreatedAt Время создания аукциона.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// EndsAt Время завершения аукциона.
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// HighestBid Наибольшая ставка.
+	HighestBid *int `json:"highestBid,omitempty"`
+
+	// HighestBidder Имя пользователя, сделавшего наибольшую ставку.
+	HighestBidder *string `json:"highestBidder,omitempty"`
+
+	// ID Идентификатор аукциона.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Item Тип предмета.
+	Item *string `json:"item,omitempty"`
+
+	// MinBid Минимальная сумма следующей ставки.
+	MinBid *int `json:"minBid,omitempty"`
+
+	// MinIncrement Минимальный шаг ставки.
+	MinIncrement *int `json:"minIncrement,omitempty"`
+
+	// StartingPrice Начальная цена.
+	StartingPrice *int `json:"startingPrice,omitempty"`
+
+	// Status Статус аукциона, например, open, closed или cancelled.
+	Status *string `json:"status,omitempty"`
+}
+
+// AuctionBid defines model for AuctionBid.
+type AuctionBid struct {
+	// Amount Сумма ставки.
+	Amount *int `json:"amount,omitempty"`
+
+	// CreatedAt Время ставки.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// ID Идентификатор ставки.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Status Статус ставки, например, held, refunded или won.
+	Status *string `json:"status,omitempty"`
+
+	// User Имя пользователя, сделавшего ставку.
+	User *string `json:"user,omitempty"`
+}
+
+// AuctionBidsResponse defines model for AuctionBidsResponse.
+type AuctionBidsResponse struct {
+	Bids *[]AuctionBid `json:"bids,omitempty"`
+}
+
+// AuctionsResponse defines model for AuctionsResponse.
+type AuctionsResponse struct {
+	Auctions *[]Auction `json:"auctions,omitempty"`
+}
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	// Password Пароль для аутентификации.
@@ -38,12 +196,357 @@ type AuthResponse struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// Badge defines model for Badge.
+type Badge struct {
+	// AwardedAt Время получения значка.
+	AwardedAt *time.Time `json:"awardedAt,omitempty"`
+
+	// Bonus Количество бонусных монет, начисленных вместе со значком.
+	Bonus *int `json:"bonus,omitempty"`
+
+	// Description За что получен значок.
+	Description *string `json:"description,omitempty"`
+
+	// Name Идентификатор значка, например, first_transfer.
+	Name *string `json:"name,omitempty"`
+
+	// Title Название значка.
+	Title *string `json:"title,omitempty"`
+}
+
+// BatchSendCoinItem defines model for BatchSendCoinItem.
+type BatchSendCoinItem struct {
+	// Amount Количество монет, которые необходимо отправить.
+	Amount int `json:"amount"`
+
+	// Message Сообщение получателю.
+	Message *string `json:"message,omitempty"`
+
+	// ToUser Имя пользователя, которому нужно отправить монеты.
+	ToUser string `json:"toUser"`
+}
+
+// BatchSendCoinRequest defines model for BatchSendCoinRequest.
+type BatchSendCoinRequest struct {
+	Transfers []BatchSendCoinItem `json:"transfers"`
+}
+
+// BatchSendCoinResponse defines model for BatchSendCoinResponse.
+type BatchSendCoinResponse struct {
+	// Errors Сообщение об ошибке, описывающее проблему.
+	Errors *string `json:"errors,omitempty"`
+
+	// Results Результаты переводов в порядке запроса.
+	Results *[]BatchSendCoinResult `json:"results,omitempty"`
+}
+
+// BatchSendCoinResult defines model for BatchSendCoinResult.
+type BatchSendCoinResult struct {
+	// Amount Количество монет.
+	Amount *int `json:"amount,omitempty"`
+
+	// Error Сообщение об ошибке, если перевод невозможен.
+	Error *string `json:"error,omitempty"`
+
+	// Status Статус перевода.
+	Status *TransferStatus `json:"status,omitempty"`
+
+	// ToUser Имя пользователя, которому отправлены монеты.
+	ToUser *string `json:"toUser,omitempty"`
+}
+
+// BudgetPeriod Период, по истечении которого бюджет на награды восстанавливается.
+type BudgetPeriod string
+
+// BuyRaffleTicketsRequest defines model for BuyRaffleTicketsRequest.
+type BuyRaffleTicketsRequest struct {
+	// Count Количество билетов. По умолчанию 1.
+	Count *int `json:"count,omitempty"`
+}
+
+// Campaign defines model for Campaign.
+type Campaign struct {
+	// Category Категория предметов, на которые действует скидка. Отсутствует у акции на предмет.
+	Category *string `json:"category,omitempty"`
+
+	// EndsAt Время окончания скидочной акции.
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// ID Идентификатор скидочной акции.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Item Тип предмета, на который действует скидка. Отсутствует у акции на категорию.
+	Item *string `json:"item,omitempty"`
+
+	// Kind Тип скидки. percent — скидка в процентах, fixed — скидка в монетах.
+	Kind *CampaignKind `json:"kind,omitempty"`
+
+	// Name Название скидочной акции.
+	Name *string `json:"name,omitempty"`
+
+	// StartsAt Время начала скидочной акции.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+
+	// Value Размер скидки в процентах или монетах.
+	Value *int `json:"value,omitempty"`
+}
+
+// CampaignKind Тип скидки. percent — скидка в процентах, fixed — скидка в монетах.
+type CampaignKind string
+
+// CampaignsResponse defines model for CampaignsResponse.
+type CampaignsResponse struct {
+	Campaigns *[]Campaign `json:"campaigns,omitempty"`
+}
+
+// CatalogItem defines model for CatalogItem.
+type CatalogItem struct {
+	// Category Категория предмета.
+	Category *string `json:"category,omitempty"`
+
+	// Description Описание предмета.
+	Description *string `json:"description,omitempty"`
+
+	// ImageURL Адрес изображения предмета.
+	ImageURL *string `json:"imageUrl,omitempty"`
+
+	// Name Тип предмета.
+	Name *string `json:"name,omitempty"`
+
+	// Price Цена предмета.
+	Price *int `json:"price,omitempty"`
+
+	// PurchaseLimit Ограничение на количество предметов, которые пользователь может купить себе или получить в подарок.
+	PurchaseLimit *PurchaseLimit `json:"purchaseLimit,omitempty"`
+
+	// RemainingPurchases Сколько еще предметов можно получить текущему пользователю. Отсутствует, если ограничения нет.
+	RemainingPurchases *int `json:"remainingPurchases,omitempty"`
+
+	// SalePrice Цена предмета с учетом действующей скидочной акции. Отсутствует, если скидки нет.
+	SalePrice *int `json:"salePrice,omitempty"`
+}
+
+// CreateAuctionRequest defines model for CreateAuctionRequest.
+type CreateAuctionRequest struct {
+	// EndsAt Время завершения аукциона.
+	EndsAt time.Time `json:"endsAt"`
+
+	// Item Тип предмета. Предмет не должен иметь вариантов.
+	Item string `json:"item"`
+
+	// MinIncrement Минимальный шаг ставки. По умолчанию 1.
+	MinIncrement *int `json:"minIncrement,omitempty"`
+
+	// StartingPrice Начальная цена.
+	StartingPrice int `json:"startingPrice"`
+}
+
+// CreateCampaignRequest defines model for CreateCampaignRequest.
+type CreateCampaignRequest struct {
+	// Category Категория предметов, на которые действует скидка.
+	Category *string `json:"category,omitempty"`
+
+	// EndsAt Время окончания скидочной акции.
+	EndsAt time.Time `json:"endsAt"`
+
+	// Item Тип предмета, на который действует скидка. Указывается либо предмет, либо категория.
+	Item *string `json:"item,omitempty"`
+
+	// Kind Тип скидки. percent — скидка в процентах, fixed — скидка в монетах.
+	Kind CampaignKind `json:"kind"`
+
+	// Name Название скидочной акции.
+	Name string `json:"name"`
+
+	// StartsAt Время начала скидочной акции. По умолчанию — текущее время.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+
+	// Value Размер скидки в процентах (от 1 до 100) или монетах.
+	Value int `json:"value"`
+}
+
+// CreateItemVariantRequest defines model for CreateItemVariantRequest.
+type CreateItemVariantRequest struct {
+	// Color Цвет.
+	Color *string `json:"color,omitempty"`
+
+	// Price Цена варианта. Если не указана, используется цена предмета.
+	Price *int `json:"price,omitempty"`
+
+	// Size Размер.
+	Size *string `json:"size,omitempty"`
+
+	// Stock Количество предметов в наличии.
+	Stock int `json:"stock"`
+}
+
+// CreatePaymentRequestRequest defines model for CreatePaymentRequestRequest.
+type CreatePaymentRequestRequest struct {
+	// Amount Количество монет.
+	Amount int `json:"amount"`
+
+	// FromUser Имя пользователя, у которого нужно запросить монеты.
+	FromUser string `json:"fromUser"`
+
+	// Note Комментарий к запросу, например, на что собираются монеты.
+	Note *string `json:"note,omitempty"`
+}
+
+// CreatePromoCodeRequest defines model for CreatePromoCodeRequest.
+type CreatePromoCodeRequest struct {
+	// Code Промокод из латинских букв, цифр, "_" и "-" длиной от 3 до 32 символов. Регистр не учитывается.
+	Code string `json:"code"`
+
+	// ExpiresAt Время, после которого промокод недействителен.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Kind Тип промокода. coins — начисление монет, percent — скидка на покупку в процентах, fixed — скидка на покупку в монетах.
+	Kind PromoCodeKind `json:"kind"`
+
+	// MaxRedemptions Максимальное количество активаций. По умолчанию не ограничено.
+	MaxRedemptions *int `json:"maxRedemptions,omitempty"`
+
+	// NewUserDays Ограничить промокод пользователями, зарегистрированными не раньше, чем столько дней назад.
+	NewUserDays *int `json:"newUserDays,omitempty"`
+
+	// Value Количество монет или размер скидки.
+	Value int `json:"value"`
+}
+
+// CreateRaffleRequest defines model for CreateRaffleRequest.
+type CreateRaffleRequest struct {
+	// DrawsAt Время розыгрыша.
+	DrawsAt time.Time `json:"drawsAt"`
+
+	// Item Тип разыгрываемого предмета. Предмет не должен иметь вариантов.
+	Item string `json:"item"`
+
+	// MaxTicketsPerUser Максимальное количество билетов у одного пользователя.
+	MaxTicketsPerUser *int `json:"maxTicketsPerUser,omitempty"`
+
+	// Name Название розыгрыша.
+	Name string `json:"name"`
+
+	// TicketPrice Цена билета в монетах.
+	TicketPrice int `json:"ticketPrice"`
+
+	// WinnerCount Количество призов. По умолчанию 1.
+	WinnerCount *int `json:"winnerCount,omitempty"`
+}
+
+// CreateScheduledTransferRequest defines model for CreateScheduledTransferRequest.
+type CreateScheduledTransferRequest struct {
+	// Amount Количество монет в каждом переводе.
+	Amount int `json:"amount"`
+
+	// Cron Расписание повторяющегося перевода в формате cron (UTC), например, "0 9 * * 1" или "@monthly".
+	Cron *string `json:"cron,omitempty"`
+
+	// RunAt Время разового перевода. Для повторяющегося перевода — время, до которого переводы не начнутся.
+	RunAt *time.Time `json:"runAt,omitempty"`
+
+	// ToUser Имя пользователя, которому нужно переводить монеты.
+	ToUser string `json:"toUser"`
+}
+
+// CreateTeamRequest defines model for CreateTeamRequest.
+type CreateTeamRequest struct {
+	// Name Название команды.
+	Name string `json:"name"`
+}
+
+// CreateWebhookSubscriptionRequest defines model for CreateWebhookSubscriptionRequest.
+type CreateWebhookSubscriptionRequest struct {
+	EventKinds []WebhookEventKind `json:"eventKinds"`
+
+	// Secret Ключ подписи. По умолчанию генерируется.
+	Secret *string `json:"secret,omitempty"`
+
+	// URL Абсолютный URL со схемой http или https. Хост должен указывать на публичный адрес, внутренние адреса (loopback, частные, link-local) отклоняются.
+	URL string `json:"url"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	// Errors Сообщение об ошибке, описывающее проблему.
 	Errors *string `json:"errors,omitempty"`
 }
 
+// FundTeamRequest defines model for FundTeamRequest.
+type FundTeamRequest struct {
+	// Amount Количество монет, на которое нужно пополнить фонд.
+	Amount int `json:"amount"`
+}
+
+// Gift defines model for Gift.
+type Gift struct {
+	// Amount Количество потраченных монет.
+	Amount *int `json:"amount,omitempty"`
+
+	// Color Цвет варианта предмета.
+	Color *string `json:"color,omitempty"`
+
+	// CreatedAt Время покупки подарка.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// FromDisplayName Отображаемое имя пользователя, который купил подарок.
+	FromDisplayName *string `json:"fromDisplayName,omitempty"`
+
+	// FromUser Имя пользователя, который купил подарок.
+	FromUser *string `json:"fromUser,omitempty"`
+
+	// Item Тип подаренного предмета.
+	Item *string `json:"item,omitempty"`
+
+	// ListPrice Цена предмета без скидки.
+	ListPrice *int `json:"listPrice,omitempty"`
+
+	// Note Комментарий к подарку.
+	Note *string `json:"note,omitempty"`
+
+	// Size Размер варианта предмета.
+	Size *string `json:"size,omitempty"`
+
+	// ToDisplayName Отображаемое имя пользователя, который получил подарок.
+	ToDisplayName *string `json:"toDisplayName,omitempty"`
+
+	// ToUser Имя пользователя, который получил подарок.
+	ToUser *string `json:"toUser,omitempty"`
+}
+
+// GiftItemRequest defines model for GiftItemRequest.
+type GiftItemRequest struct {
+	// Color Цвет варианта предмета.
+	Color *string `json:"color,omitempty"`
+
+	// Note Комментарий к подарку.
+	Note *string `json:"note,omitempty"`
+
+	// PromoCode Промокод на скидку.
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Size Размер варианта предмета.
+	Size *string `json:"size,omitempty"`
+
+	// ToUser Имя пользователя, которому дарится предмет.
+	ToUser string `json:"toUser"`
+}
+
+// GivingBudget Бюджет на награды, который можно только отправить другим пользователям, но не потратить на покупки.
+type GivingBudget struct {
+	// Allowance Количество монет, выделяемых на период.
+	Allowance *int `json:"allowance,omitempty"`
+
+	// Period Период, по истечении которого бюджет на награды восстанавливается.
+	Period *BudgetPeriod `json:"period,omitempty"`
+
+	// Remaining Количество монет, оставшихся в текущем периоде.
+	Remaining *int `json:"remaining,omitempty"`
+
+	// ResetsAt Время, когда бюджет восстановится.
+	ResetsAt *time.Time `json:"resetsAt,omitempty"`
+}
+
 // HealthResponse defines model for HealthResponse.
 type HealthResponse struct {
 	// Status Статус сервиса.
@@ -57,1360 +560,22910 @@ type InfoResponse struct {
 			// Amount Количество полученных монет.
 			Amount *int `json:"amount,omitempty"`
 
+			// Badge Идентификатор значка, вместе с которым начислены бонусные монеты. Задан только для бонусов, у них нет отправителя.
+			Badge *string `json:"badge,omitempty"`
+
+			// FromBudget Монеты отправлены из бюджета на награды, а не из баланса отправителя.
+			FromBudget *bool `json:"fromBudget,omitempty"`
+
+			// FromDisplayName Отображаемое имя пользователя, который отправил монеты.
+			FromDisplayName *string `json:"fromDisplayName,omitempty"`
+
+			// FromTeam Команда, из фонда которой отправлены монеты, а не из баланса отправителя.
+			FromTeam *string `json:"fromTeam,omitempty"`
+
 			// FromUser Имя пользователя, который отправил монеты.
 			FromUser *string `json:"fromUser,omitempty"`
+
+			// Message Сообщение отправителя.
+			Message *string `json:"message,omitempty"`
+
+			// Status Статус перевода.
+			Status *TransferStatus `json:"status,omitempty"`
 		} `json:"received,omitempty"`
 		Sent *[]struct {
 			// Amount Количество отправленных монет.
 			Amount *int `json:"amount,omitempty"`
 
+			// FromBudget Монеты отправлены из бюджета на награды, а не из баланса.
+			FromBudget *bool `json:"fromBudget,omitempty"`
+
+			// FromTeam Команда, из фонда которой отправлены монеты, а не из баланса.
+			FromTeam *string `json:"fromTeam,omitempty"`
+
+			// Message Сообщение получателю.
+			Message *string `json:"message,omitempty"`
+
+			// Status Статус перевода.
+			Status *TransferStatus `json:"status,omitempty"`
+
+			// ToDisplayName Отображаемое имя пользователя, которому отправлены монеты.
+			ToDisplayName *string `json:"toDisplayName,omitempty"`
+
 			// ToUser Имя пользователя, которому отправлены монеты.
 			ToUser *string `json:"toUser,omitempty"`
 		} `json:"sent,omitempty"`
 	} `json:"coinHistory,omitempty"`
 
 	// Coins Количество доступных монет.
-	Coins     *int `json:"coins,omitempty"`
-	Inventory *[]struct {
+	Coins       *int `json:"coins,omitempty"`
+	GiftHistory *struct {
+		// Received Подарки, полученные текущим пользователем.
+		Received *[]Gift `json:"received,omitempty"`
+
+		// Sent Подарки, купленные текущим пользователем другим пользователям.
+		Sent *[]Gift `json:"sent,omitempty"`
+	} `json:"giftHistory,omitempty"`
+
+	// GivingBudget Бюджет на награды, который можно только отправить другим пользователям, но не потратить на покупки.
+	GivingBudget *GivingBudget `json:"givingBudget,omitempty"`
+	Inventory    *[]struct {
+		// Color Цвет варианта предмета.
+		Color *string `json:"color,omitempty"`
+
 		// Quantity Количество предметов.
 		Quantity *int `json:"quantity,omitempty"`
 
+		// Size Размер варианта предмета.
+		Size *string `json:"size,omitempty"`
+
 		// Type Тип предмета.
 		Type *string `json:"type,omitempty"`
 	} `json:"inventory,omitempty"`
 }
 
-// SendCoinRequest defines model for SendCoinRequest.
-type SendCoinRequest struct {
-	// Amount Количество монет, которые необходимо отправить.
-	Amount int `json:"amount"`
+// ItemTransfer defines model for ItemTransfer.
+type ItemTransfer struct {
+	// Color Цвет варианта предмета.
+	Color *string `json:"color,omitempty"`
 
-	// ToUser Имя пользователя, которому нужно отправить монеты.
-	ToUser string `json:"toUser"`
+	// CreatedAt Время передачи.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// FromUser Имя пользователя, который передал предмет.
+	FromUser *string `json:"fromUser,omitempty"`
+
+	// Item Тип переданного предмета.
+	Item *string `json:"item,omitempty"`
+
+	// Size Размер варианта предмета.
+	Size *string `json:"size,omitempty"`
+
+	// ToUser Имя пользователя, который получил предмет.
+	ToUser *string `json:"toUser,omitempty"`
 }
 
-// PostAPIAuthJSONRequestBody defines body for PostAPIAuth for application/json ContentType.
-type PostAPIAuthJSONRequestBody = AuthRequest
+// ItemTransfersResponse defines model for ItemTransfersResponse.
+type ItemTransfersResponse struct {
+	// Received Предметы, полученные текущим пользователем.
+	Received *[]ItemTransfer `json:"received,omitempty"`
 
-// PostAPISendCoinJSONRequestBody defines body for PostAPISendCoin for application/json ContentType.
-type PostAPISendCoinJSONRequestBody = SendCoinRequest
+	// Sent Предметы, переданные текущим пользователем.
+	Sent *[]ItemTransfer `json:"sent,omitempty"`
+}
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// ItemVariant defines model for ItemVariant.
+type ItemVariant struct {
+	// Color Цвет.
+	Color *string `json:"color,omitempty"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// ID Идентификатор варианта.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Price Цена варианта с учетом переопределения.
+	Price *int `json:"price,omitempty"`
+
+	// Size Размер.
+	Size *string `json:"size,omitempty"`
+
+	// Stock Количество предметов в наличии.
+	Stock *int `json:"stock,omitempty"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// ItemVariantsResponse defines model for ItemVariantsResponse.
+type ItemVariantsResponse struct {
+	Variants *[]ItemVariant `json:"variants,omitempty"`
+}
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+// ItemsResponse defines model for ItemsResponse.
+type ItemsResponse struct {
+	Items *[]CatalogItem `json:"items,omitempty"`
+}
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+// KudosEntry defines model for KudosEntry.
+type KudosEntry struct {
+	// Amount Количество монет, если оба пользователя согласились его показывать.
+	Amount *int `json:"amount,omitempty"`
+
+	// CreatedAt Время перевода.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// FromDisplayName Отображаемое имя пользователя, который отправил монеты.
+	FromDisplayName *string `json:"fromDisplayName,omitempty"`
+
+	// FromUser Имя пользователя, который отправил монеты.
+	FromUser *string `json:"fromUser,omitempty"`
+
+	// ID Идентификатор перевода.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Message Сообщение получателю.
+	Message *string `json:"message,omitempty"`
+
+	// ToDisplayName Отображаемое имя пользователя, которому отправлены монеты.
+	ToDisplayName *string `json:"toDisplayName,omitempty"`
+
+	// ToUser Имя пользователя, которому отправлены монеты.
+	ToUser *string `json:"toUser,omitempty"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// KudosFeedResponse defines model for KudosFeedResponse.
+type KudosFeedResponse struct {
+	// Entries Записи от новых к старым.
+	Entries *[]KudosEntry `json:"entries,omitempty"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// NextBefore Значение параметра before для следующей страницы, если она может быть.
+	NextBefore *openapi_types.UUID `json:"nextBefore,omitempty"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
-}
+// KudosSettings defines model for KudosSettings.
+type KudosSettings struct {
+	// Public Показывать свои переводы с сообщениями в ленте благодарностей.
+	Public bool `json:"public"`
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+	// ShowAmounts Показывать в ленте количество монет.
+	ShowAmounts bool `json:"showAmounts"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// PostAPIAuthWithBody request with any body
-	PostAPIAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// LeaderboardEntry defines model for LeaderboardEntry.
+type LeaderboardEntry struct {
+	// DisplayName Отображаемое имя.
+	DisplayName *string `json:"displayName,omitempty"`
 
-	PostAPIAuth(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Rank Место в рейтинге. Пользователи с равными значениями делят место.
+	Rank *int `json:"rank,omitempty"`
 
-	// GetAPIBuyItem request
-	GetAPIBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Username Имя пользователя.
+	Username *string `json:"username,omitempty"`
 
-	// GetAPIHealth request
-	GetAPIHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Value Значение, по которому составлен рейтинг.
+	Value *int `json:"value,omitempty"`
+}
 
-	// GetAPIInfo request
-	GetAPIInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// LeaderboardKind Вид рейтинга — по отправленным монетам, полученным монетам, количеству разных получателей или потраченным на покупки монетам.
+type LeaderboardKind string
 
-	// PostAPISendCoinWithBody request with any body
-	PostAPISendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// LeaderboardResponse defines model for LeaderboardResponse.
+type LeaderboardResponse struct {
+	Entries *[]LeaderboardEntry `json:"entries,omitempty"`
 
-	PostAPISendCoin(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
-}
+	// From Начало периода, если он ограничен.
+	From *time.Time `json:"from,omitempty"`
 
-func (c *Client) PostAPIAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAPIAuthRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
+	// Kind Вид рейтинга — по отправленным монетам, полученным монетам, количеству разных получателей или потраченным на покупки монетам.
+	Kind *LeaderboardKind `json:"kind,omitempty"`
 
-func (c *Client) PostAPIAuth(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAPIAuthRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+	// To Конец периода, если он ограничен.
+	To *time.Time `json:"to,omitempty"`
 }
 
-func (c *Client) GetAPIBuyItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAPIBuyItemRequest(c.Server, item)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// Notification defines model for Notification.
+type Notification struct {
+	// CreatedAt Время создания уведомления.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// ID Идентификатор уведомления.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Item Тип предмета, к которому относится уведомление.
+	Item *string `json:"item,omitempty"`
+
+	// Kind Тип уведомления, например, price_drop или back_in_stock.
+	Kind *string `json:"kind,omitempty"`
+
+	// Message Текст уведомления.
+	Message *string `json:"message,omitempty"`
+
+	// Read Прочитано ли уведомление.
+	Read *bool `json:"read,omitempty"`
 }
 
-func (c *Client) GetAPIHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAPIHealthRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// NotificationsResponse defines model for NotificationsResponse.
+type NotificationsResponse struct {
+	Notifications *[]Notification `json:"notifications,omitempty"`
 }
 
-func (c *Client) GetAPIInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAPIInfoRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PaymentRequest defines model for PaymentRequest.
+type PaymentRequest struct {
+	// Amount Количество запрошенных монет.
+	Amount *int `json:"amount,omitempty"`
+
+	// CreatedAt Время создания запроса.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// ExpiresAt Время, после которого запрос истекает.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// FromUser Имя пользователя, у которого запрошены монеты.
+	FromUser *string `json:"fromUser,omitempty"`
+
+	// ID Идентификатор запроса.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Note Комментарий к запросу.
+	Note *string `json:"note,omitempty"`
+
+	// Status Статус запроса монет. approving — запрос принят, но перевод ожидает подтверждения.
+	Status *PaymentRequestStatus `json:"status,omitempty"`
+
+	// ToUser Имя пользователя, который запросил монеты.
+	ToUser *string `json:"toUser,omitempty"`
+
+	// TransferStatus Статус перевода.
+	TransferStatus *TransferStatus `json:"transferStatus,omitempty"`
 }
 
-func (c *Client) PostAPISendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAPISendCoinRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PaymentRequestStatus Статус запроса монет. approving — запрос принят, но перевод ожидает подтверждения.
+type PaymentRequestStatus string
+
+// PaymentRequestsResponse defines model for PaymentRequestsResponse.
+type PaymentRequestsResponse struct {
+	// Incoming Ожидающие запросы к текущему пользователю.
+	Incoming *[]PaymentRequest `json:"incoming,omitempty"`
+
+	// Outgoing Запросы, созданные текущим пользователем.
+	Outgoing *[]PaymentRequest `json:"outgoing,omitempty"`
 }
 
-func (c *Client) PostAPISendCoin(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAPISendCoinRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PendingTransfersResponse defines model for PendingTransfersResponse.
+type PendingTransfersResponse struct {
+	Transfers *[]Transfer `json:"transfers,omitempty"`
 }
 
-// NewPostAPIAuthRequest calls the generic PostAPIAuth builder with application/json body
-func NewPostAPIAuthRequest(server string, body PostAPIAuthJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAPIAuthRequestWithBody(server, "application/json", bodyReader)
+// PlaceBidRequest defines model for PlaceBidRequest.
+type PlaceBidRequest struct {
+	// Amount Сумма ставки.
+	Amount int `json:"amount"`
 }
 
-// NewPostAPIAuthRequestWithBody generates requests for PostAPIAuth with any type of body
-func NewPostAPIAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// Profile defines model for Profile.
+type Profile struct {
+	// AvatarURL Ссылка на аватар.
+	AvatarURL *string `json:"avatarUrl,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Badges Полученные значки. Не заполняется в результатах поиска.
+	Badges *[]Badge `json:"badges,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/auth")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Department Отдел.
+	Department *string `json:"department,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// DisplayName Отображаемое имя.
+	DisplayName *string `json:"displayName,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// StartDate Дата выхода на работу.
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// Title Должность.
+	Title *string `json:"title,omitempty"`
 
-	return req, nil
+	// Username Имя пользователя.
+	Username *string `json:"username,omitempty"`
 }
 
-// NewGetAPIBuyItemRequest generates requests for GetAPIBuyItem
-func NewGetAPIBuyItemRequest(server string, item string) (*http.Request, error) {
-	var err error
+// PromoCode defines model for PromoCode.
+type PromoCode struct {
+	// Code Промокод.
+	Code *string `json:"code,omitempty"`
 
-	var pathParam0 string
+	// CreatedAt Время создания промокода.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
-	if err != nil {
-		return nil, err
-	}
+	// ExpiresAt Время, после которого промокод недействителен.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Kind Тип промокода. coins — начисление монет, percent — скидка на покупку в процентах, fixed — скидка на покупку в монетах.
+	Kind *PromoCodeKind `json:"kind,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// MaxRedemptions Максимальное количество активаций. Отсутствует, если количество не ограничено.
+	MaxRedemptions *int `json:"maxRedemptions,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// NewUserDays Промокод доступен только пользователям, зарегистрированным не раньше, чем столько дней назад.
+	NewUserDays *int `json:"newUserDays,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// RedemptionCount Количество активаций.
+	RedemptionCount *int `json:"redemptionCount,omitempty"`
 
-	return req, nil
+	// Value Количество монет или размер скидки.
+	Value *int `json:"value,omitempty"`
 }
 
-// NewGetAPIHealthRequest generates requests for GetAPIHealth
-func NewGetAPIHealthRequest(server string) (*http.Request, error) {
-	var err error
+// PromoCodeKind Тип промокода. coins — начисление монет, percent — скидка на покупку в процентах, fixed — скидка на покупку в монетах.
+type PromoCodeKind string
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PromoCodeRedemption defines model for PromoCodeRedemption.
+type PromoCodeRedemption struct {
+	// Amount Количество начисленных монет или размер скидки в монетах.
+	Amount *int `json:"amount,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Code Промокод.
+	Code *string `json:"code,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// CreatedAt Время активации промокода.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Kind Тип промокода. coins — начисление монет, percent — скидка на покупку в процентах, fixed — скидка на покупку в монетах.
+	Kind *PromoCodeKind `json:"kind,omitempty"`
 
-	return req, nil
+	// User Имя пользователя, который активировал промокод.
+	User *string `json:"user,omitempty"`
 }
 
-// NewGetAPIInfoRequest generates requests for GetAPIInfo
-func NewGetAPIInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PromoCodeRedemptionsResponse defines model for PromoCodeRedemptionsResponse.
+type PromoCodeRedemptionsResponse struct {
+	Redemptions *[]PromoCodeRedemption `json:"redemptions,omitempty"`
+}
 
-	operationPath := fmt.Sprintf("/api/info")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PromoCodesResponse defines model for PromoCodesResponse.
+type PromoCodesResponse struct {
+	PromoCodes *[]PromoCode `json:"promoCodes,omitempty"`
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// PurchaseLimit Ограничение на количество предметов, которые пользователь может купить себе или получить в подарок.
+type PurchaseLimit struct {
+	// Item Тип предмета.
+	Item *string `json:"item,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// MaxCount Максимальное количество предметов.
+	MaxCount *int `json:"maxCount,omitempty"`
 
-	return req, nil
+	// PeriodDays Период ограничения в днях. Отсутствует, если ограничение бессрочное.
+	PeriodDays *int `json:"periodDays,omitempty"`
 }
 
-// NewPostAPISendCoinRequest calls the generic PostAPISendCoin builder with application/json body
-func NewPostAPISendCoinRequest(server string, body PostAPISendCoinJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAPISendCoinRequestWithBody(server, "application/json", bodyReader)
-}
+// Raffle defines model for Raffle.
+type Raffle struct {
+	// CreatedAt Время создания розыгрыша.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-// NewPostAPISendCoinRequestWithBody generates requests for PostAPISendCoin with any type of body
-func NewPostAPISendCoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// DrawnAt Время, когда были выбраны победители.
+	DrawnAt *time.Time `json:"drawnAt,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// DrawsAt Время розыгрыша.
+	DrawsAt *time.Time `json:"drawsAt,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/sendCoin")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// ID Идентификатор розыгрыша.
+	ID *openapi_types.UUID `json:"id,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Item Тип разыгрываемого предмета.
+	Item *string `json:"item,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// MaxTicketsPerUser Максимальное количество билетов у одного пользователя. Отсутствует, если не ограничено.
+	MaxTicketsPerUser *int `json:"maxTicketsPerUser,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// Name Название розыгрыша.
+	Name *string `json:"name,omitempty"`
 
-	return req, nil
+	// Seed Зерно в шестнадцатеричном виде. Отсутствует до розыгрыша. Билеты нумеруются с 0 в порядке покупки, k-й победитель (с 0) — это remaining[h mod len(remaining)], где remaining — еще не выигравшие номера по возрастанию, а h — первые 8 байт SHA-256(байты зерна || k как 4-байтовое big-endian число) как big-endian число.
+	Seed *string `json:"seed,omitempty"`
+
+	// SeedHash SHA-256 байт зерна в шестнадцатеричном виде.
+	SeedHash *string `json:"seedHash,omitempty"`
+
+	// Status Статус розыгрыша, например, open, drawn или cancelled.
+	Status *string `json:"status,omitempty"`
+
+	// TicketCount Количество проданных билетов.
+	TicketCount *int `json:"ticketCount,omitempty"`
+
+	// TicketPrice Цена билета в монетах.
+	TicketPrice *int `json:"ticketPrice,omitempty"`
+
+	// WinnerCount Количество призов.
+	WinnerCount *int `json:"winnerCount,omitempty"`
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
+// RaffleTicket defines model for RaffleTicket.
+type RaffleTicket struct {
+	// CreatedAt Время покупки билета.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Number Номер билета.
+	Number *int `json:"number,omitempty"`
+
+	// User Имя пользователя, купившего билет.
+	User *string `json:"user,omitempty"`
+
+	// Won Выиграл ли билет.
+	Won *bool `json:"won,omitempty"`
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+// RaffleTicketsResponse defines model for RaffleTicketsResponse.
+type RaffleTicketsResponse struct {
+	Tickets *[]RaffleTicket `json:"tickets,omitempty"`
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
+// RafflesResponse defines model for RafflesResponse.
+type RafflesResponse struct {
+	Raffles *[]Raffle `json:"raffles,omitempty"`
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+// RedeemPromoCodeRequest defines model for RedeemPromoCodeRequest.
+type RedeemPromoCodeRequest struct {
+	// Code Промокод.
+	Code string `json:"code"`
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostAPIAuthWithBodyWithResponse request with any body
-	PostAPIAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error)
+// Reservation defines model for Reservation.
+type Reservation struct {
+	// Amount Количество удержанных монет.
+	Amount *int `json:"amount,omitempty"`
 
-	PostAPIAuthWithResponse(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error)
+	// Color Цвет варианта предмета.
+	Color *string `json:"color,omitempty"`
 
-	// GetAPIBuyItemWithResponse request
-	GetAPIBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetAPIBuyItemResponse, error)
+	// CreatedAt Время создания резерва.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	// GetAPIHealthWithResponse request
-	GetAPIHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIHealthResponse, error)
+	// ExpiresAt Время, после которого резерв будет снят.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
 
-	// GetAPIInfoWithResponse request
-	GetAPIInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIInfoResponse, error)
+	// ID Идентификатор резерва.
+	ID *openapi_types.UUID `json:"id,omitempty"`
 
-	// PostAPISendCoinWithBodyWithResponse request with any body
-	PostAPISendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error)
+	// Item Тип зарезервированного предмета.
+	Item *string `json:"item,omitempty"`
 
-	PostAPISendCoinWithResponse(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error)
+	// ListPrice Цена предмета без скидки.
+	ListPrice *int `json:"listPrice,omitempty"`
+
+	// Size Размер варианта предмета.
+	Size *string `json:"size,omitempty"`
+
+	// Status Статус резерва, например, active, purchased, cancelled или expired.
+	Status *string `json:"status,omitempty"`
 }
 
-type PostAPIAuthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+// ReservationsResponse defines model for ReservationsResponse.
+type ReservationsResponse struct {
+	Reservations *[]Reservation `json:"reservations,omitempty"`
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAPIAuthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// ReserveItemRequest defines model for ReserveItemRequest.
+type ReserveItemRequest struct {
+	// Color Цвет варианта предмета.
+	Color *string `json:"color,omitempty"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Size Размер варианта предмета.
+	Size *string `json:"size,omitempty"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAPIAuthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// ScheduledTransfer defines model for ScheduledTransfer.
+type ScheduledTransfer struct {
+	// Amount Количество монет в каждом переводе.
+	Amount *int `json:"amount,omitempty"`
+
+	// CreatedAt Время создания запланированного перевода.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Cron Расписание повторяющегося перевода в формате cron (UTC). Отсутствует у разового перевода.
+	Cron *string `json:"cron,omitempty"`
+
+	// ID Идентификатор запланированного перевода.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// LastError Причина, по которой не удался последний перевод.
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastRunAt Время последнего перевода.
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+
+	// NextRunAt Время следующего перевода.
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
+
+	// Status Статус запланированного перевода.
+	Status *ScheduledTransferStatus `json:"status,omitempty"`
+
+	// ToUser Имя пользователя, которому переводятся монеты.
+	ToUser *string `json:"toUser,omitempty"`
 }
 
-type GetAPIBuyItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+// ScheduledTransferStatus Статус запланированного перевода.
+type ScheduledTransferStatus string
+
+// ScheduledTransfersResponse defines model for ScheduledTransfersResponse.
+type ScheduledTransfersResponse struct {
+	ScheduledTransfers *[]ScheduledTransfer `json:"scheduledTransfers,omitempty"`
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAPIBuyItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// SendCoinRequest defines model for SendCoinRequest.
+type SendCoinRequest struct {
+	// Amount Количество монет, которые необходимо отправить.
+	Amount int `json:"amount"`
+
+	// FromBudget Отправить монеты из бюджета на награды, а не из баланса.
+	FromBudget *bool `json:"fromBudget,omitempty"`
+
+	// Message Сообщение получателю, например, благодарность. Показывается в ленте благодарностей.
+	Message *string `json:"message,omitempty"`
+
+	// ToUser Имя пользователя, которому нужно отправить монеты.
+	ToUser string `json:"toUser"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAPIBuyItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// SendTeamCoinRequest defines model for SendTeamCoinRequest.
+type SendTeamCoinRequest struct {
+	// Amount Количество монет, которые необходимо отправить.
+	Amount int `json:"amount"`
+
+	// ToUser Имя пользователя, которому нужно отправить монеты.
+	ToUser string `json:"toUser"`
 }
 
-type GetAPIHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HealthResponse
-	JSON500      *ErrorResponse
+// SetGivingBudgetRequest defines model for SetGivingBudgetRequest.
+type SetGivingBudgetRequest struct {
+	// Allowance Количество монет, выделяемых на период.
+	Allowance int `json:"allowance"`
+
+	// Period Период, по истечении которого бюджет на награды восстанавливается.
+	Period BudgetPeriod `json:"period"`
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAPIHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// SetPurchaseLimitRequest defines model for SetPurchaseLimitRequest.
+type SetPurchaseLimitRequest struct {
+	// MaxCount Максимальное количество предметов.
+	MaxCount int `json:"maxCount"`
+
+	// PeriodDays Период ограничения в днях. По умолчанию ограничение бессрочное.
+	PeriodDays *int `json:"periodDays,omitempty"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAPIHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// SetTeamMemberRequest defines model for SetTeamMemberRequest.
+type SetTeamMemberRequest struct {
+	// Role Роль в команде. Менеджеры могут отправлять монеты из фонда команды.
+	Role TeamRole `json:"role"`
 }
 
-type GetAPIInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InfoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+// Team defines model for Team.
+type Team struct {
+	// Balance Количество монет в фонде команды.
+	Balance *int `json:"balance,omitempty"`
+
+	// Name Название команды.
+	Name *string `json:"name,omitempty"`
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAPIInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// TeamHistoryItem defines model for TeamHistoryItem.
+type TeamHistoryItem struct {
+	// Amount Количество монет.
+	Amount *int `json:"amount,omitempty"`
+
+	// CreatedAt Время операции.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Kind Тип операции. fund — пополнение фонда администратором, send — отправка монет из фонда участнику.
+	Kind *TeamHistoryKind `json:"kind,omitempty"`
+
+	// ToUser Имя пользователя, которому отправлены монеты.
+	ToUser *string `json:"toUser,omitempty"`
+
+	// User Имя пользователя, который выполнил операцию.
+	User *string `json:"user,omitempty"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAPIInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// TeamHistoryKind Тип операции. fund — пополнение фонда администратором, send — отправка монет из фонда участнику.
+type TeamHistoryKind string
+
+// TeamHistoryResponse defines model for TeamHistoryResponse.
+type TeamHistoryResponse struct {
+	History *[]TeamHistoryItem `json:"history,omitempty"`
 }
 
-type PostAPISendCoinResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	// DisplayName Отображаемое имя пользователя.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Role Роль в команде. Менеджеры могут отправлять монеты из фонда команды.
+	Role *TeamRole `json:"role,omitempty"`
+
+	// Username Имя пользователя.
+	Username *string `json:"username,omitempty"`
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAPISendCoinResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// TeamResponse defines model for TeamResponse.
+type TeamResponse struct {
+	// Balance Количество монет в фонде команды.
+	Balance *int          `json:"balance,omitempty"`
+	Members *[]TeamMember `json:"members,omitempty"`
+
+	// Name Название команды.
+	Name *string `json:"name,omitempty"`
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAPISendCoinResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// TeamRole Роль в команде. Менеджеры могут отправлять монеты из фонда команды.
+type TeamRole string
+
+// TeamsResponse defines model for TeamsResponse.
+type TeamsResponse struct {
+	Teams *[]Team `json:"teams,omitempty"`
 }
 
-// PostAPIAuthWithBodyWithResponse request with arbitrary body returning *PostAPIAuthResponse
-func (c *ClientWithResponses) PostAPIAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error) {
-	rsp, err := c.PostAPIAuthWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAPIAuthResponse(rsp)
+// Transfer defines model for Transfer.
+type Transfer struct {
+	// Amount Количество монет.
+	Amount *int `json:"amount,omitempty"`
+
+	// CreatedAt Время создания перевода.
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// ExpiresAt Время, после которого ожидающий перевод отменяется.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// FromUser Имя пользователя, который отправил монеты.
+	FromUser *string `json:"fromUser,omitempty"`
+
+	// ID Идентификатор перевода.
+	ID *openapi_types.UUID `json:"id,omitempty"`
+
+	// Message Сообщение получателю.
+	Message *string `json:"message,omitempty"`
+
+	// Status Статус перевода.
+	Status *TransferStatus `json:"status,omitempty"`
+
+	// ToUser Имя пользователя, которому отправлены монеты.
+	ToUser *string `json:"toUser,omitempty"`
 }
 
-func (c *ClientWithResponses) PostAPIAuthWithResponse(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error) {
-	rsp, err := c.PostAPIAuth(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAPIAuthResponse(rsp)
+// TransferItemRequest defines model for TransferItemRequest.
+type TransferItemRequest struct {
+	// Color Цвет передаваемых предметов.
+	Color *string `json:"color,omitempty"`
+
+	// Item Тип предмета.
+	Item string `json:"item"`
+
+	// Quantity Количество предметов. По умолчанию 1.
+	Quantity *int `json:"quantity,omitempty"`
+
+	// Size Размер передаваемых предметов. Если не указаны ни размер, ни цвет, передаются предметы любого варианта.
+	Size *string `json:"size,omitempty"`
+
+	// ToUser Имя пользователя, которому нужно передать предметы.
+	ToUser string `json:"toUser"`
 }
 
-// GetAPIBuyItemWithResponse request returning *GetAPIBuyItemResponse
-func (c *ClientWithResponses) GetAPIBuyItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetAPIBuyItemResponse, error) {
-	rsp, err := c.GetAPIBuyItem(ctx, item, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAPIBuyItemResponse(rsp)
+// TransferItemResponse defines model for TransferItemResponse.
+type TransferItemResponse struct {
+	// Transfers Передачи, по одной на каждый переданный предмет.
+	Transfers *[]ItemTransfer `json:"transfers,omitempty"`
 }
 
-// GetAPIHealthWithResponse request returning *GetAPIHealthResponse
-func (c *ClientWithResponses) GetAPIHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIHealthResponse, error) {
-	rsp, err := c.GetAPIHealth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAPIHealthResponse(rsp)
+// TransferLimits Ограничения на переводы монет от одного пользователя. Значение 0 означает отсутствие ограничения.
+type TransferLimits struct {
+	// ApprovalThreshold Переводы больше этого количества монет требуют одобрения менеджера или администратора.
+	ApprovalThreshold int `json:"approvalThreshold"`
+
+	// ApprovalTimeoutHours Время в часах, после которого неодобренный перевод отменяется.
+	ApprovalTimeoutHours int `json:"approvalTimeoutHours"`
+
+	// MaxPerDay Максимальное количество монет, отправленных за последние 24 часа.
+	MaxPerDay int `json:"maxPerDay"`
+
+	// MaxPerRecipient Максимальное количество монет, отправленных одному получателю за период.
+	MaxPerRecipient int `json:"maxPerRecipient"`
+
+	// MaxPerTransfer Максимальное количество монет в одном переводе.
+	MaxPerTransfer int `json:"maxPerTransfer"`
+
+	// RecipientPeriodDays Длительность периода для ограничения на одного получателя в днях.
+	RecipientPeriodDays int `json:"recipientPeriodDays"`
 }
 
-// GetAPIInfoWithResponse request returning *GetAPIInfoResponse
-func (c *ClientWithResponses) GetAPIInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIInfoResponse, error) {
-	rsp, err := c.GetAPIInfo(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAPIInfoResponse(rsp)
+// TransferStatus Статус перевода.
+type TransferStatus string
+
+// UpdateItemRequest defines model for UpdateItemRequest.
+type UpdateItemRequest struct {
+	// Category Категория предмета.
+	Category *string `json:"category,omitempty"`
+
+	// Description Описание предмета.
+	Description *string `json:"description,omitempty"`
 }
 
-// PostAPISendCoinWithBodyWithResponse request with arbitrary body returning *PostAPISendCoinResponse
-func (c *ClientWithResponses) PostAPISendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error) {
-	rsp, err := c.PostAPISendCoinWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAPISendCoinResponse(rsp)
+// UpdateItemVariantRequest defines model for UpdateItemVariantRequest.
+type UpdateItemVariantRequest struct {
+	// Price Цена варианта. Если не указана, используется цена предмета.
+	Price *int `json:"price,omitempty"`
+
+	// Stock Количество предметов в наличии.
+	Stock int `json:"stock"`
 }
 
-func (c *ClientWithResponses) PostAPISendCoinWithResponse(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error) {
-	rsp, err := c.PostAPISendCoin(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostAPISendCoinResponse(rsp)
+// UpdateProfileRequest defines model for UpdateProfileRequest.
+type UpdateProfileRequest struct {
+	// AvatarURL Ссылка на аватар. Должна быть абсолютной ссылкой http или https.
+	AvatarURL *string `json:"avatarUrl,omitempty"`
+
+	// Department Отдел.
+	Department *string `json:"department,omitempty"`
+
+	// DisplayName Отображаемое имя.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// StartDate Дата выхода на работу.
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
+
+	// Title Должность.
+	Title *string `json:"title,omitempty"`
 }
 
-// ParsePostAPIAuthResponse parses an HTTP response from a PostAPIAuthWithResponse call
-func ParsePostAPIAuthResponse(rsp *http.Response) (*PostAPIAuthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// UserSearchResponse defines model for UserSearchResponse.
+type UserSearchResponse struct {
+	Users *[]Profile `json:"users,omitempty"`
+}
 
-	response := &PostAPIAuthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// WebhookDeadLetter defines model for WebhookDeadLetter.
+type WebhookDeadLetter struct {
+	// Attempts Количество неудачных попыток.
+	Attempts  *int                `json:"attempts,omitempty"`
+	CreatedAt *time.Time          `json:"createdAt,omitempty"`
+	EventID   *openapi_types.UUID `json:"eventId,omitempty"`
+	EventKind *WebhookEventKind   `json:"eventKind,omitempty"`
+
+	// ID Идентификатор доставки.
+	ID            *openapi_types.UUID `json:"id,omitempty"`
+	LastAttemptAt *time.Time          `json:"lastAttemptAt,omitempty"`
+
+	// LastError Ошибка последней попытки.
+	LastError      *string             `json:"lastError,omitempty"`
+	SubscriptionID *openapi_types.UUID `json:"subscriptionId,omitempty"`
+	URL            *string             `json:"url,omitempty"`
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// WebhookDeadLettersResponse defines model for WebhookDeadLettersResponse.
+type WebhookDeadLettersResponse struct {
+	DeadLetters *[]WebhookDeadLetter `json:"deadLetters,omitempty"`
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// WebhookEventKind defines model for WebhookEventKind.
+type WebhookEventKind string
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt  *time.Time          `json:"createdAt,omitempty"`
+	EventKinds *[]WebhookEventKind `json:"eventKinds,omitempty"`
+	ID         *openapi_types.UUID `json:"id,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// Secret Ключ подписи HMAC-SHA256 в заголовке X-Merch-Signature. Возвращается только при создании.
+	Secret *string `json:"secret,omitempty"`
 
-	}
+	// URL URL, на который отправляются события.
+	URL *string `json:"url,omitempty"`
+}
 
-	return response, nil
+// WebhookSubscriptionsResponse defines model for WebhookSubscriptionsResponse.
+type WebhookSubscriptionsResponse struct {
+	Subscriptions *[]WebhookSubscription `json:"subscriptions,omitempty"`
 }
 
-// ParseGetAPIBuyItemResponse parses an HTTP response from a GetAPIBuyItemWithResponse call
-func ParseGetAPIBuyItemResponse(rsp *http.Response) (*GetAPIBuyItemResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// WishlistItem defines model for WishlistItem.
+type WishlistItem struct {
+	// AddedAt Время добавления предмета в список желаний.
+	AddedAt *time.Time `json:"addedAt,omitempty"`
 
-	response := &GetAPIBuyItemResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// InStock Есть ли предмет в наличии.
+	InStock *bool `json:"inStock,omitempty"`
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// Item Тип предмета.
+	Item *string `json:"item,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// ListPrice Текущая цена предмета без скидки.
+	ListPrice *int `json:"listPrice,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// MissingCoins Сколько монет не хватает для покупки.
+	MissingCoins *int `json:"missingCoins,omitempty"`
 
-	}
+	// Price Текущая цена предмета с учетом скидочных акций. Для предметов с вариантами — цена самого дешевого варианта в наличии.
+	Price *int `json:"price,omitempty"`
 
-	return response, nil
+	// Progress Прогресс накопления монет на предмет в процентах от 0 до 100.
+	Progress *int `json:"progress,omitempty"`
 }
 
-// ParseGetAPIHealthResponse parses an HTTP response from a GetAPIHealthWithResponse call
-func ParseGetAPIHealthResponse(rsp *http.Response) (*GetAPIHealthResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetAPIHealthResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// WishlistResponse defines model for WishlistResponse.
+type WishlistResponse struct {
+	// Balance Количество монет текущего пользователя.
+	Balance *int            `json:"balance,omitempty"`
+	Items   *[]WishlistItem `json:"items,omitempty"`
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// GetAPIBuyItemParams defines parameters for GetAPIBuyItem.
+type GetAPIBuyItemParams struct {
+	// Size Размер варианта предмета. Для предметов с вариантами нужно указать размер, цвет или оба.
+	Size *string `form:"size,omitempty" json:"size,omitempty"`
 
-	}
+	// Color Цвет варианта предмета.
+	Color *string `form:"color,omitempty" json:"color,omitempty"`
 
-	return response, nil
+	// PromoCode Промокод на скидку.
+	PromoCode *string `form:"promoCode,omitempty" json:"promoCode,omitempty"`
 }
 
-// ParseGetAPIInfoResponse parses an HTTP response from a GetAPIInfoWithResponse call
-func ParseGetAPIInfoResponse(rsp *http.Response) (*GetAPIInfoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetAPIImagesKeyParams defines parameters for GetAPIImagesKey.
+type GetAPIImagesKeyParams struct {
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
 
-	response := &GetAPIInfoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// GetAPIItemsParams defines parameters for GetAPIItems.
+type GetAPIItemsParams struct {
+	// Category Категория предметов. Если не указана, возвращаются все предметы.
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InfoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// GetAPIKudosParams defines parameters for GetAPIKudos.
+type GetAPIKudosParams struct {
+	// Before Идентификатор последней записи предыдущей страницы.
+	Before *openapi_types.UUID `form:"before,omitempty" json:"before,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// Limit Максимальное количество записей в ответе (по умолчанию 20, не больше 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// GetAPILeaderboardsKindParams defines parameters for GetAPILeaderboardsKind.
+type GetAPILeaderboardsKindParams struct {
+	// Period Календарный период до текущего момента (по умолчанию month). Не используется вместе с from и to.
+	Period *GetAPILeaderboardsKindParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// From Начало периода включительно.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
 
-	}
+	// To Конец периода не включительно.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
 
-	return response, nil
+	// Limit Максимальное количество пользователей в ответе (по умолчанию 10, не больше 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// ParsePostAPISendCoinResponse parses an HTTP response from a PostAPISendCoinWithResponse call
-func ParsePostAPISendCoinResponse(rsp *http.Response) (*PostAPISendCoinResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetAPILeaderboardsKindParamsPeriod defines parameters for GetAPILeaderboardsKind.
+type GetAPILeaderboardsKindParamsPeriod string
 
-	response := &PostAPISendCoinResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// GetAPINotificationsParams defines parameters for GetAPINotifications.
+type GetAPINotificationsParams struct {
+	// Unread Вернуть только непрочитанные уведомления.
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// PostAPIReservationsIDPurchaseParams defines parameters for PostAPIReservationsIDPurchase.
+type PostAPIReservationsIDPurchaseParams struct {
+	// Size Размер варианта предмета, если вариант не был выбран при резервировании.
+	Size *string `form:"size,omitempty" json:"size,omitempty"`
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Color Цвет варианта предмета, если вариант не был выбран при резервировании.
+	Color *string `form:"color,omitempty" json:"color,omitempty"`
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// GetAPIUsersParams defines parameters for GetAPIUsers.
+type GetAPIUsersParams struct {
+	// Q Начало или часть имени пользователя или отображаемого имени.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
-	}
+	// Limit Максимальное количество пользователей в ответе (по умолчанию 20, не больше 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	return response, nil
+	// Offset Количество пропускаемых пользователей.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
-	// (POST /api/auth)
-	PostAPIAuth(w http.ResponseWriter, r *http.Request)
-	// Купить предмет за монеты.
-	// (GET /api/buy/{item})
-	GetAPIBuyItem(w http.ResponseWriter, r *http.Request, item string)
-	// Получить здоровье сервиса.
-	// (GET /api/health)
-	GetAPIHealth(w http.ResponseWriter, r *http.Request)
-	// Получить информацию о монетах, инвентаре и истории транзакций.
-	// (GET /api/info)
-	GetAPIInfo(w http.ResponseWriter, r *http.Request)
-	// Отправить монеты другому пользователю.
-	// (POST /api/sendCoin)
-	PostAPISendCoin(w http.ResponseWriter, r *http.Request)
+// GetAPIWebhooksDeadLettersParams defines parameters for GetAPIWebhooksDeadLetters.
+type GetAPIWebhooksDeadLettersParams struct {
+	// Limit Максимальное количество доставок в ответе (по умолчанию 50, не больше 100).
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// PostAPIAuctionsJSONRequestBody defines body for PostAPIAuctions for application/json ContentType.
+type PostAPIAuctionsJSONRequestBody = CreateAuctionRequest
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// PostAPIAuctionsIDBidsJSONRequestBody defines body for PostAPIAuctionsIDBids for application/json ContentType.
+type PostAPIAuctionsIDBidsJSONRequestBody = PlaceBidRequest
 
-// PostAPIAuth operation middleware
-func (siw *ServerInterfaceWrapper) PostAPIAuth(w http.ResponseWriter, r *http.Request) {
+// PostAPIAuthJSONRequestBody defines body for PostAPIAuth for application/json ContentType.
+type PostAPIAuthJSONRequestBody = AuthRequest
 
-	ctx := r.Context()
+// PutAPIBudgetsUsernameJSONRequestBody defines body for PutAPIBudgetsUsername for application/json ContentType.
+type PutAPIBudgetsUsernameJSONRequestBody = SetGivingBudgetRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// PostAPIBuyItemGiftJSONRequestBody defines body for PostAPIBuyItemGift for application/json ContentType.
+type PostAPIBuyItemGiftJSONRequestBody = GiftItemRequest
 
-	r = r.WithContext(ctx)
+// PostAPICampaignsJSONRequestBody defines body for PostAPICampaigns for application/json ContentType.
+type PostAPICampaignsJSONRequestBody = CreateCampaignRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAPIAuth(w, r)
-	}))
+// PostAPIInventoryTransferJSONRequestBody defines body for PostAPIInventoryTransfer for application/json ContentType.
+type PostAPIInventoryTransferJSONRequestBody = TransferItemRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PutAPIItemsItemJSONRequestBody defines body for PutAPIItemsItem for application/json ContentType.
+type PutAPIItemsItemJSONRequestBody = UpdateItemRequest
 
-	handler.ServeHTTP(w, r)
-}
+// PutAPIItemsItemPurchaseLimitJSONRequestBody defines body for PutAPIItemsItemPurchaseLimit for application/json ContentType.
+type PutAPIItemsItemPurchaseLimitJSONRequestBody = SetPurchaseLimitRequest
 
-// GetAPIBuyItem operation middleware
-func (siw *ServerInterfaceWrapper) GetAPIBuyItem(w http.ResponseWriter, r *http.Request) {
+// PostAPIItemsItemVariantsJSONRequestBody defines body for PostAPIItemsItemVariants for application/json ContentType.
+type PostAPIItemsItemVariantsJSONRequestBody = CreateItemVariantRequest
 
-	var err error
+// PutAPIItemsItemVariantsIDJSONRequestBody defines body for PutAPIItemsItemVariantsID for application/json ContentType.
+type PutAPIItemsItemVariantsIDJSONRequestBody = UpdateItemVariantRequest
 
-	// ------------- Path parameter "item" -------------
-	var item string
+// PutAPIKudosSettingsJSONRequestBody defines body for PutAPIKudosSettings for application/json ContentType.
+type PutAPIKudosSettingsJSONRequestBody = KudosSettings
 
-	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
-		return
-	}
+// PostAPIPaymentRequestsJSONRequestBody defines body for PostAPIPaymentRequests for application/json ContentType.
+type PostAPIPaymentRequestsJSONRequestBody = CreatePaymentRequestRequest
 
-	ctx := r.Context()
+// PutAPIProfileJSONRequestBody defines body for PutAPIProfile for application/json ContentType.
+type PutAPIProfileJSONRequestBody = UpdateProfileRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// PostAPIPromoCodesJSONRequestBody defines body for PostAPIPromoCodes for application/json ContentType.
+type PostAPIPromoCodesJSONRequestBody = CreatePromoCodeRequest
 
-	r = r.WithContext(ctx)
+// PostAPIPromoCodesRedeemJSONRequestBody defines body for PostAPIPromoCodesRedeem for application/json ContentType.
+type PostAPIPromoCodesRedeemJSONRequestBody = RedeemPromoCodeRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAPIBuyItem(w, r, item)
-	}))
+// PostAPIRafflesJSONRequestBody defines body for PostAPIRaffles for application/json ContentType.
+type PostAPIRafflesJSONRequestBody = CreateRaffleRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PostAPIRafflesIDTicketsJSONRequestBody defines body for PostAPIRafflesIDTickets for application/json ContentType.
+type PostAPIRafflesIDTicketsJSONRequestBody = BuyRaffleTicketsRequest
 
-	handler.ServeHTTP(w, r)
-}
+// PostAPIReservationsJSONRequestBody defines body for PostAPIReservations for application/json ContentType.
+type PostAPIReservationsJSONRequestBody = ReserveItemRequest
 
-// GetAPIHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetAPIHealth(w http.ResponseWriter, r *http.Request) {
+// PostAPIScheduledTransfersJSONRequestBody defines body for PostAPIScheduledTransfers for application/json ContentType.
+type PostAPIScheduledTransfersJSONRequestBody = CreateScheduledTransferRequest
 
-	ctx := r.Context()
+// PostAPISendCoinJSONRequestBody defines body for PostAPISendCoin for application/json ContentType.
+type PostAPISendCoinJSONRequestBody = SendCoinRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// PostAPISendCoinBatchJSONRequestBody defines body for PostAPISendCoinBatch for application/json ContentType.
+type PostAPISendCoinBatchJSONRequestBody = BatchSendCoinRequest
 
-	r = r.WithContext(ctx)
+// PostAPITeamsJSONRequestBody defines body for PostAPITeams for application/json ContentType.
+type PostAPITeamsJSONRequestBody = CreateTeamRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAPIHealth(w, r)
-	}))
+// PostAPITeamsTeamFundJSONRequestBody defines body for PostAPITeamsTeamFund for application/json ContentType.
+type PostAPITeamsTeamFundJSONRequestBody = FundTeamRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PutAPITeamsTeamMembersUsernameJSONRequestBody defines body for PutAPITeamsTeamMembersUsername for application/json ContentType.
+type PutAPITeamsTeamMembersUsernameJSONRequestBody = SetTeamMemberRequest
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetAPIInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetAPIInfo(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+// PostAPITeamsTeamSendCoinJSONRequestBody defines body for PostAPITeamsTeamSendCoin for application/json ContentType.
+type PostAPITeamsTeamSendCoinJSONRequestBody = SendTeamCoinRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// PutAPITransferLimitsJSONRequestBody defines body for PutAPITransferLimits for application/json ContentType.
+type PutAPITransferLimitsJSONRequestBody = TransferLimits
 
-	r = r.WithContext(ctx)
+// PostAPIWebhooksJSONRequestBody defines body for PostAPIWebhooks for application/json ContentType.
+type PostAPIWebhooksJSONRequestBody = CreateWebhookSubscriptionRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAPIInfo(w, r)
-	}))
+// PostAPIWishlistJSONRequestBody defines body for PostAPIWishlist for application/json ContentType.
+type PostAPIWishlistJSONRequestBody = AddWishlistItemRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
-	handler.ServeHTTP(w, r)
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
 }
 
-// PostAPISendCoin operation middleware
-func (siw *ServerInterfaceWrapper) PostAPISendCoin(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
 
-	r = r.WithContext(ctx)
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAPISendCoin(w, r)
-	}))
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
 	}
-
-	handler.ServeHTTP(w, r)
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAPIAuctions request
+	GetAPIAuctions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// PostAPIAuctionsWithBody request with any body
+	PostAPIAuctionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	PostAPIAuctions(ctx context.Context, body PostAPIAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// DeleteAPIAuctionsID request
+	DeleteAPIAuctionsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// GetAPIAuctionsID request
+	GetAPIAuctionsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// GetAPIAuctionsIDBids request
+	GetAPIAuctionsIDBids(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// PostAPIAuctionsIDBidsWithBody request with any body
+	PostAPIAuctionsIDBidsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	PostAPIAuctionsIDBids(ctx context.Context, id openapi_types.UUID, body PostAPIAuctionsIDBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// PostAPIAuthWithBody request with any body
+	PostAPIAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	PostAPIAuth(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// PutAPIBudgetsUsernameWithBody request with any body
+	PutAPIBudgetsUsernameWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	PutAPIBudgetsUsername(ctx context.Context, username string, body PutAPIBudgetsUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// GetAPIBuyItem request
+	GetAPIBuyItem(ctx context.Context, item string, params *GetAPIBuyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// PostAPIBuyItemGiftWithBody request with any body
+	PostAPIBuyItemGiftWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	PostAPIBuyItemGift(ctx context.Context, item string, body PostAPIBuyItemGiftJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	// GetAPICampaigns request
+	GetAPICampaigns(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// PostAPICampaignsWithBody request with any body
+	PostAPICampaignsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	PostAPICampaigns(ctx context.Context, body PostAPICampaignsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	// DeleteAPICampaignsID request
+	DeleteAPICampaignsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	// GetAPIEvents request
+	GetAPIEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+	// GetAPIHealth request
+	GetAPIHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// GetAPIImagesKey request
+	GetAPIImagesKey(ctx context.Context, key string, params *GetAPIImagesKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	m.HandleFunc("POST "+options.BaseURL+"/api/auth", wrapper.PostAPIAuth)
-	m.HandleFunc("GET "+options.BaseURL+"/api/buy/{item}", wrapper.GetAPIBuyItem)
-	m.HandleFunc("GET "+options.BaseURL+"/api/health", wrapper.GetAPIHealth)
-	m.HandleFunc("GET "+options.BaseURL+"/api/info", wrapper.GetAPIInfo)
-	m.HandleFunc("POST "+options.BaseURL+"/api/sendCoin", wrapper.PostAPISendCoin)
+	// GetAPIInfo request
+	GetAPIInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return m
-}
+	// PostAPIInventoryTransferWithBody request with any body
+	PostAPIInventoryTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type PostAPIAuthRequestObject struct {
-	Body *PostAPIAuthJSONRequestBody
-}
+	PostAPIInventoryTransfer(ctx context.Context, body PostAPIInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type PostAPIAuthResponseObject interface {
-	VisitPostAPIAuthResponse(w http.ResponseWriter) error
-}
+	// GetAPIInventoryTransfers request
+	GetAPIInventoryTransfers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type PostAPIAuth200JSONResponse AuthResponse
+	// GetAPIItems request
+	GetAPIItems(ctx context.Context, params *GetAPIItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response PostAPIAuth200JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// PutAPIItemsItemWithBody request with any body
+	PutAPIItemsItemWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	PutAPIItemsItem(ctx context.Context, item string, body PutAPIItemsItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type PostAPIAuth400JSONResponse ErrorResponse
+	// PutAPIItemsItemImageWithBody request with any body
+	PutAPIItemsItemImageWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response PostAPIAuth400JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// DeleteAPIItemsItemPurchaseLimit request
+	DeleteAPIItemsItemPurchaseLimit(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// PutAPIItemsItemPurchaseLimitWithBody request with any body
+	PutAPIItemsItemPurchaseLimitWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type PostAPIAuth401JSONResponse ErrorResponse
+	PutAPIItemsItemPurchaseLimit(ctx context.Context, item string, body PutAPIItemsItemPurchaseLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response PostAPIAuth401JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// GetAPIItemsItemVariants request
+	GetAPIItemsItemVariants(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// PostAPIItemsItemVariantsWithBody request with any body
+	PostAPIItemsItemVariantsWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type PostAPIAuth500JSONResponse ErrorResponse
+	PostAPIItemsItemVariants(ctx context.Context, item string, body PostAPIItemsItemVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response PostAPIAuth500JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// PutAPIItemsItemVariantsIDWithBody request with any body
+	PutAPIItemsItemVariantsIDWithBody(ctx context.Context, item string, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	PutAPIItemsItemVariantsID(ctx context.Context, item string, id openapi_types.UUID, body PutAPIItemsItemVariantsIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type GetAPIBuyItemRequestObject struct {
-	Item string `json:"item"`
-}
+	// GetAPIKudos request
+	GetAPIKudos(ctx context.Context, params *GetAPIKudosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type GetAPIBuyItemResponseObject interface {
-	VisitGetAPIBuyItemResponse(w http.ResponseWriter) error
-}
+	// GetAPIKudosSettings request
+	GetAPIKudosSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type GetAPIBuyItem200Response struct {
-}
+	// PutAPIKudosSettingsWithBody request with any body
+	PutAPIKudosSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response GetAPIBuyItem200Response) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	PutAPIKudosSettings(ctx context.Context, body PutAPIKudosSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type GetAPIBuyItem400JSONResponse ErrorResponse
+	// GetAPILeaderboardsKind request
+	GetAPILeaderboardsKind(ctx context.Context, kind LeaderboardKind, params *GetAPILeaderboardsKindParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response GetAPIBuyItem400JSONResponse) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// GetAPINotifications request
+	GetAPINotifications(ctx context.Context, params *GetAPINotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// PostAPINotificationsRead request
+	PostAPINotificationsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type GetAPIBuyItem401JSONResponse ErrorResponse
+	// PostAPINotificationsIDRead request
+	PostAPINotificationsIDRead(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response GetAPIBuyItem401JSONResponse) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// GetAPIPaymentRequests request
+	GetAPIPaymentRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// PostAPIPaymentRequestsWithBody request with any body
+	PostAPIPaymentRequestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-type GetAPIBuyItem500JSONResponse ErrorResponse
+	PostAPIPaymentRequests(ctx context.Context, body PostAPIPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-func (response GetAPIBuyItem500JSONResponse) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// PostAPIPaymentRequestsIDAccept request
+	PostAPIPaymentRequestsIDAccept(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return json.NewEncoder(w).Encode(response)
+	// PostAPIPaymentRequestsIDDecline request
+	PostAPIPaymentRequestsIDDecline(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIProfile request
+	GetAPIProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAPIProfileWithBody request with any body
+	PutAPIProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAPIProfile(ctx context.Context, body PutAPIProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIPromoCodes request
+	GetAPIPromoCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIPromoCodesWithBody request with any body
+	PostAPIPromoCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIPromoCodes(ctx context.Context, body PostAPIPromoCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIPromoCodesRedeemWithBody request with any body
+	PostAPIPromoCodesRedeemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIPromoCodesRedeem(ctx context.Context, body PostAPIPromoCodesRedeemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIPromoCodesCodeRedemptions request
+	GetAPIPromoCodesCodeRedemptions(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIRaffles request
+	GetAPIRaffles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIRafflesWithBody request with any body
+	PostAPIRafflesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIRaffles(ctx context.Context, body PostAPIRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIRafflesID request
+	GetAPIRafflesID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIRafflesIDTickets request
+	GetAPIRafflesIDTickets(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIRafflesIDTicketsWithBody request with any body
+	PostAPIRafflesIDTicketsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIRafflesIDTickets(ctx context.Context, id openapi_types.UUID, body PostAPIRafflesIDTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIReservations request
+	GetAPIReservations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIReservationsWithBody request with any body
+	PostAPIReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIReservations(ctx context.Context, body PostAPIReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPIReservationsID request
+	DeleteAPIReservationsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIReservationsIDPurchase request
+	PostAPIReservationsIDPurchase(ctx context.Context, id openapi_types.UUID, params *PostAPIReservationsIDPurchaseParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIScheduledTransfers request
+	GetAPIScheduledTransfers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIScheduledTransfersWithBody request with any body
+	PostAPIScheduledTransfersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIScheduledTransfers(ctx context.Context, body PostAPIScheduledTransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPIScheduledTransfersID request
+	DeleteAPIScheduledTransfersID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPISendCoinWithBody request with any body
+	PostAPISendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPISendCoin(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPISendCoinBatchWithBody request with any body
+	PostAPISendCoinBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPISendCoinBatch(ctx context.Context, body PostAPISendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPITeams request
+	GetAPITeams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPITeamsWithBody request with any body
+	PostAPITeamsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPITeams(ctx context.Context, body PostAPITeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPITeamsTeam request
+	GetAPITeamsTeam(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPITeamsTeamFundWithBody request with any body
+	PostAPITeamsTeamFundWithBody(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPITeamsTeamFund(ctx context.Context, team string, body PostAPITeamsTeamFundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPITeamsTeamHistory request
+	GetAPITeamsTeamHistory(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPITeamsTeamMembersUsername request
+	DeleteAPITeamsTeamMembersUsername(ctx context.Context, team string, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAPITeamsTeamMembersUsernameWithBody request with any body
+	PutAPITeamsTeamMembersUsernameWithBody(ctx context.Context, team string, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAPITeamsTeamMembersUsername(ctx context.Context, team string, username string, body PutAPITeamsTeamMembersUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPITeamsTeamSendCoinWithBody request with any body
+	PostAPITeamsTeamSendCoinWithBody(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPITeamsTeamSendCoin(ctx context.Context, team string, body PostAPITeamsTeamSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPITransferLimits request
+	GetAPITransferLimits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAPITransferLimitsWithBody request with any body
+	PutAPITransferLimitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAPITransferLimits(ctx context.Context, body PutAPITransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPITransfersPending request
+	GetAPITransfersPending(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPITransfersIDApprove request
+	PostAPITransfersIDApprove(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPITransfersIDReject request
+	PostAPITransfersIDReject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIUsers request
+	GetAPIUsers(ctx context.Context, params *GetAPIUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIUsersUsername request
+	GetAPIUsersUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIUsersUsernameDeactivate request
+	PostAPIUsersUsernameDeactivate(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIUsersUsernameReactivate request
+	PostAPIUsersUsernameReactivate(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIWebhooks request
+	GetAPIWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIWebhooksWithBody request with any body
+	PostAPIWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIWebhooks(ctx context.Context, body PostAPIWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIWebhooksDeadLetters request
+	GetAPIWebhooksDeadLetters(ctx context.Context, params *GetAPIWebhooksDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIWebhooksDeliveriesIDRetry request
+	PostAPIWebhooksDeliveriesIDRetry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPIWebhooksID request
+	DeleteAPIWebhooksID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIWishlist request
+	GetAPIWishlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIWishlistWithBody request with any body
+	PostAPIWishlistWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIWishlist(ctx context.Context, body PostAPIWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPIWishlistItem request
+	DeleteAPIWishlistItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAPIAuctions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIAuctionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIAuctionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIAuctionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIAuctions(ctx context.Context, body PostAPIAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIAuctionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPIAuctionsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIAuctionsIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIAuctionsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIAuctionsIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIAuctionsIDBids(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIAuctionsIDBidsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIAuctionsIDBidsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIAuctionsIDBidsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIAuctionsIDBids(ctx context.Context, id openapi_types.UUID, body PostAPIAuctionsIDBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIAuctionsIDBidsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIAuthRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIAuth(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIAuthRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIBudgetsUsernameWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIBudgetsUsernameRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIBudgetsUsername(ctx context.Context, username string, body PutAPIBudgetsUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIBudgetsUsernameRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIBuyItem(ctx context.Context, item string, params *GetAPIBuyItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIBuyItemRequest(c.Server, item, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIBuyItemGiftWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIBuyItemGiftRequestWithBody(c.Server, item, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIBuyItemGift(ctx context.Context, item string, body PostAPIBuyItemGiftJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIBuyItemGiftRequest(c.Server, item, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPICampaigns(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPICampaignsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPICampaignsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPICampaignsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPICampaigns(ctx context.Context, body PostAPICampaignsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPICampaignsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPICampaignsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPICampaignsIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIEventsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIImagesKey(ctx context.Context, key string, params *GetAPIImagesKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIImagesKeyRequest(c.Server, key, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIInventoryTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIInventoryTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIInventoryTransfer(ctx context.Context, body PostAPIInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIInventoryTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIInventoryTransfers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIInventoryTransfersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIItems(ctx context.Context, params *GetAPIItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIItemsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIItemsItemWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIItemsItemRequestWithBody(c.Server, item, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIItemsItem(ctx context.Context, item string, body PutAPIItemsItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIItemsItemRequest(c.Server, item, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIItemsItemImageWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIItemsItemImageRequestWithBody(c.Server, item, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPIItemsItemPurchaseLimit(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIItemsItemPurchaseLimitRequest(c.Server, item)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIItemsItemPurchaseLimitWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIItemsItemPurchaseLimitRequestWithBody(c.Server, item, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIItemsItemPurchaseLimit(ctx context.Context, item string, body PutAPIItemsItemPurchaseLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIItemsItemPurchaseLimitRequest(c.Server, item, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIItemsItemVariants(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIItemsItemVariantsRequest(c.Server, item)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIItemsItemVariantsWithBody(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIItemsItemVariantsRequestWithBody(c.Server, item, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIItemsItemVariants(ctx context.Context, item string, body PostAPIItemsItemVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIItemsItemVariantsRequest(c.Server, item, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIItemsItemVariantsIDWithBody(ctx context.Context, item string, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIItemsItemVariantsIDRequestWithBody(c.Server, item, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIItemsItemVariantsID(ctx context.Context, item string, id openapi_types.UUID, body PutAPIItemsItemVariantsIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIItemsItemVariantsIDRequest(c.Server, item, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIKudos(ctx context.Context, params *GetAPIKudosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIKudosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIKudosSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIKudosSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIKudosSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIKudosSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIKudosSettings(ctx context.Context, body PutAPIKudosSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIKudosSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPILeaderboardsKind(ctx context.Context, kind LeaderboardKind, params *GetAPILeaderboardsKindParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPILeaderboardsKindRequest(c.Server, kind, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPINotifications(ctx context.Context, params *GetAPINotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPINotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPINotificationsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPINotificationsReadRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPINotificationsIDRead(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPINotificationsIDReadRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIPaymentRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIPaymentRequestsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPaymentRequestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPaymentRequestsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPaymentRequests(ctx context.Context, body PostAPIPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPaymentRequestsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPaymentRequestsIDAccept(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPaymentRequestsIDAcceptRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPaymentRequestsIDDecline(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPaymentRequestsIDDeclineRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIProfile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIProfileRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIProfileWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIProfileRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPIProfile(ctx context.Context, body PutAPIProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPIProfileRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIPromoCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIPromoCodesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPromoCodesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPromoCodesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPromoCodes(ctx context.Context, body PostAPIPromoCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPromoCodesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPromoCodesRedeemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPromoCodesRedeemRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIPromoCodesRedeem(ctx context.Context, body PostAPIPromoCodesRedeemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIPromoCodesRedeemRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIPromoCodesCodeRedemptions(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIPromoCodesCodeRedemptionsRequest(c.Server, code)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIRaffles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIRafflesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIRafflesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIRafflesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIRaffles(ctx context.Context, body PostAPIRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIRafflesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIRafflesID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIRafflesIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIRafflesIDTickets(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIRafflesIDTicketsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIRafflesIDTicketsWithBody(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIRafflesIDTicketsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIRafflesIDTickets(ctx context.Context, id openapi_types.UUID, body PostAPIRafflesIDTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIRafflesIDTicketsRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIReservations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIReservationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIReservationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIReservations(ctx context.Context, body PostAPIReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIReservationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPIReservationsID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIReservationsIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIReservationsIDPurchase(ctx context.Context, id openapi_types.UUID, params *PostAPIReservationsIDPurchaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIReservationsIDPurchaseRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIScheduledTransfers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIScheduledTransfersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIScheduledTransfersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIScheduledTransfersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIScheduledTransfers(ctx context.Context, body PostAPIScheduledTransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIScheduledTransfersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPIScheduledTransfersID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIScheduledTransfersIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPISendCoinWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPISendCoinRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPISendCoin(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPISendCoinRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPISendCoinBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPISendCoinBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPISendCoinBatch(ctx context.Context, body PostAPISendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPISendCoinBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPITeams(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPITeamsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITeamsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITeamsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITeams(ctx context.Context, body PostAPITeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITeamsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPITeamsTeam(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPITeamsTeamRequest(c.Server, team)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITeamsTeamFundWithBody(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITeamsTeamFundRequestWithBody(c.Server, team, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITeamsTeamFund(ctx context.Context, team string, body PostAPITeamsTeamFundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITeamsTeamFundRequest(c.Server, team, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPITeamsTeamHistory(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPITeamsTeamHistoryRequest(c.Server, team)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPITeamsTeamMembersUsername(ctx context.Context, team string, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPITeamsTeamMembersUsernameRequest(c.Server, team, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPITeamsTeamMembersUsernameWithBody(ctx context.Context, team string, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPITeamsTeamMembersUsernameRequestWithBody(c.Server, team, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPITeamsTeamMembersUsername(ctx context.Context, team string, username string, body PutAPITeamsTeamMembersUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPITeamsTeamMembersUsernameRequest(c.Server, team, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITeamsTeamSendCoinWithBody(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITeamsTeamSendCoinRequestWithBody(c.Server, team, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITeamsTeamSendCoin(ctx context.Context, team string, body PostAPITeamsTeamSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITeamsTeamSendCoinRequest(c.Server, team, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPITransferLimits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPITransferLimitsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPITransferLimitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPITransferLimitsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAPITransferLimits(ctx context.Context, body PutAPITransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAPITransferLimitsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPITransfersPending(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPITransfersPendingRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITransfersIDApprove(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITransfersIDApproveRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPITransfersIDReject(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPITransfersIDRejectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIUsers(ctx context.Context, params *GetAPIUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIUsersUsername(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIUsersUsernameRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIUsersUsernameDeactivate(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIUsersUsernameDeactivateRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIUsersUsernameReactivate(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIUsersUsernameReactivateRequest(c.Server, username)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIWebhooksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIWebhooksRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIWebhooks(ctx context.Context, body PostAPIWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIWebhooksRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIWebhooksDeadLetters(ctx context.Context, params *GetAPIWebhooksDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIWebhooksDeadLettersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIWebhooksDeliveriesIDRetry(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIWebhooksDeliveriesIDRetryRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPIWebhooksID(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIWebhooksIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAPIWishlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIWishlistRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIWishlistWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIWishlistRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAPIWishlist(ctx context.Context, body PostAPIWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIWishlistRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAPIWishlistItem(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIWishlistItemRequest(c.Server, item)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAPIAuctionsRequest generates requests for GetAPIAuctions
+func NewGetAPIAuctionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIAuctionsRequest calls the generic PostAPIAuctions builder with application/json body
+func NewPostAPIAuctionsRequest(server string, body PostAPIAuctionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIAuctionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIAuctionsRequestWithBody generates requests for PostAPIAuctions with any type of body
+func NewPostAPIAuctionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAPIAuctionsIDRequest generates requests for DeleteAPIAuctionsID
+func NewDeleteAPIAuctionsIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIAuctionsIDRequest generates requests for GetAPIAuctionsID
+func NewGetAPIAuctionsIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIAuctionsIDBidsRequest generates requests for GetAPIAuctionsIDBids
+func NewGetAPIAuctionsIDBidsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions/%s/bids", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIAuctionsIDBidsRequest calls the generic PostAPIAuctionsIDBids builder with application/json body
+func NewPostAPIAuctionsIDBidsRequest(server string, id openapi_types.UUID, body PostAPIAuctionsIDBidsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIAuctionsIDBidsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostAPIAuctionsIDBidsRequestWithBody generates requests for PostAPIAuctionsIDBids with any type of body
+func NewPostAPIAuctionsIDBidsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auctions/%s/bids", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAPIAuthRequest calls the generic PostAPIAuth builder with application/json body
+func NewPostAPIAuthRequest(server string, body PostAPIAuthJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIAuthRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIAuthRequestWithBody generates requests for PostAPIAuth with any type of body
+func NewPostAPIAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/auth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutAPIBudgetsUsernameRequest calls the generic PutAPIBudgetsUsername builder with application/json body
+func NewPutAPIBudgetsUsernameRequest(server string, username string, body PutAPIBudgetsUsernameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPIBudgetsUsernameRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewPutAPIBudgetsUsernameRequestWithBody generates requests for PutAPIBudgetsUsername with any type of body
+func NewPutAPIBudgetsUsernameRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/budgets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIBuyItemRequest generates requests for GetAPIBuyItem
+func NewGetAPIBuyItemRequest(server string, item string, params *GetAPIBuyItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buy/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Color != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "color", runtime.ParamLocationQuery, *params.Color); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PromoCode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "promoCode", runtime.ParamLocationQuery, *params.PromoCode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIBuyItemGiftRequest calls the generic PostAPIBuyItemGift builder with application/json body
+func NewPostAPIBuyItemGiftRequest(server string, item string, body PostAPIBuyItemGiftJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIBuyItemGiftRequestWithBody(server, item, "application/json", bodyReader)
+}
+
+// NewPostAPIBuyItemGiftRequestWithBody generates requests for PostAPIBuyItemGift with any type of body
+func NewPostAPIBuyItemGiftRequestWithBody(server string, item string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/buy/%s/gift", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPICampaignsRequest generates requests for GetAPICampaigns
+func NewGetAPICampaignsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/campaigns")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPICampaignsRequest calls the generic PostAPICampaigns builder with application/json body
+func NewPostAPICampaignsRequest(server string, body PostAPICampaignsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPICampaignsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPICampaignsRequestWithBody generates requests for PostAPICampaigns with any type of body
+func NewPostAPICampaignsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/campaigns")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAPICampaignsIDRequest generates requests for DeleteAPICampaignsID
+func NewDeleteAPICampaignsIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/campaigns/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIEventsRequest generates requests for GetAPIEvents
+func NewGetAPIEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIHealthRequest generates requests for GetAPIHealth
+func NewGetAPIHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIImagesKeyRequest generates requests for GetAPIImagesKey
+func NewGetAPIImagesKeyRequest(server string, key string, params *GetAPIImagesKeyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/images/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAPIInfoRequest generates requests for GetAPIInfo
+func NewGetAPIInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIInventoryTransferRequest calls the generic PostAPIInventoryTransfer builder with application/json body
+func NewPostAPIInventoryTransferRequest(server string, body PostAPIInventoryTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIInventoryTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIInventoryTransferRequestWithBody generates requests for PostAPIInventoryTransfer with any type of body
+func NewPostAPIInventoryTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/inventory/transfer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIInventoryTransfersRequest generates requests for GetAPIInventoryTransfers
+func NewGetAPIInventoryTransfersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/inventory/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIItemsRequest generates requests for GetAPIItems
+func NewGetAPIItemsRequest(server string, params *GetAPIItemsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAPIItemsItemRequest calls the generic PutAPIItemsItem builder with application/json body
+func NewPutAPIItemsItemRequest(server string, item string, body PutAPIItemsItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPIItemsItemRequestWithBody(server, item, "application/json", bodyReader)
+}
+
+// NewPutAPIItemsItemRequestWithBody generates requests for PutAPIItemsItem with any type of body
+func NewPutAPIItemsItemRequestWithBody(server string, item string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutAPIItemsItemImageRequestWithBody generates requests for PutAPIItemsItemImage with any type of body
+func NewPutAPIItemsItemImageRequestWithBody(server string, item string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items/%s/image", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAPIItemsItemPurchaseLimitRequest generates requests for DeleteAPIItemsItemPurchaseLimit
+func NewDeleteAPIItemsItemPurchaseLimitRequest(server string, item string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items/%s/purchaseLimit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAPIItemsItemPurchaseLimitRequest calls the generic PutAPIItemsItemPurchaseLimit builder with application/json body
+func NewPutAPIItemsItemPurchaseLimitRequest(server string, item string, body PutAPIItemsItemPurchaseLimitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPIItemsItemPurchaseLimitRequestWithBody(server, item, "application/json", bodyReader)
+}
+
+// NewPutAPIItemsItemPurchaseLimitRequestWithBody generates requests for PutAPIItemsItemPurchaseLimit with any type of body
+func NewPutAPIItemsItemPurchaseLimitRequestWithBody(server string, item string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items/%s/purchaseLimit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIItemsItemVariantsRequest generates requests for GetAPIItemsItemVariants
+func NewGetAPIItemsItemVariantsRequest(server string, item string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items/%s/variants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIItemsItemVariantsRequest calls the generic PostAPIItemsItemVariants builder with application/json body
+func NewPostAPIItemsItemVariantsRequest(server string, item string, body PostAPIItemsItemVariantsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIItemsItemVariantsRequestWithBody(server, item, "application/json", bodyReader)
+}
+
+// NewPostAPIItemsItemVariantsRequestWithBody generates requests for PostAPIItemsItemVariants with any type of body
+func NewPostAPIItemsItemVariantsRequestWithBody(server string, item string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items/%s/variants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutAPIItemsItemVariantsIDRequest calls the generic PutAPIItemsItemVariantsID builder with application/json body
+func NewPutAPIItemsItemVariantsIDRequest(server string, item string, id openapi_types.UUID, body PutAPIItemsItemVariantsIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPIItemsItemVariantsIDRequestWithBody(server, item, id, "application/json", bodyReader)
+}
+
+// NewPutAPIItemsItemVariantsIDRequestWithBody generates requests for PutAPIItemsItemVariantsID with any type of body
+func NewPutAPIItemsItemVariantsIDRequestWithBody(server string, item string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/items/%s/variants/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIKudosRequest generates requests for GetAPIKudos
+func NewGetAPIKudosRequest(server string, params *GetAPIKudosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/kudos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIKudosSettingsRequest generates requests for GetAPIKudosSettings
+func NewGetAPIKudosSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/kudos/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAPIKudosSettingsRequest calls the generic PutAPIKudosSettings builder with application/json body
+func NewPutAPIKudosSettingsRequest(server string, body PutAPIKudosSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPIKudosSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutAPIKudosSettingsRequestWithBody generates requests for PutAPIKudosSettings with any type of body
+func NewPutAPIKudosSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/kudos/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPILeaderboardsKindRequest generates requests for GetAPILeaderboardsKind
+func NewGetAPILeaderboardsKindRequest(server string, kind LeaderboardKind, params *GetAPILeaderboardsKindParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "kind", runtime.ParamLocationPath, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/leaderboards/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPINotificationsRequest generates requests for GetAPINotifications
+func NewGetAPINotificationsRequest(server string, params *GetAPINotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Unread != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPINotificationsReadRequest generates requests for PostAPINotificationsRead
+func NewPostAPINotificationsReadRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/read")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPINotificationsIDReadRequest generates requests for PostAPINotificationsIDRead
+func NewPostAPINotificationsIDReadRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIPaymentRequestsRequest generates requests for GetAPIPaymentRequests
+func NewGetAPIPaymentRequestsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIPaymentRequestsRequest calls the generic PostAPIPaymentRequests builder with application/json body
+func NewPostAPIPaymentRequestsRequest(server string, body PostAPIPaymentRequestsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIPaymentRequestsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIPaymentRequestsRequestWithBody generates requests for PostAPIPaymentRequests with any type of body
+func NewPostAPIPaymentRequestsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAPIPaymentRequestsIDAcceptRequest generates requests for PostAPIPaymentRequestsIDAccept
+func NewPostAPIPaymentRequestsIDAcceptRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests/%s/accept", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIPaymentRequestsIDDeclineRequest generates requests for PostAPIPaymentRequestsIDDecline
+func NewPostAPIPaymentRequestsIDDeclineRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/paymentRequests/%s/decline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIProfileRequest generates requests for GetAPIProfile
+func NewGetAPIProfileRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAPIProfileRequest calls the generic PutAPIProfile builder with application/json body
+func NewPutAPIProfileRequest(server string, body PutAPIProfileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPIProfileRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutAPIProfileRequestWithBody generates requests for PutAPIProfile with any type of body
+func NewPutAPIProfileRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIPromoCodesRequest generates requests for GetAPIPromoCodes
+func NewGetAPIPromoCodesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/promoCodes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIPromoCodesRequest calls the generic PostAPIPromoCodes builder with application/json body
+func NewPostAPIPromoCodesRequest(server string, body PostAPIPromoCodesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIPromoCodesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIPromoCodesRequestWithBody generates requests for PostAPIPromoCodes with any type of body
+func NewPostAPIPromoCodesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/promoCodes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAPIPromoCodesRedeemRequest calls the generic PostAPIPromoCodesRedeem builder with application/json body
+func NewPostAPIPromoCodesRedeemRequest(server string, body PostAPIPromoCodesRedeemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIPromoCodesRedeemRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIPromoCodesRedeemRequestWithBody generates requests for PostAPIPromoCodesRedeem with any type of body
+func NewPostAPIPromoCodesRedeemRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/promoCodes/redeem")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIPromoCodesCodeRedemptionsRequest generates requests for GetAPIPromoCodesCodeRedemptions
+func NewGetAPIPromoCodesCodeRedemptionsRequest(server string, code string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/promoCodes/%s/redemptions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIRafflesRequest generates requests for GetAPIRaffles
+func NewGetAPIRafflesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIRafflesRequest calls the generic PostAPIRaffles builder with application/json body
+func NewPostAPIRafflesRequest(server string, body PostAPIRafflesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIRafflesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIRafflesRequestWithBody generates requests for PostAPIRaffles with any type of body
+func NewPostAPIRafflesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIRafflesIDRequest generates requests for GetAPIRafflesID
+func NewGetAPIRafflesIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIRafflesIDTicketsRequest generates requests for GetAPIRafflesIDTickets
+func NewGetAPIRafflesIDTicketsRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles/%s/tickets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIRafflesIDTicketsRequest calls the generic PostAPIRafflesIDTickets builder with application/json body
+func NewPostAPIRafflesIDTicketsRequest(server string, id openapi_types.UUID, body PostAPIRafflesIDTicketsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIRafflesIDTicketsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewPostAPIRafflesIDTicketsRequestWithBody generates requests for PostAPIRafflesIDTickets with any type of body
+func NewPostAPIRafflesIDTicketsRequestWithBody(server string, id openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/raffles/%s/tickets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIReservationsRequest generates requests for GetAPIReservations
+func NewGetAPIReservationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIReservationsRequest calls the generic PostAPIReservations builder with application/json body
+func NewPostAPIReservationsRequest(server string, body PostAPIReservationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIReservationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIReservationsRequestWithBody generates requests for PostAPIReservations with any type of body
+func NewPostAPIReservationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAPIReservationsIDRequest generates requests for DeleteAPIReservationsID
+func NewDeleteAPIReservationsIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reservations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIReservationsIDPurchaseRequest generates requests for PostAPIReservationsIDPurchase
+func NewPostAPIReservationsIDPurchaseRequest(server string, id openapi_types.UUID, params *PostAPIReservationsIDPurchaseParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/reservations/%s/purchase", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Color != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "color", runtime.ParamLocationQuery, *params.Color); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIScheduledTransfersRequest generates requests for GetAPIScheduledTransfers
+func NewGetAPIScheduledTransfersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/scheduledTransfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIScheduledTransfersRequest calls the generic PostAPIScheduledTransfers builder with application/json body
+func NewPostAPIScheduledTransfersRequest(server string, body PostAPIScheduledTransfersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIScheduledTransfersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIScheduledTransfersRequestWithBody generates requests for PostAPIScheduledTransfers with any type of body
+func NewPostAPIScheduledTransfersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/scheduledTransfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAPIScheduledTransfersIDRequest generates requests for DeleteAPIScheduledTransfersID
+func NewDeleteAPIScheduledTransfersIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/scheduledTransfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPISendCoinRequest calls the generic PostAPISendCoin builder with application/json body
+func NewPostAPISendCoinRequest(server string, body PostAPISendCoinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPISendCoinRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPISendCoinRequestWithBody generates requests for PostAPISendCoin with any type of body
+func NewPostAPISendCoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sendCoin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAPISendCoinBatchRequest calls the generic PostAPISendCoinBatch builder with application/json body
+func NewPostAPISendCoinBatchRequest(server string, body PostAPISendCoinBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPISendCoinBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPISendCoinBatchRequestWithBody generates requests for PostAPISendCoinBatch with any type of body
+func NewPostAPISendCoinBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sendCoin/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPITeamsRequest generates requests for GetAPITeams
+func NewGetAPITeamsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPITeamsRequest calls the generic PostAPITeams builder with application/json body
+func NewPostAPITeamsRequest(server string, body PostAPITeamsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPITeamsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPITeamsRequestWithBody generates requests for PostAPITeams with any type of body
+func NewPostAPITeamsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPITeamsTeamRequest generates requests for GetAPITeamsTeam
+func NewGetAPITeamsTeamRequest(server string, team string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team", runtime.ParamLocationPath, team)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPITeamsTeamFundRequest calls the generic PostAPITeamsTeamFund builder with application/json body
+func NewPostAPITeamsTeamFundRequest(server string, team string, body PostAPITeamsTeamFundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPITeamsTeamFundRequestWithBody(server, team, "application/json", bodyReader)
+}
+
+// NewPostAPITeamsTeamFundRequestWithBody generates requests for PostAPITeamsTeamFund with any type of body
+func NewPostAPITeamsTeamFundRequestWithBody(server string, team string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team", runtime.ParamLocationPath, team)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams/%s/fund", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPITeamsTeamHistoryRequest generates requests for GetAPITeamsTeamHistory
+func NewGetAPITeamsTeamHistoryRequest(server string, team string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team", runtime.ParamLocationPath, team)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAPITeamsTeamMembersUsernameRequest generates requests for DeleteAPITeamsTeamMembersUsername
+func NewDeleteAPITeamsTeamMembersUsernameRequest(server string, team string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team", runtime.ParamLocationPath, team)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAPITeamsTeamMembersUsernameRequest calls the generic PutAPITeamsTeamMembersUsername builder with application/json body
+func NewPutAPITeamsTeamMembersUsernameRequest(server string, team string, username string, body PutAPITeamsTeamMembersUsernameJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPITeamsTeamMembersUsernameRequestWithBody(server, team, username, "application/json", bodyReader)
+}
+
+// NewPutAPITeamsTeamMembersUsernameRequestWithBody generates requests for PutAPITeamsTeamMembersUsername with any type of body
+func NewPutAPITeamsTeamMembersUsernameRequestWithBody(server string, team string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team", runtime.ParamLocationPath, team)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAPITeamsTeamSendCoinRequest calls the generic PostAPITeamsTeamSendCoin builder with application/json body
+func NewPostAPITeamsTeamSendCoinRequest(server string, team string, body PostAPITeamsTeamSendCoinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPITeamsTeamSendCoinRequestWithBody(server, team, "application/json", bodyReader)
+}
+
+// NewPostAPITeamsTeamSendCoinRequestWithBody generates requests for PostAPITeamsTeamSendCoin with any type of body
+func NewPostAPITeamsTeamSendCoinRequestWithBody(server string, team string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "team", runtime.ParamLocationPath, team)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/teams/%s/sendCoin", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPITransferLimitsRequest generates requests for GetAPITransferLimits
+func NewGetAPITransferLimitsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transferLimits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAPITransferLimitsRequest calls the generic PutAPITransferLimits builder with application/json body
+func NewPutAPITransferLimitsRequest(server string, body PutAPITransferLimitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAPITransferLimitsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutAPITransferLimitsRequestWithBody generates requests for PutAPITransferLimits with any type of body
+func NewPutAPITransferLimitsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transferLimits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPITransfersPendingRequest generates requests for GetAPITransfersPending
+func NewGetAPITransfersPendingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transfers/pending")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPITransfersIDApproveRequest generates requests for PostAPITransfersIDApprove
+func NewPostAPITransfersIDApproveRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transfers/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPITransfersIDRejectRequest generates requests for PostAPITransfersIDReject
+func NewPostAPITransfersIDRejectRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/transfers/%s/reject", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIUsersRequest generates requests for GetAPIUsers
+func NewGetAPIUsersRequest(server string, params *GetAPIUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIUsersUsernameRequest generates requests for GetAPIUsersUsername
+func NewGetAPIUsersUsernameRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIUsersUsernameDeactivateRequest generates requests for PostAPIUsersUsernameDeactivate
+func NewPostAPIUsersUsernameDeactivateRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/users/%s/deactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIUsersUsernameReactivateRequest generates requests for PostAPIUsersUsernameReactivate
+func NewPostAPIUsersUsernameReactivateRequest(server string, username string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/users/%s/reactivate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIWebhooksRequest generates requests for GetAPIWebhooks
+func NewGetAPIWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIWebhooksRequest calls the generic PostAPIWebhooks builder with application/json body
+func NewPostAPIWebhooksRequest(server string, body PostAPIWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIWebhooksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIWebhooksRequestWithBody generates requests for PostAPIWebhooks with any type of body
+func NewPostAPIWebhooksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAPIWebhooksDeadLettersRequest generates requests for GetAPIWebhooksDeadLetters
+func NewGetAPIWebhooksDeadLettersRequest(server string, params *GetAPIWebhooksDeadLettersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/dead-letters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIWebhooksDeliveriesIDRetryRequest generates requests for PostAPIWebhooksDeliveriesIDRetry
+func NewPostAPIWebhooksDeliveriesIDRetryRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/deliveries/%s/retry", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAPIWebhooksIDRequest generates requests for DeleteAPIWebhooksID
+func NewDeleteAPIWebhooksIDRequest(server string, id openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAPIWishlistRequest generates requests for GetAPIWishlist
+func NewGetAPIWishlistRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/wishlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAPIWishlistRequest calls the generic PostAPIWishlist builder with application/json body
+func NewPostAPIWishlistRequest(server string, body PostAPIWishlistJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAPIWishlistRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAPIWishlistRequestWithBody generates requests for PostAPIWishlist with any type of body
+func NewPostAPIWishlistRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/wishlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAPIWishlistItemRequest generates requests for DeleteAPIWishlistItem
+func NewDeleteAPIWishlistItemRequest(server string, item string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "item", runtime.ParamLocationPath, item)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/wishlist/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAPIAuctionsWithResponse request
+	GetAPIAuctionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIAuctionsResponse, error)
+
+	// PostAPIAuctionsWithBodyWithResponse request with any body
+	PostAPIAuctionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuctionsResponse, error)
+
+	PostAPIAuctionsWithResponse(ctx context.Context, body PostAPIAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuctionsResponse, error)
+
+	// DeleteAPIAuctionsIDWithResponse request
+	DeleteAPIAuctionsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIAuctionsIDResponse, error)
+
+	// GetAPIAuctionsIDWithResponse request
+	GetAPIAuctionsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIAuctionsIDResponse, error)
+
+	// GetAPIAuctionsIDBidsWithResponse request
+	GetAPIAuctionsIDBidsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIAuctionsIDBidsResponse, error)
+
+	// PostAPIAuctionsIDBidsWithBodyWithResponse request with any body
+	PostAPIAuctionsIDBidsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuctionsIDBidsResponse, error)
+
+	PostAPIAuctionsIDBidsWithResponse(ctx context.Context, id openapi_types.UUID, body PostAPIAuctionsIDBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuctionsIDBidsResponse, error)
+
+	// PostAPIAuthWithBodyWithResponse request with any body
+	PostAPIAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error)
+
+	PostAPIAuthWithResponse(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error)
+
+	// PutAPIBudgetsUsernameWithBodyWithResponse request with any body
+	PutAPIBudgetsUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIBudgetsUsernameResponse, error)
+
+	PutAPIBudgetsUsernameWithResponse(ctx context.Context, username string, body PutAPIBudgetsUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIBudgetsUsernameResponse, error)
+
+	// GetAPIBuyItemWithResponse request
+	GetAPIBuyItemWithResponse(ctx context.Context, item string, params *GetAPIBuyItemParams, reqEditors ...RequestEditorFn) (*GetAPIBuyItemResponse, error)
+
+	// PostAPIBuyItemGiftWithBodyWithResponse request with any body
+	PostAPIBuyItemGiftWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIBuyItemGiftResponse, error)
+
+	PostAPIBuyItemGiftWithResponse(ctx context.Context, item string, body PostAPIBuyItemGiftJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIBuyItemGiftResponse, error)
+
+	// GetAPICampaignsWithResponse request
+	GetAPICampaignsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPICampaignsResponse, error)
+
+	// PostAPICampaignsWithBodyWithResponse request with any body
+	PostAPICampaignsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPICampaignsResponse, error)
+
+	PostAPICampaignsWithResponse(ctx context.Context, body PostAPICampaignsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPICampaignsResponse, error)
+
+	// DeleteAPICampaignsIDWithResponse request
+	DeleteAPICampaignsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPICampaignsIDResponse, error)
+
+	// GetAPIEventsWithResponse request
+	GetAPIEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIEventsResponse, error)
+
+	// GetAPIHealthWithResponse request
+	GetAPIHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIHealthResponse, error)
+
+	// GetAPIImagesKeyWithResponse request
+	GetAPIImagesKeyWithResponse(ctx context.Context, key string, params *GetAPIImagesKeyParams, reqEditors ...RequestEditorFn) (*GetAPIImagesKeyResponse, error)
+
+	// GetAPIInfoWithResponse request
+	GetAPIInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIInfoResponse, error)
+
+	// PostAPIInventoryTransferWithBodyWithResponse request with any body
+	PostAPIInventoryTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIInventoryTransferResponse, error)
+
+	PostAPIInventoryTransferWithResponse(ctx context.Context, body PostAPIInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIInventoryTransferResponse, error)
+
+	// GetAPIInventoryTransfersWithResponse request
+	GetAPIInventoryTransfersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIInventoryTransfersResponse, error)
+
+	// GetAPIItemsWithResponse request
+	GetAPIItemsWithResponse(ctx context.Context, params *GetAPIItemsParams, reqEditors ...RequestEditorFn) (*GetAPIItemsResponse, error)
+
+	// PutAPIItemsItemWithBodyWithResponse request with any body
+	PutAPIItemsItemWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemResponse, error)
+
+	PutAPIItemsItemWithResponse(ctx context.Context, item string, body PutAPIItemsItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIItemsItemResponse, error)
+
+	// PutAPIItemsItemImageWithBodyWithResponse request with any body
+	PutAPIItemsItemImageWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemImageResponse, error)
+
+	// DeleteAPIItemsItemPurchaseLimitWithResponse request
+	DeleteAPIItemsItemPurchaseLimitWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*DeleteAPIItemsItemPurchaseLimitResponse, error)
+
+	// PutAPIItemsItemPurchaseLimitWithBodyWithResponse request with any body
+	PutAPIItemsItemPurchaseLimitWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemPurchaseLimitResponse, error)
+
+	PutAPIItemsItemPurchaseLimitWithResponse(ctx context.Context, item string, body PutAPIItemsItemPurchaseLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIItemsItemPurchaseLimitResponse, error)
+
+	// GetAPIItemsItemVariantsWithResponse request
+	GetAPIItemsItemVariantsWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetAPIItemsItemVariantsResponse, error)
+
+	// PostAPIItemsItemVariantsWithBodyWithResponse request with any body
+	PostAPIItemsItemVariantsWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIItemsItemVariantsResponse, error)
+
+	PostAPIItemsItemVariantsWithResponse(ctx context.Context, item string, body PostAPIItemsItemVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIItemsItemVariantsResponse, error)
+
+	// PutAPIItemsItemVariantsIDWithBodyWithResponse request with any body
+	PutAPIItemsItemVariantsIDWithBodyWithResponse(ctx context.Context, item string, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemVariantsIDResponse, error)
+
+	PutAPIItemsItemVariantsIDWithResponse(ctx context.Context, item string, id openapi_types.UUID, body PutAPIItemsItemVariantsIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIItemsItemVariantsIDResponse, error)
+
+	// GetAPIKudosWithResponse request
+	GetAPIKudosWithResponse(ctx context.Context, params *GetAPIKudosParams, reqEditors ...RequestEditorFn) (*GetAPIKudosResponse, error)
+
+	// GetAPIKudosSettingsWithResponse request
+	GetAPIKudosSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIKudosSettingsResponse, error)
+
+	// PutAPIKudosSettingsWithBodyWithResponse request with any body
+	PutAPIKudosSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIKudosSettingsResponse, error)
+
+	PutAPIKudosSettingsWithResponse(ctx context.Context, body PutAPIKudosSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIKudosSettingsResponse, error)
+
+	// GetAPILeaderboardsKindWithResponse request
+	GetAPILeaderboardsKindWithResponse(ctx context.Context, kind LeaderboardKind, params *GetAPILeaderboardsKindParams, reqEditors ...RequestEditorFn) (*GetAPILeaderboardsKindResponse, error)
+
+	// GetAPINotificationsWithResponse request
+	GetAPINotificationsWithResponse(ctx context.Context, params *GetAPINotificationsParams, reqEditors ...RequestEditorFn) (*GetAPINotificationsResponse, error)
+
+	// PostAPINotificationsReadWithResponse request
+	PostAPINotificationsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAPINotificationsReadResponse, error)
+
+	// PostAPINotificationsIDReadWithResponse request
+	PostAPINotificationsIDReadWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPINotificationsIDReadResponse, error)
+
+	// GetAPIPaymentRequestsWithResponse request
+	GetAPIPaymentRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIPaymentRequestsResponse, error)
+
+	// PostAPIPaymentRequestsWithBodyWithResponse request with any body
+	PostAPIPaymentRequestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsResponse, error)
+
+	PostAPIPaymentRequestsWithResponse(ctx context.Context, body PostAPIPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsResponse, error)
+
+	// PostAPIPaymentRequestsIDAcceptWithResponse request
+	PostAPIPaymentRequestsIDAcceptWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsIDAcceptResponse, error)
+
+	// PostAPIPaymentRequestsIDDeclineWithResponse request
+	PostAPIPaymentRequestsIDDeclineWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsIDDeclineResponse, error)
+
+	// GetAPIProfileWithResponse request
+	GetAPIProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIProfileResponse, error)
+
+	// PutAPIProfileWithBodyWithResponse request with any body
+	PutAPIProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIProfileResponse, error)
+
+	PutAPIProfileWithResponse(ctx context.Context, body PutAPIProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIProfileResponse, error)
+
+	// GetAPIPromoCodesWithResponse request
+	GetAPIPromoCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIPromoCodesResponse, error)
+
+	// PostAPIPromoCodesWithBodyWithResponse request with any body
+	PostAPIPromoCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesResponse, error)
+
+	PostAPIPromoCodesWithResponse(ctx context.Context, body PostAPIPromoCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesResponse, error)
+
+	// PostAPIPromoCodesRedeemWithBodyWithResponse request with any body
+	PostAPIPromoCodesRedeemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesRedeemResponse, error)
+
+	PostAPIPromoCodesRedeemWithResponse(ctx context.Context, body PostAPIPromoCodesRedeemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesRedeemResponse, error)
+
+	// GetAPIPromoCodesCodeRedemptionsWithResponse request
+	GetAPIPromoCodesCodeRedemptionsWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetAPIPromoCodesCodeRedemptionsResponse, error)
+
+	// GetAPIRafflesWithResponse request
+	GetAPIRafflesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIRafflesResponse, error)
+
+	// PostAPIRafflesWithBodyWithResponse request with any body
+	PostAPIRafflesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIRafflesResponse, error)
+
+	PostAPIRafflesWithResponse(ctx context.Context, body PostAPIRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIRafflesResponse, error)
+
+	// GetAPIRafflesIDWithResponse request
+	GetAPIRafflesIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIRafflesIDResponse, error)
+
+	// GetAPIRafflesIDTicketsWithResponse request
+	GetAPIRafflesIDTicketsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIRafflesIDTicketsResponse, error)
+
+	// PostAPIRafflesIDTicketsWithBodyWithResponse request with any body
+	PostAPIRafflesIDTicketsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIRafflesIDTicketsResponse, error)
+
+	PostAPIRafflesIDTicketsWithResponse(ctx context.Context, id openapi_types.UUID, body PostAPIRafflesIDTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIRafflesIDTicketsResponse, error)
+
+	// GetAPIReservationsWithResponse request
+	GetAPIReservationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIReservationsResponse, error)
+
+	// PostAPIReservationsWithBodyWithResponse request with any body
+	PostAPIReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIReservationsResponse, error)
+
+	PostAPIReservationsWithResponse(ctx context.Context, body PostAPIReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIReservationsResponse, error)
+
+	// DeleteAPIReservationsIDWithResponse request
+	DeleteAPIReservationsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIReservationsIDResponse, error)
+
+	// PostAPIReservationsIDPurchaseWithResponse request
+	PostAPIReservationsIDPurchaseWithResponse(ctx context.Context, id openapi_types.UUID, params *PostAPIReservationsIDPurchaseParams, reqEditors ...RequestEditorFn) (*PostAPIReservationsIDPurchaseResponse, error)
+
+	// GetAPIScheduledTransfersWithResponse request
+	GetAPIScheduledTransfersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIScheduledTransfersResponse, error)
+
+	// PostAPIScheduledTransfersWithBodyWithResponse request with any body
+	PostAPIScheduledTransfersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIScheduledTransfersResponse, error)
+
+	PostAPIScheduledTransfersWithResponse(ctx context.Context, body PostAPIScheduledTransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIScheduledTransfersResponse, error)
+
+	// DeleteAPIScheduledTransfersIDWithResponse request
+	DeleteAPIScheduledTransfersIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIScheduledTransfersIDResponse, error)
+
+	// PostAPISendCoinWithBodyWithResponse request with any body
+	PostAPISendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error)
+
+	PostAPISendCoinWithResponse(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error)
+
+	// PostAPISendCoinBatchWithBodyWithResponse request with any body
+	PostAPISendCoinBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPISendCoinBatchResponse, error)
+
+	PostAPISendCoinBatchWithResponse(ctx context.Context, body PostAPISendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPISendCoinBatchResponse, error)
+
+	// GetAPITeamsWithResponse request
+	GetAPITeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPITeamsResponse, error)
+
+	// PostAPITeamsWithBodyWithResponse request with any body
+	PostAPITeamsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPITeamsResponse, error)
+
+	PostAPITeamsWithResponse(ctx context.Context, body PostAPITeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPITeamsResponse, error)
+
+	// GetAPITeamsTeamWithResponse request
+	GetAPITeamsTeamWithResponse(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*GetAPITeamsTeamResponse, error)
+
+	// PostAPITeamsTeamFundWithBodyWithResponse request with any body
+	PostAPITeamsTeamFundWithBodyWithResponse(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamFundResponse, error)
+
+	PostAPITeamsTeamFundWithResponse(ctx context.Context, team string, body PostAPITeamsTeamFundJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamFundResponse, error)
+
+	// GetAPITeamsTeamHistoryWithResponse request
+	GetAPITeamsTeamHistoryWithResponse(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*GetAPITeamsTeamHistoryResponse, error)
+
+	// DeleteAPITeamsTeamMembersUsernameWithResponse request
+	DeleteAPITeamsTeamMembersUsernameWithResponse(ctx context.Context, team string, username string, reqEditors ...RequestEditorFn) (*DeleteAPITeamsTeamMembersUsernameResponse, error)
+
+	// PutAPITeamsTeamMembersUsernameWithBodyWithResponse request with any body
+	PutAPITeamsTeamMembersUsernameWithBodyWithResponse(ctx context.Context, team string, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPITeamsTeamMembersUsernameResponse, error)
+
+	PutAPITeamsTeamMembersUsernameWithResponse(ctx context.Context, team string, username string, body PutAPITeamsTeamMembersUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPITeamsTeamMembersUsernameResponse, error)
+
+	// PostAPITeamsTeamSendCoinWithBodyWithResponse request with any body
+	PostAPITeamsTeamSendCoinWithBodyWithResponse(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamSendCoinResponse, error)
+
+	PostAPITeamsTeamSendCoinWithResponse(ctx context.Context, team string, body PostAPITeamsTeamSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamSendCoinResponse, error)
+
+	// GetAPITransferLimitsWithResponse request
+	GetAPITransferLimitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPITransferLimitsResponse, error)
+
+	// PutAPITransferLimitsWithBodyWithResponse request with any body
+	PutAPITransferLimitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPITransferLimitsResponse, error)
+
+	PutAPITransferLimitsWithResponse(ctx context.Context, body PutAPITransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPITransferLimitsResponse, error)
+
+	// GetAPITransfersPendingWithResponse request
+	GetAPITransfersPendingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPITransfersPendingResponse, error)
+
+	// PostAPITransfersIDApproveWithResponse request
+	PostAPITransfersIDApproveWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPITransfersIDApproveResponse, error)
+
+	// PostAPITransfersIDRejectWithResponse request
+	PostAPITransfersIDRejectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPITransfersIDRejectResponse, error)
+
+	// GetAPIUsersWithResponse request
+	GetAPIUsersWithResponse(ctx context.Context, params *GetAPIUsersParams, reqEditors ...RequestEditorFn) (*GetAPIUsersResponse, error)
+
+	// GetAPIUsersUsernameWithResponse request
+	GetAPIUsersUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetAPIUsersUsernameResponse, error)
+
+	// PostAPIUsersUsernameDeactivateWithResponse request
+	PostAPIUsersUsernameDeactivateWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*PostAPIUsersUsernameDeactivateResponse, error)
+
+	// PostAPIUsersUsernameReactivateWithResponse request
+	PostAPIUsersUsernameReactivateWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*PostAPIUsersUsernameReactivateResponse, error)
+
+	// GetAPIWebhooksWithResponse request
+	GetAPIWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIWebhooksResponse, error)
+
+	// PostAPIWebhooksWithBodyWithResponse request with any body
+	PostAPIWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIWebhooksResponse, error)
+
+	PostAPIWebhooksWithResponse(ctx context.Context, body PostAPIWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIWebhooksResponse, error)
+
+	// GetAPIWebhooksDeadLettersWithResponse request
+	GetAPIWebhooksDeadLettersWithResponse(ctx context.Context, params *GetAPIWebhooksDeadLettersParams, reqEditors ...RequestEditorFn) (*GetAPIWebhooksDeadLettersResponse, error)
+
+	// PostAPIWebhooksDeliveriesIDRetryWithResponse request
+	PostAPIWebhooksDeliveriesIDRetryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPIWebhooksDeliveriesIDRetryResponse, error)
+
+	// DeleteAPIWebhooksIDWithResponse request
+	DeleteAPIWebhooksIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIWebhooksIDResponse, error)
+
+	// GetAPIWishlistWithResponse request
+	GetAPIWishlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIWishlistResponse, error)
+
+	// PostAPIWishlistWithBodyWithResponse request with any body
+	PostAPIWishlistWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIWishlistResponse, error)
+
+	PostAPIWishlistWithResponse(ctx context.Context, body PostAPIWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIWishlistResponse, error)
+
+	// DeleteAPIWishlistItemWithResponse request
+	DeleteAPIWishlistItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*DeleteAPIWishlistItemResponse, error)
+}
+
+type GetAPIAuctionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuctionsResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIAuctionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIAuctionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIAuctionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Auction
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIAuctionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIAuctionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIAuctionsIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIAuctionsIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIAuctionsIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIAuctionsIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Auction
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIAuctionsIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIAuctionsIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIAuctionsIDBidsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuctionBidsResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIAuctionsIDBidsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIAuctionsIDBidsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIAuctionsIDBidsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuctionBid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIAuctionsIDBidsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIAuctionsIDBidsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIAuthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPIBudgetsUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GivingBudget
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPIBudgetsUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPIBudgetsUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIBuyItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIBuyItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIBuyItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIBuyItemGiftResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Gift
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIBuyItemGiftResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIBuyItemGiftResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPICampaignsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CampaignsResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPICampaignsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPICampaignsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPICampaignsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Campaign
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPICampaignsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPICampaignsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPICampaignsIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPICampaignsIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPICampaignsIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIImagesKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIImagesKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIImagesKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InfoResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIInventoryTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferItemResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIInventoryTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIInventoryTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIInventoryTransfersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemTransfersResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIInventoryTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIInventoryTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemsResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPIItemsItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItem
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPIItemsItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPIItemsItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPIItemsItemImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItem
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPIItemsItemImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPIItemsItemImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIItemsItemPurchaseLimitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIItemsItemPurchaseLimitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIItemsItemPurchaseLimitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPIItemsItemPurchaseLimitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseLimit
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPIItemsItemPurchaseLimitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPIItemsItemPurchaseLimitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIItemsItemVariantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemVariantsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIItemsItemVariantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIItemsItemVariantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIItemsItemVariantsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemVariant
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIItemsItemVariantsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIItemsItemVariantsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPIItemsItemVariantsIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemVariant
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPIItemsItemVariantsIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPIItemsItemVariantsIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIKudosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KudosFeedResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIKudosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIKudosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIKudosSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KudosSettings
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIKudosSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIKudosSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPIKudosSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KudosSettings
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPIKudosSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPIKudosSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPILeaderboardsKindResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LeaderboardResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPILeaderboardsKindResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPILeaderboardsKindResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPINotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationsResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPINotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPINotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPINotificationsReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPINotificationsReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPINotificationsReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPINotificationsIDReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPINotificationsIDReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPINotificationsIDReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIPaymentRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentRequestsResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIPaymentRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIPaymentRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIPaymentRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentRequest
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIPaymentRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIPaymentRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIPaymentRequestsIDAcceptResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentRequest
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIPaymentRequestsIDAcceptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIPaymentRequestsIDAcceptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIPaymentRequestsIDDeclineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentRequest
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIPaymentRequestsIDDeclineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIPaymentRequestsIDDeclineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Profile
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPIProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Profile
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPIProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPIProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIPromoCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PromoCodesResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIPromoCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIPromoCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIPromoCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PromoCode
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIPromoCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIPromoCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIPromoCodesRedeemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PromoCodeRedemption
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIPromoCodesRedeemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIPromoCodesRedeemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIPromoCodesCodeRedemptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PromoCodeRedemptionsResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIPromoCodesCodeRedemptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIPromoCodesCodeRedemptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIRafflesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RafflesResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIRafflesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIRafflesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIRafflesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Raffle
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIRafflesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIRafflesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIRafflesIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Raffle
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIRafflesIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIRafflesIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIRafflesIDTicketsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RaffleTicketsResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIRafflesIDTicketsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIRafflesIDTicketsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIRafflesIDTicketsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RaffleTicketsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIRafflesIDTicketsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIRafflesIDTicketsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIReservationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReservationsResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIReservationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Reservation
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIReservationsIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIReservationsIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIReservationsIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIReservationsIDPurchaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIReservationsIDPurchaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIReservationsIDPurchaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIScheduledTransfersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScheduledTransfersResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIScheduledTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIScheduledTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIScheduledTransfersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScheduledTransfer
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIScheduledTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIScheduledTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIScheduledTransfersIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScheduledTransfer
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIScheduledTransfersIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIScheduledTransfersIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPISendCoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Transfer
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPISendCoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPISendCoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPISendCoinBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchSendCoinResponse
+	JSON400      *BatchSendCoinResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPISendCoinBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPISendCoinBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPITeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPITeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPITeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPITeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPITeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPITeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPITeamsTeamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPITeamsTeamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPITeamsTeamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPITeamsTeamFundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPITeamsTeamFundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPITeamsTeamFundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPITeamsTeamHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamHistoryResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPITeamsTeamHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPITeamsTeamHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPITeamsTeamMembersUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPITeamsTeamMembersUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPITeamsTeamMembersUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPITeamsTeamMembersUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamMember
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPITeamsTeamMembersUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPITeamsTeamMembersUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPITeamsTeamSendCoinResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPITeamsTeamSendCoinResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPITeamsTeamSendCoinResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPITransferLimitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferLimits
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPITransferLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPITransferLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAPITransferLimitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferLimits
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAPITransferLimitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAPITransferLimitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPITransfersPendingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PendingTransfersResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPITransfersPendingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPITransfersPendingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPITransfersIDApproveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Transfer
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPITransfersIDApproveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPITransfersIDApproveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPITransfersIDRejectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Transfer
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPITransfersIDRejectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPITransfersIDRejectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSearchResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIUsersUsernameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Profile
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIUsersUsernameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIUsersUsernameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIUsersUsernameDeactivateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIUsersUsernameDeactivateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIUsersUsernameDeactivateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIUsersUsernameReactivateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIUsersUsernameReactivateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIUsersUsernameReactivateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscriptionsResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscription
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIWebhooksDeadLettersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeadLettersResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIWebhooksDeadLettersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIWebhooksDeadLettersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIWebhooksDeliveriesIDRetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIWebhooksDeliveriesIDRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIWebhooksDeliveriesIDRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIWebhooksIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIWebhooksIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIWebhooksIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIWishlistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WishlistResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIWishlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIWishlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIWishlistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WishlistItem
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIWishlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIWishlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIWishlistItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIWishlistItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIWishlistItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAPIAuctionsWithResponse request returning *GetAPIAuctionsResponse
+func (c *ClientWithResponses) GetAPIAuctionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIAuctionsResponse, error) {
+	rsp, err := c.GetAPIAuctions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIAuctionsResponse(rsp)
+}
+
+// PostAPIAuctionsWithBodyWithResponse request with arbitrary body returning *PostAPIAuctionsResponse
+func (c *ClientWithResponses) PostAPIAuctionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuctionsResponse, error) {
+	rsp, err := c.PostAPIAuctionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIAuctionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIAuctionsWithResponse(ctx context.Context, body PostAPIAuctionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuctionsResponse, error) {
+	rsp, err := c.PostAPIAuctions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIAuctionsResponse(rsp)
+}
+
+// DeleteAPIAuctionsIDWithResponse request returning *DeleteAPIAuctionsIDResponse
+func (c *ClientWithResponses) DeleteAPIAuctionsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIAuctionsIDResponse, error) {
+	rsp, err := c.DeleteAPIAuctionsID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIAuctionsIDResponse(rsp)
+}
+
+// GetAPIAuctionsIDWithResponse request returning *GetAPIAuctionsIDResponse
+func (c *ClientWithResponses) GetAPIAuctionsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIAuctionsIDResponse, error) {
+	rsp, err := c.GetAPIAuctionsID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIAuctionsIDResponse(rsp)
+}
+
+// GetAPIAuctionsIDBidsWithResponse request returning *GetAPIAuctionsIDBidsResponse
+func (c *ClientWithResponses) GetAPIAuctionsIDBidsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIAuctionsIDBidsResponse, error) {
+	rsp, err := c.GetAPIAuctionsIDBids(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIAuctionsIDBidsResponse(rsp)
+}
+
+// PostAPIAuctionsIDBidsWithBodyWithResponse request with arbitrary body returning *PostAPIAuctionsIDBidsResponse
+func (c *ClientWithResponses) PostAPIAuctionsIDBidsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuctionsIDBidsResponse, error) {
+	rsp, err := c.PostAPIAuctionsIDBidsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIAuctionsIDBidsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIAuctionsIDBidsWithResponse(ctx context.Context, id openapi_types.UUID, body PostAPIAuctionsIDBidsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuctionsIDBidsResponse, error) {
+	rsp, err := c.PostAPIAuctionsIDBids(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIAuctionsIDBidsResponse(rsp)
+}
+
+// PostAPIAuthWithBodyWithResponse request with arbitrary body returning *PostAPIAuthResponse
+func (c *ClientWithResponses) PostAPIAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error) {
+	rsp, err := c.PostAPIAuthWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIAuthResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIAuthWithResponse(ctx context.Context, body PostAPIAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIAuthResponse, error) {
+	rsp, err := c.PostAPIAuth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIAuthResponse(rsp)
+}
+
+// PutAPIBudgetsUsernameWithBodyWithResponse request with arbitrary body returning *PutAPIBudgetsUsernameResponse
+func (c *ClientWithResponses) PutAPIBudgetsUsernameWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIBudgetsUsernameResponse, error) {
+	rsp, err := c.PutAPIBudgetsUsernameWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIBudgetsUsernameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPIBudgetsUsernameWithResponse(ctx context.Context, username string, body PutAPIBudgetsUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIBudgetsUsernameResponse, error) {
+	rsp, err := c.PutAPIBudgetsUsername(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIBudgetsUsernameResponse(rsp)
+}
+
+// GetAPIBuyItemWithResponse request returning *GetAPIBuyItemResponse
+func (c *ClientWithResponses) GetAPIBuyItemWithResponse(ctx context.Context, item string, params *GetAPIBuyItemParams, reqEditors ...RequestEditorFn) (*GetAPIBuyItemResponse, error) {
+	rsp, err := c.GetAPIBuyItem(ctx, item, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIBuyItemResponse(rsp)
+}
+
+// PostAPIBuyItemGiftWithBodyWithResponse request with arbitrary body returning *PostAPIBuyItemGiftResponse
+func (c *ClientWithResponses) PostAPIBuyItemGiftWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIBuyItemGiftResponse, error) {
+	rsp, err := c.PostAPIBuyItemGiftWithBody(ctx, item, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIBuyItemGiftResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIBuyItemGiftWithResponse(ctx context.Context, item string, body PostAPIBuyItemGiftJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIBuyItemGiftResponse, error) {
+	rsp, err := c.PostAPIBuyItemGift(ctx, item, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIBuyItemGiftResponse(rsp)
+}
+
+// GetAPICampaignsWithResponse request returning *GetAPICampaignsResponse
+func (c *ClientWithResponses) GetAPICampaignsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPICampaignsResponse, error) {
+	rsp, err := c.GetAPICampaigns(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPICampaignsResponse(rsp)
+}
+
+// PostAPICampaignsWithBodyWithResponse request with arbitrary body returning *PostAPICampaignsResponse
+func (c *ClientWithResponses) PostAPICampaignsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPICampaignsResponse, error) {
+	rsp, err := c.PostAPICampaignsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPICampaignsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPICampaignsWithResponse(ctx context.Context, body PostAPICampaignsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPICampaignsResponse, error) {
+	rsp, err := c.PostAPICampaigns(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPICampaignsResponse(rsp)
+}
+
+// DeleteAPICampaignsIDWithResponse request returning *DeleteAPICampaignsIDResponse
+func (c *ClientWithResponses) DeleteAPICampaignsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPICampaignsIDResponse, error) {
+	rsp, err := c.DeleteAPICampaignsID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPICampaignsIDResponse(rsp)
+}
+
+// GetAPIEventsWithResponse request returning *GetAPIEventsResponse
+func (c *ClientWithResponses) GetAPIEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIEventsResponse, error) {
+	rsp, err := c.GetAPIEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIEventsResponse(rsp)
+}
+
+// GetAPIHealthWithResponse request returning *GetAPIHealthResponse
+func (c *ClientWithResponses) GetAPIHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIHealthResponse, error) {
+	rsp, err := c.GetAPIHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIHealthResponse(rsp)
+}
+
+// GetAPIImagesKeyWithResponse request returning *GetAPIImagesKeyResponse
+func (c *ClientWithResponses) GetAPIImagesKeyWithResponse(ctx context.Context, key string, params *GetAPIImagesKeyParams, reqEditors ...RequestEditorFn) (*GetAPIImagesKeyResponse, error) {
+	rsp, err := c.GetAPIImagesKey(ctx, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIImagesKeyResponse(rsp)
+}
+
+// GetAPIInfoWithResponse request returning *GetAPIInfoResponse
+func (c *ClientWithResponses) GetAPIInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIInfoResponse, error) {
+	rsp, err := c.GetAPIInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIInfoResponse(rsp)
+}
+
+// PostAPIInventoryTransferWithBodyWithResponse request with arbitrary body returning *PostAPIInventoryTransferResponse
+func (c *ClientWithResponses) PostAPIInventoryTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIInventoryTransferResponse, error) {
+	rsp, err := c.PostAPIInventoryTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIInventoryTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIInventoryTransferWithResponse(ctx context.Context, body PostAPIInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIInventoryTransferResponse, error) {
+	rsp, err := c.PostAPIInventoryTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIInventoryTransferResponse(rsp)
+}
+
+// GetAPIInventoryTransfersWithResponse request returning *GetAPIInventoryTransfersResponse
+func (c *ClientWithResponses) GetAPIInventoryTransfersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIInventoryTransfersResponse, error) {
+	rsp, err := c.GetAPIInventoryTransfers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIInventoryTransfersResponse(rsp)
+}
+
+// GetAPIItemsWithResponse request returning *GetAPIItemsResponse
+func (c *ClientWithResponses) GetAPIItemsWithResponse(ctx context.Context, params *GetAPIItemsParams, reqEditors ...RequestEditorFn) (*GetAPIItemsResponse, error) {
+	rsp, err := c.GetAPIItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIItemsResponse(rsp)
+}
+
+// PutAPIItemsItemWithBodyWithResponse request with arbitrary body returning *PutAPIItemsItemResponse
+func (c *ClientWithResponses) PutAPIItemsItemWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemResponse, error) {
+	rsp, err := c.PutAPIItemsItemWithBody(ctx, item, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIItemsItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPIItemsItemWithResponse(ctx context.Context, item string, body PutAPIItemsItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIItemsItemResponse, error) {
+	rsp, err := c.PutAPIItemsItem(ctx, item, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIItemsItemResponse(rsp)
+}
+
+// PutAPIItemsItemImageWithBodyWithResponse request with arbitrary body returning *PutAPIItemsItemImageResponse
+func (c *ClientWithResponses) PutAPIItemsItemImageWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemImageResponse, error) {
+	rsp, err := c.PutAPIItemsItemImageWithBody(ctx, item, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIItemsItemImageResponse(rsp)
+}
+
+// DeleteAPIItemsItemPurchaseLimitWithResponse request returning *DeleteAPIItemsItemPurchaseLimitResponse
+func (c *ClientWithResponses) DeleteAPIItemsItemPurchaseLimitWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*DeleteAPIItemsItemPurchaseLimitResponse, error) {
+	rsp, err := c.DeleteAPIItemsItemPurchaseLimit(ctx, item, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIItemsItemPurchaseLimitResponse(rsp)
+}
+
+// PutAPIItemsItemPurchaseLimitWithBodyWithResponse request with arbitrary body returning *PutAPIItemsItemPurchaseLimitResponse
+func (c *ClientWithResponses) PutAPIItemsItemPurchaseLimitWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemPurchaseLimitResponse, error) {
+	rsp, err := c.PutAPIItemsItemPurchaseLimitWithBody(ctx, item, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIItemsItemPurchaseLimitResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPIItemsItemPurchaseLimitWithResponse(ctx context.Context, item string, body PutAPIItemsItemPurchaseLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIItemsItemPurchaseLimitResponse, error) {
+	rsp, err := c.PutAPIItemsItemPurchaseLimit(ctx, item, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIItemsItemPurchaseLimitResponse(rsp)
+}
+
+// GetAPIItemsItemVariantsWithResponse request returning *GetAPIItemsItemVariantsResponse
+func (c *ClientWithResponses) GetAPIItemsItemVariantsWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*GetAPIItemsItemVariantsResponse, error) {
+	rsp, err := c.GetAPIItemsItemVariants(ctx, item, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIItemsItemVariantsResponse(rsp)
+}
+
+// PostAPIItemsItemVariantsWithBodyWithResponse request with arbitrary body returning *PostAPIItemsItemVariantsResponse
+func (c *ClientWithResponses) PostAPIItemsItemVariantsWithBodyWithResponse(ctx context.Context, item string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIItemsItemVariantsResponse, error) {
+	rsp, err := c.PostAPIItemsItemVariantsWithBody(ctx, item, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIItemsItemVariantsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIItemsItemVariantsWithResponse(ctx context.Context, item string, body PostAPIItemsItemVariantsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIItemsItemVariantsResponse, error) {
+	rsp, err := c.PostAPIItemsItemVariants(ctx, item, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIItemsItemVariantsResponse(rsp)
+}
+
+// PutAPIItemsItemVariantsIDWithBodyWithResponse request with arbitrary body returning *PutAPIItemsItemVariantsIDResponse
+func (c *ClientWithResponses) PutAPIItemsItemVariantsIDWithBodyWithResponse(ctx context.Context, item string, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIItemsItemVariantsIDResponse, error) {
+	rsp, err := c.PutAPIItemsItemVariantsIDWithBody(ctx, item, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIItemsItemVariantsIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPIItemsItemVariantsIDWithResponse(ctx context.Context, item string, id openapi_types.UUID, body PutAPIItemsItemVariantsIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIItemsItemVariantsIDResponse, error) {
+	rsp, err := c.PutAPIItemsItemVariantsID(ctx, item, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIItemsItemVariantsIDResponse(rsp)
+}
+
+// GetAPIKudosWithResponse request returning *GetAPIKudosResponse
+func (c *ClientWithResponses) GetAPIKudosWithResponse(ctx context.Context, params *GetAPIKudosParams, reqEditors ...RequestEditorFn) (*GetAPIKudosResponse, error) {
+	rsp, err := c.GetAPIKudos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIKudosResponse(rsp)
+}
+
+// GetAPIKudosSettingsWithResponse request returning *GetAPIKudosSettingsResponse
+func (c *ClientWithResponses) GetAPIKudosSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIKudosSettingsResponse, error) {
+	rsp, err := c.GetAPIKudosSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIKudosSettingsResponse(rsp)
+}
+
+// PutAPIKudosSettingsWithBodyWithResponse request with arbitrary body returning *PutAPIKudosSettingsResponse
+func (c *ClientWithResponses) PutAPIKudosSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIKudosSettingsResponse, error) {
+	rsp, err := c.PutAPIKudosSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIKudosSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPIKudosSettingsWithResponse(ctx context.Context, body PutAPIKudosSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIKudosSettingsResponse, error) {
+	rsp, err := c.PutAPIKudosSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIKudosSettingsResponse(rsp)
+}
+
+// GetAPILeaderboardsKindWithResponse request returning *GetAPILeaderboardsKindResponse
+func (c *ClientWithResponses) GetAPILeaderboardsKindWithResponse(ctx context.Context, kind LeaderboardKind, params *GetAPILeaderboardsKindParams, reqEditors ...RequestEditorFn) (*GetAPILeaderboardsKindResponse, error) {
+	rsp, err := c.GetAPILeaderboardsKind(ctx, kind, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPILeaderboardsKindResponse(rsp)
+}
+
+// GetAPINotificationsWithResponse request returning *GetAPINotificationsResponse
+func (c *ClientWithResponses) GetAPINotificationsWithResponse(ctx context.Context, params *GetAPINotificationsParams, reqEditors ...RequestEditorFn) (*GetAPINotificationsResponse, error) {
+	rsp, err := c.GetAPINotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPINotificationsResponse(rsp)
+}
+
+// PostAPINotificationsReadWithResponse request returning *PostAPINotificationsReadResponse
+func (c *ClientWithResponses) PostAPINotificationsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAPINotificationsReadResponse, error) {
+	rsp, err := c.PostAPINotificationsRead(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPINotificationsReadResponse(rsp)
+}
+
+// PostAPINotificationsIDReadWithResponse request returning *PostAPINotificationsIDReadResponse
+func (c *ClientWithResponses) PostAPINotificationsIDReadWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPINotificationsIDReadResponse, error) {
+	rsp, err := c.PostAPINotificationsIDRead(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPINotificationsIDReadResponse(rsp)
+}
+
+// GetAPIPaymentRequestsWithResponse request returning *GetAPIPaymentRequestsResponse
+func (c *ClientWithResponses) GetAPIPaymentRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIPaymentRequestsResponse, error) {
+	rsp, err := c.GetAPIPaymentRequests(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIPaymentRequestsResponse(rsp)
+}
+
+// PostAPIPaymentRequestsWithBodyWithResponse request with arbitrary body returning *PostAPIPaymentRequestsResponse
+func (c *ClientWithResponses) PostAPIPaymentRequestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsResponse, error) {
+	rsp, err := c.PostAPIPaymentRequestsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPaymentRequestsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIPaymentRequestsWithResponse(ctx context.Context, body PostAPIPaymentRequestsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsResponse, error) {
+	rsp, err := c.PostAPIPaymentRequests(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPaymentRequestsResponse(rsp)
+}
+
+// PostAPIPaymentRequestsIDAcceptWithResponse request returning *PostAPIPaymentRequestsIDAcceptResponse
+func (c *ClientWithResponses) PostAPIPaymentRequestsIDAcceptWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsIDAcceptResponse, error) {
+	rsp, err := c.PostAPIPaymentRequestsIDAccept(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPaymentRequestsIDAcceptResponse(rsp)
+}
+
+// PostAPIPaymentRequestsIDDeclineWithResponse request returning *PostAPIPaymentRequestsIDDeclineResponse
+func (c *ClientWithResponses) PostAPIPaymentRequestsIDDeclineWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPIPaymentRequestsIDDeclineResponse, error) {
+	rsp, err := c.PostAPIPaymentRequestsIDDecline(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPaymentRequestsIDDeclineResponse(rsp)
+}
+
+// GetAPIProfileWithResponse request returning *GetAPIProfileResponse
+func (c *ClientWithResponses) GetAPIProfileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIProfileResponse, error) {
+	rsp, err := c.GetAPIProfile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIProfileResponse(rsp)
+}
+
+// PutAPIProfileWithBodyWithResponse request with arbitrary body returning *PutAPIProfileResponse
+func (c *ClientWithResponses) PutAPIProfileWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPIProfileResponse, error) {
+	rsp, err := c.PutAPIProfileWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIProfileResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPIProfileWithResponse(ctx context.Context, body PutAPIProfileJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPIProfileResponse, error) {
+	rsp, err := c.PutAPIProfile(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPIProfileResponse(rsp)
+}
+
+// GetAPIPromoCodesWithResponse request returning *GetAPIPromoCodesResponse
+func (c *ClientWithResponses) GetAPIPromoCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIPromoCodesResponse, error) {
+	rsp, err := c.GetAPIPromoCodes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIPromoCodesResponse(rsp)
+}
+
+// PostAPIPromoCodesWithBodyWithResponse request with arbitrary body returning *PostAPIPromoCodesResponse
+func (c *ClientWithResponses) PostAPIPromoCodesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesResponse, error) {
+	rsp, err := c.PostAPIPromoCodesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPromoCodesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIPromoCodesWithResponse(ctx context.Context, body PostAPIPromoCodesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesResponse, error) {
+	rsp, err := c.PostAPIPromoCodes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPromoCodesResponse(rsp)
+}
+
+// PostAPIPromoCodesRedeemWithBodyWithResponse request with arbitrary body returning *PostAPIPromoCodesRedeemResponse
+func (c *ClientWithResponses) PostAPIPromoCodesRedeemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesRedeemResponse, error) {
+	rsp, err := c.PostAPIPromoCodesRedeemWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPromoCodesRedeemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIPromoCodesRedeemWithResponse(ctx context.Context, body PostAPIPromoCodesRedeemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIPromoCodesRedeemResponse, error) {
+	rsp, err := c.PostAPIPromoCodesRedeem(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIPromoCodesRedeemResponse(rsp)
+}
+
+// GetAPIPromoCodesCodeRedemptionsWithResponse request returning *GetAPIPromoCodesCodeRedemptionsResponse
+func (c *ClientWithResponses) GetAPIPromoCodesCodeRedemptionsWithResponse(ctx context.Context, code string, reqEditors ...RequestEditorFn) (*GetAPIPromoCodesCodeRedemptionsResponse, error) {
+	rsp, err := c.GetAPIPromoCodesCodeRedemptions(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIPromoCodesCodeRedemptionsResponse(rsp)
+}
+
+// GetAPIRafflesWithResponse request returning *GetAPIRafflesResponse
+func (c *ClientWithResponses) GetAPIRafflesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIRafflesResponse, error) {
+	rsp, err := c.GetAPIRaffles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIRafflesResponse(rsp)
+}
+
+// PostAPIRafflesWithBodyWithResponse request with arbitrary body returning *PostAPIRafflesResponse
+func (c *ClientWithResponses) PostAPIRafflesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIRafflesResponse, error) {
+	rsp, err := c.PostAPIRafflesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIRafflesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIRafflesWithResponse(ctx context.Context, body PostAPIRafflesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIRafflesResponse, error) {
+	rsp, err := c.PostAPIRaffles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIRafflesResponse(rsp)
+}
+
+// GetAPIRafflesIDWithResponse request returning *GetAPIRafflesIDResponse
+func (c *ClientWithResponses) GetAPIRafflesIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIRafflesIDResponse, error) {
+	rsp, err := c.GetAPIRafflesID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIRafflesIDResponse(rsp)
+}
+
+// GetAPIRafflesIDTicketsWithResponse request returning *GetAPIRafflesIDTicketsResponse
+func (c *ClientWithResponses) GetAPIRafflesIDTicketsWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAPIRafflesIDTicketsResponse, error) {
+	rsp, err := c.GetAPIRafflesIDTickets(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIRafflesIDTicketsResponse(rsp)
+}
+
+// PostAPIRafflesIDTicketsWithBodyWithResponse request with arbitrary body returning *PostAPIRafflesIDTicketsResponse
+func (c *ClientWithResponses) PostAPIRafflesIDTicketsWithBodyWithResponse(ctx context.Context, id openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIRafflesIDTicketsResponse, error) {
+	rsp, err := c.PostAPIRafflesIDTicketsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIRafflesIDTicketsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIRafflesIDTicketsWithResponse(ctx context.Context, id openapi_types.UUID, body PostAPIRafflesIDTicketsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIRafflesIDTicketsResponse, error) {
+	rsp, err := c.PostAPIRafflesIDTickets(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIRafflesIDTicketsResponse(rsp)
+}
+
+// GetAPIReservationsWithResponse request returning *GetAPIReservationsResponse
+func (c *ClientWithResponses) GetAPIReservationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIReservationsResponse, error) {
+	rsp, err := c.GetAPIReservations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIReservationsResponse(rsp)
+}
+
+// PostAPIReservationsWithBodyWithResponse request with arbitrary body returning *PostAPIReservationsResponse
+func (c *ClientWithResponses) PostAPIReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIReservationsResponse, error) {
+	rsp, err := c.PostAPIReservationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIReservationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIReservationsWithResponse(ctx context.Context, body PostAPIReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIReservationsResponse, error) {
+	rsp, err := c.PostAPIReservations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIReservationsResponse(rsp)
+}
+
+// DeleteAPIReservationsIDWithResponse request returning *DeleteAPIReservationsIDResponse
+func (c *ClientWithResponses) DeleteAPIReservationsIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIReservationsIDResponse, error) {
+	rsp, err := c.DeleteAPIReservationsID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIReservationsIDResponse(rsp)
+}
+
+// PostAPIReservationsIDPurchaseWithResponse request returning *PostAPIReservationsIDPurchaseResponse
+func (c *ClientWithResponses) PostAPIReservationsIDPurchaseWithResponse(ctx context.Context, id openapi_types.UUID, params *PostAPIReservationsIDPurchaseParams, reqEditors ...RequestEditorFn) (*PostAPIReservationsIDPurchaseResponse, error) {
+	rsp, err := c.PostAPIReservationsIDPurchase(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIReservationsIDPurchaseResponse(rsp)
+}
+
+// GetAPIScheduledTransfersWithResponse request returning *GetAPIScheduledTransfersResponse
+func (c *ClientWithResponses) GetAPIScheduledTransfersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIScheduledTransfersResponse, error) {
+	rsp, err := c.GetAPIScheduledTransfers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIScheduledTransfersResponse(rsp)
+}
+
+// PostAPIScheduledTransfersWithBodyWithResponse request with arbitrary body returning *PostAPIScheduledTransfersResponse
+func (c *ClientWithResponses) PostAPIScheduledTransfersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIScheduledTransfersResponse, error) {
+	rsp, err := c.PostAPIScheduledTransfersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIScheduledTransfersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIScheduledTransfersWithResponse(ctx context.Context, body PostAPIScheduledTransfersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIScheduledTransfersResponse, error) {
+	rsp, err := c.PostAPIScheduledTransfers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIScheduledTransfersResponse(rsp)
+}
+
+// DeleteAPIScheduledTransfersIDWithResponse request returning *DeleteAPIScheduledTransfersIDResponse
+func (c *ClientWithResponses) DeleteAPIScheduledTransfersIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIScheduledTransfersIDResponse, error) {
+	rsp, err := c.DeleteAPIScheduledTransfersID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIScheduledTransfersIDResponse(rsp)
+}
+
+// PostAPISendCoinWithBodyWithResponse request with arbitrary body returning *PostAPISendCoinResponse
+func (c *ClientWithResponses) PostAPISendCoinWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error) {
+	rsp, err := c.PostAPISendCoinWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPISendCoinResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPISendCoinWithResponse(ctx context.Context, body PostAPISendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPISendCoinResponse, error) {
+	rsp, err := c.PostAPISendCoin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPISendCoinResponse(rsp)
+}
+
+// PostAPISendCoinBatchWithBodyWithResponse request with arbitrary body returning *PostAPISendCoinBatchResponse
+func (c *ClientWithResponses) PostAPISendCoinBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPISendCoinBatchResponse, error) {
+	rsp, err := c.PostAPISendCoinBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPISendCoinBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPISendCoinBatchWithResponse(ctx context.Context, body PostAPISendCoinBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPISendCoinBatchResponse, error) {
+	rsp, err := c.PostAPISendCoinBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPISendCoinBatchResponse(rsp)
+}
+
+// GetAPITeamsWithResponse request returning *GetAPITeamsResponse
+func (c *ClientWithResponses) GetAPITeamsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPITeamsResponse, error) {
+	rsp, err := c.GetAPITeams(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPITeamsResponse(rsp)
+}
+
+// PostAPITeamsWithBodyWithResponse request with arbitrary body returning *PostAPITeamsResponse
+func (c *ClientWithResponses) PostAPITeamsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPITeamsResponse, error) {
+	rsp, err := c.PostAPITeamsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITeamsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPITeamsWithResponse(ctx context.Context, body PostAPITeamsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPITeamsResponse, error) {
+	rsp, err := c.PostAPITeams(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITeamsResponse(rsp)
+}
+
+// GetAPITeamsTeamWithResponse request returning *GetAPITeamsTeamResponse
+func (c *ClientWithResponses) GetAPITeamsTeamWithResponse(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*GetAPITeamsTeamResponse, error) {
+	rsp, err := c.GetAPITeamsTeam(ctx, team, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPITeamsTeamResponse(rsp)
+}
+
+// PostAPITeamsTeamFundWithBodyWithResponse request with arbitrary body returning *PostAPITeamsTeamFundResponse
+func (c *ClientWithResponses) PostAPITeamsTeamFundWithBodyWithResponse(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamFundResponse, error) {
+	rsp, err := c.PostAPITeamsTeamFundWithBody(ctx, team, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITeamsTeamFundResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPITeamsTeamFundWithResponse(ctx context.Context, team string, body PostAPITeamsTeamFundJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamFundResponse, error) {
+	rsp, err := c.PostAPITeamsTeamFund(ctx, team, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITeamsTeamFundResponse(rsp)
+}
+
+// GetAPITeamsTeamHistoryWithResponse request returning *GetAPITeamsTeamHistoryResponse
+func (c *ClientWithResponses) GetAPITeamsTeamHistoryWithResponse(ctx context.Context, team string, reqEditors ...RequestEditorFn) (*GetAPITeamsTeamHistoryResponse, error) {
+	rsp, err := c.GetAPITeamsTeamHistory(ctx, team, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPITeamsTeamHistoryResponse(rsp)
+}
+
+// DeleteAPITeamsTeamMembersUsernameWithResponse request returning *DeleteAPITeamsTeamMembersUsernameResponse
+func (c *ClientWithResponses) DeleteAPITeamsTeamMembersUsernameWithResponse(ctx context.Context, team string, username string, reqEditors ...RequestEditorFn) (*DeleteAPITeamsTeamMembersUsernameResponse, error) {
+	rsp, err := c.DeleteAPITeamsTeamMembersUsername(ctx, team, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPITeamsTeamMembersUsernameResponse(rsp)
+}
+
+// PutAPITeamsTeamMembersUsernameWithBodyWithResponse request with arbitrary body returning *PutAPITeamsTeamMembersUsernameResponse
+func (c *ClientWithResponses) PutAPITeamsTeamMembersUsernameWithBodyWithResponse(ctx context.Context, team string, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPITeamsTeamMembersUsernameResponse, error) {
+	rsp, err := c.PutAPITeamsTeamMembersUsernameWithBody(ctx, team, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPITeamsTeamMembersUsernameResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPITeamsTeamMembersUsernameWithResponse(ctx context.Context, team string, username string, body PutAPITeamsTeamMembersUsernameJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPITeamsTeamMembersUsernameResponse, error) {
+	rsp, err := c.PutAPITeamsTeamMembersUsername(ctx, team, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPITeamsTeamMembersUsernameResponse(rsp)
+}
+
+// PostAPITeamsTeamSendCoinWithBodyWithResponse request with arbitrary body returning *PostAPITeamsTeamSendCoinResponse
+func (c *ClientWithResponses) PostAPITeamsTeamSendCoinWithBodyWithResponse(ctx context.Context, team string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamSendCoinResponse, error) {
+	rsp, err := c.PostAPITeamsTeamSendCoinWithBody(ctx, team, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITeamsTeamSendCoinResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPITeamsTeamSendCoinWithResponse(ctx context.Context, team string, body PostAPITeamsTeamSendCoinJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPITeamsTeamSendCoinResponse, error) {
+	rsp, err := c.PostAPITeamsTeamSendCoin(ctx, team, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITeamsTeamSendCoinResponse(rsp)
+}
+
+// GetAPITransferLimitsWithResponse request returning *GetAPITransferLimitsResponse
+func (c *ClientWithResponses) GetAPITransferLimitsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPITransferLimitsResponse, error) {
+	rsp, err := c.GetAPITransferLimits(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPITransferLimitsResponse(rsp)
+}
+
+// PutAPITransferLimitsWithBodyWithResponse request with arbitrary body returning *PutAPITransferLimitsResponse
+func (c *ClientWithResponses) PutAPITransferLimitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAPITransferLimitsResponse, error) {
+	rsp, err := c.PutAPITransferLimitsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPITransferLimitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAPITransferLimitsWithResponse(ctx context.Context, body PutAPITransferLimitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAPITransferLimitsResponse, error) {
+	rsp, err := c.PutAPITransferLimits(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAPITransferLimitsResponse(rsp)
+}
+
+// GetAPITransfersPendingWithResponse request returning *GetAPITransfersPendingResponse
+func (c *ClientWithResponses) GetAPITransfersPendingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPITransfersPendingResponse, error) {
+	rsp, err := c.GetAPITransfersPending(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPITransfersPendingResponse(rsp)
+}
+
+// PostAPITransfersIDApproveWithResponse request returning *PostAPITransfersIDApproveResponse
+func (c *ClientWithResponses) PostAPITransfersIDApproveWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPITransfersIDApproveResponse, error) {
+	rsp, err := c.PostAPITransfersIDApprove(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITransfersIDApproveResponse(rsp)
+}
+
+// PostAPITransfersIDRejectWithResponse request returning *PostAPITransfersIDRejectResponse
+func (c *ClientWithResponses) PostAPITransfersIDRejectWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPITransfersIDRejectResponse, error) {
+	rsp, err := c.PostAPITransfersIDReject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPITransfersIDRejectResponse(rsp)
+}
+
+// GetAPIUsersWithResponse request returning *GetAPIUsersResponse
+func (c *ClientWithResponses) GetAPIUsersWithResponse(ctx context.Context, params *GetAPIUsersParams, reqEditors ...RequestEditorFn) (*GetAPIUsersResponse, error) {
+	rsp, err := c.GetAPIUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIUsersResponse(rsp)
+}
+
+// GetAPIUsersUsernameWithResponse request returning *GetAPIUsersUsernameResponse
+func (c *ClientWithResponses) GetAPIUsersUsernameWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*GetAPIUsersUsernameResponse, error) {
+	rsp, err := c.GetAPIUsersUsername(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIUsersUsernameResponse(rsp)
+}
+
+// PostAPIUsersUsernameDeactivateWithResponse request returning *PostAPIUsersUsernameDeactivateResponse
+func (c *ClientWithResponses) PostAPIUsersUsernameDeactivateWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*PostAPIUsersUsernameDeactivateResponse, error) {
+	rsp, err := c.PostAPIUsersUsernameDeactivate(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIUsersUsernameDeactivateResponse(rsp)
+}
+
+// PostAPIUsersUsernameReactivateWithResponse request returning *PostAPIUsersUsernameReactivateResponse
+func (c *ClientWithResponses) PostAPIUsersUsernameReactivateWithResponse(ctx context.Context, username string, reqEditors ...RequestEditorFn) (*PostAPIUsersUsernameReactivateResponse, error) {
+	rsp, err := c.PostAPIUsersUsernameReactivate(ctx, username, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIUsersUsernameReactivateResponse(rsp)
+}
+
+// GetAPIWebhooksWithResponse request returning *GetAPIWebhooksResponse
+func (c *ClientWithResponses) GetAPIWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIWebhooksResponse, error) {
+	rsp, err := c.GetAPIWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIWebhooksResponse(rsp)
+}
+
+// PostAPIWebhooksWithBodyWithResponse request with arbitrary body returning *PostAPIWebhooksResponse
+func (c *ClientWithResponses) PostAPIWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIWebhooksResponse, error) {
+	rsp, err := c.PostAPIWebhooksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIWebhooksWithResponse(ctx context.Context, body PostAPIWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIWebhooksResponse, error) {
+	rsp, err := c.PostAPIWebhooks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIWebhooksResponse(rsp)
+}
+
+// GetAPIWebhooksDeadLettersWithResponse request returning *GetAPIWebhooksDeadLettersResponse
+func (c *ClientWithResponses) GetAPIWebhooksDeadLettersWithResponse(ctx context.Context, params *GetAPIWebhooksDeadLettersParams, reqEditors ...RequestEditorFn) (*GetAPIWebhooksDeadLettersResponse, error) {
+	rsp, err := c.GetAPIWebhooksDeadLetters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIWebhooksDeadLettersResponse(rsp)
+}
+
+// PostAPIWebhooksDeliveriesIDRetryWithResponse request returning *PostAPIWebhooksDeliveriesIDRetryResponse
+func (c *ClientWithResponses) PostAPIWebhooksDeliveriesIDRetryWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostAPIWebhooksDeliveriesIDRetryResponse, error) {
+	rsp, err := c.PostAPIWebhooksDeliveriesIDRetry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIWebhooksDeliveriesIDRetryResponse(rsp)
+}
+
+// DeleteAPIWebhooksIDWithResponse request returning *DeleteAPIWebhooksIDResponse
+func (c *ClientWithResponses) DeleteAPIWebhooksIDWithResponse(ctx context.Context, id openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteAPIWebhooksIDResponse, error) {
+	rsp, err := c.DeleteAPIWebhooksID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIWebhooksIDResponse(rsp)
+}
+
+// GetAPIWishlistWithResponse request returning *GetAPIWishlistResponse
+func (c *ClientWithResponses) GetAPIWishlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAPIWishlistResponse, error) {
+	rsp, err := c.GetAPIWishlist(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIWishlistResponse(rsp)
+}
+
+// PostAPIWishlistWithBodyWithResponse request with arbitrary body returning *PostAPIWishlistResponse
+func (c *ClientWithResponses) PostAPIWishlistWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIWishlistResponse, error) {
+	rsp, err := c.PostAPIWishlistWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIWishlistResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIWishlistWithResponse(ctx context.Context, body PostAPIWishlistJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIWishlistResponse, error) {
+	rsp, err := c.PostAPIWishlist(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIWishlistResponse(rsp)
+}
+
+// DeleteAPIWishlistItemWithResponse request returning *DeleteAPIWishlistItemResponse
+func (c *ClientWithResponses) DeleteAPIWishlistItemWithResponse(ctx context.Context, item string, reqEditors ...RequestEditorFn) (*DeleteAPIWishlistItemResponse, error) {
+	rsp, err := c.DeleteAPIWishlistItem(ctx, item, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIWishlistItemResponse(rsp)
+}
+
+// ParseGetAPIAuctionsResponse parses an HTTP response from a GetAPIAuctionsWithResponse call
+func ParseGetAPIAuctionsResponse(rsp *http.Response) (*GetAPIAuctionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIAuctionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuctionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIAuctionsResponse parses an HTTP response from a PostAPIAuctionsWithResponse call
+func ParsePostAPIAuctionsResponse(rsp *http.Response) (*PostAPIAuctionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIAuctionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Auction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIAuctionsIDResponse parses an HTTP response from a DeleteAPIAuctionsIDWithResponse call
+func ParseDeleteAPIAuctionsIDResponse(rsp *http.Response) (*DeleteAPIAuctionsIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIAuctionsIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIAuctionsIDResponse parses an HTTP response from a GetAPIAuctionsIDWithResponse call
+func ParseGetAPIAuctionsIDResponse(rsp *http.Response) (*GetAPIAuctionsIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIAuctionsIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Auction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIAuctionsIDBidsResponse parses an HTTP response from a GetAPIAuctionsIDBidsWithResponse call
+func ParseGetAPIAuctionsIDBidsResponse(rsp *http.Response) (*GetAPIAuctionsIDBidsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIAuctionsIDBidsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuctionBidsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIAuctionsIDBidsResponse parses an HTTP response from a PostAPIAuctionsIDBidsWithResponse call
+func ParsePostAPIAuctionsIDBidsResponse(rsp *http.Response) (*PostAPIAuctionsIDBidsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIAuctionsIDBidsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuctionBid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIAuthResponse parses an HTTP response from a PostAPIAuthWithResponse call
+func ParsePostAPIAuthResponse(rsp *http.Response) (*PostAPIAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPIBudgetsUsernameResponse parses an HTTP response from a PutAPIBudgetsUsernameWithResponse call
+func ParsePutAPIBudgetsUsernameResponse(rsp *http.Response) (*PutAPIBudgetsUsernameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPIBudgetsUsernameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GivingBudget
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIBuyItemResponse parses an HTTP response from a GetAPIBuyItemWithResponse call
+func ParseGetAPIBuyItemResponse(rsp *http.Response) (*GetAPIBuyItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIBuyItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIBuyItemGiftResponse parses an HTTP response from a PostAPIBuyItemGiftWithResponse call
+func ParsePostAPIBuyItemGiftResponse(rsp *http.Response) (*PostAPIBuyItemGiftResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIBuyItemGiftResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Gift
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPICampaignsResponse parses an HTTP response from a GetAPICampaignsWithResponse call
+func ParseGetAPICampaignsResponse(rsp *http.Response) (*GetAPICampaignsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPICampaignsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CampaignsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPICampaignsResponse parses an HTTP response from a PostAPICampaignsWithResponse call
+func ParsePostAPICampaignsResponse(rsp *http.Response) (*PostAPICampaignsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPICampaignsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Campaign
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPICampaignsIDResponse parses an HTTP response from a DeleteAPICampaignsIDWithResponse call
+func ParseDeleteAPICampaignsIDResponse(rsp *http.Response) (*DeleteAPICampaignsIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPICampaignsIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIEventsResponse parses an HTTP response from a GetAPIEventsWithResponse call
+func ParseGetAPIEventsResponse(rsp *http.Response) (*GetAPIEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIHealthResponse parses an HTTP response from a GetAPIHealthWithResponse call
+func ParseGetAPIHealthResponse(rsp *http.Response) (*GetAPIHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIImagesKeyResponse parses an HTTP response from a GetAPIImagesKeyWithResponse call
+func ParseGetAPIImagesKeyResponse(rsp *http.Response) (*GetAPIImagesKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIImagesKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIInfoResponse parses an HTTP response from a GetAPIInfoWithResponse call
+func ParseGetAPIInfoResponse(rsp *http.Response) (*GetAPIInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIInventoryTransferResponse parses an HTTP response from a PostAPIInventoryTransferWithResponse call
+func ParsePostAPIInventoryTransferResponse(rsp *http.Response) (*PostAPIInventoryTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIInventoryTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIInventoryTransfersResponse parses an HTTP response from a GetAPIInventoryTransfersWithResponse call
+func ParseGetAPIInventoryTransfersResponse(rsp *http.Response) (*GetAPIInventoryTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIInventoryTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemTransfersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIItemsResponse parses an HTTP response from a GetAPIItemsWithResponse call
+func ParseGetAPIItemsResponse(rsp *http.Response) (*GetAPIItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPIItemsItemResponse parses an HTTP response from a PutAPIItemsItemWithResponse call
+func ParsePutAPIItemsItemResponse(rsp *http.Response) (*PutAPIItemsItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPIItemsItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPIItemsItemImageResponse parses an HTTP response from a PutAPIItemsItemImageWithResponse call
+func ParsePutAPIItemsItemImageResponse(rsp *http.Response) (*PutAPIItemsItemImageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPIItemsItemImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIItemsItemPurchaseLimitResponse parses an HTTP response from a DeleteAPIItemsItemPurchaseLimitWithResponse call
+func ParseDeleteAPIItemsItemPurchaseLimitResponse(rsp *http.Response) (*DeleteAPIItemsItemPurchaseLimitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIItemsItemPurchaseLimitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPIItemsItemPurchaseLimitResponse parses an HTTP response from a PutAPIItemsItemPurchaseLimitWithResponse call
+func ParsePutAPIItemsItemPurchaseLimitResponse(rsp *http.Response) (*PutAPIItemsItemPurchaseLimitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPIItemsItemPurchaseLimitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIItemsItemVariantsResponse parses an HTTP response from a GetAPIItemsItemVariantsWithResponse call
+func ParseGetAPIItemsItemVariantsResponse(rsp *http.Response) (*GetAPIItemsItemVariantsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIItemsItemVariantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemVariantsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIItemsItemVariantsResponse parses an HTTP response from a PostAPIItemsItemVariantsWithResponse call
+func ParsePostAPIItemsItemVariantsResponse(rsp *http.Response) (*PostAPIItemsItemVariantsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIItemsItemVariantsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemVariant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPIItemsItemVariantsIDResponse parses an HTTP response from a PutAPIItemsItemVariantsIDWithResponse call
+func ParsePutAPIItemsItemVariantsIDResponse(rsp *http.Response) (*PutAPIItemsItemVariantsIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPIItemsItemVariantsIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemVariant
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIKudosResponse parses an HTTP response from a GetAPIKudosWithResponse call
+func ParseGetAPIKudosResponse(rsp *http.Response) (*GetAPIKudosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIKudosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KudosFeedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIKudosSettingsResponse parses an HTTP response from a GetAPIKudosSettingsWithResponse call
+func ParseGetAPIKudosSettingsResponse(rsp *http.Response) (*GetAPIKudosSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIKudosSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KudosSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPIKudosSettingsResponse parses an HTTP response from a PutAPIKudosSettingsWithResponse call
+func ParsePutAPIKudosSettingsResponse(rsp *http.Response) (*PutAPIKudosSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPIKudosSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KudosSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPILeaderboardsKindResponse parses an HTTP response from a GetAPILeaderboardsKindWithResponse call
+func ParseGetAPILeaderboardsKindResponse(rsp *http.Response) (*GetAPILeaderboardsKindResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPILeaderboardsKindResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LeaderboardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPINotificationsResponse parses an HTTP response from a GetAPINotificationsWithResponse call
+func ParseGetAPINotificationsResponse(rsp *http.Response) (*GetAPINotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPINotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPINotificationsReadResponse parses an HTTP response from a PostAPINotificationsReadWithResponse call
+func ParsePostAPINotificationsReadResponse(rsp *http.Response) (*PostAPINotificationsReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPINotificationsReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPINotificationsIDReadResponse parses an HTTP response from a PostAPINotificationsIDReadWithResponse call
+func ParsePostAPINotificationsIDReadResponse(rsp *http.Response) (*PostAPINotificationsIDReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPINotificationsIDReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIPaymentRequestsResponse parses an HTTP response from a GetAPIPaymentRequestsWithResponse call
+func ParseGetAPIPaymentRequestsResponse(rsp *http.Response) (*GetAPIPaymentRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIPaymentRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIPaymentRequestsResponse parses an HTTP response from a PostAPIPaymentRequestsWithResponse call
+func ParsePostAPIPaymentRequestsResponse(rsp *http.Response) (*PostAPIPaymentRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIPaymentRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIPaymentRequestsIDAcceptResponse parses an HTTP response from a PostAPIPaymentRequestsIDAcceptWithResponse call
+func ParsePostAPIPaymentRequestsIDAcceptResponse(rsp *http.Response) (*PostAPIPaymentRequestsIDAcceptResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIPaymentRequestsIDAcceptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIPaymentRequestsIDDeclineResponse parses an HTTP response from a PostAPIPaymentRequestsIDDeclineWithResponse call
+func ParsePostAPIPaymentRequestsIDDeclineResponse(rsp *http.Response) (*PostAPIPaymentRequestsIDDeclineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIPaymentRequestsIDDeclineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIProfileResponse parses an HTTP response from a GetAPIProfileWithResponse call
+func ParseGetAPIProfileResponse(rsp *http.Response) (*GetAPIProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPIProfileResponse parses an HTTP response from a PutAPIProfileWithResponse call
+func ParsePutAPIProfileResponse(rsp *http.Response) (*PutAPIProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPIProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIPromoCodesResponse parses an HTTP response from a GetAPIPromoCodesWithResponse call
+func ParseGetAPIPromoCodesResponse(rsp *http.Response) (*GetAPIPromoCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIPromoCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PromoCodesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIPromoCodesResponse parses an HTTP response from a PostAPIPromoCodesWithResponse call
+func ParsePostAPIPromoCodesResponse(rsp *http.Response) (*PostAPIPromoCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIPromoCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PromoCode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIPromoCodesRedeemResponse parses an HTTP response from a PostAPIPromoCodesRedeemWithResponse call
+func ParsePostAPIPromoCodesRedeemResponse(rsp *http.Response) (*PostAPIPromoCodesRedeemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIPromoCodesRedeemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PromoCodeRedemption
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIPromoCodesCodeRedemptionsResponse parses an HTTP response from a GetAPIPromoCodesCodeRedemptionsWithResponse call
+func ParseGetAPIPromoCodesCodeRedemptionsResponse(rsp *http.Response) (*GetAPIPromoCodesCodeRedemptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIPromoCodesCodeRedemptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PromoCodeRedemptionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIRafflesResponse parses an HTTP response from a GetAPIRafflesWithResponse call
+func ParseGetAPIRafflesResponse(rsp *http.Response) (*GetAPIRafflesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIRafflesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RafflesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIRafflesResponse parses an HTTP response from a PostAPIRafflesWithResponse call
+func ParsePostAPIRafflesResponse(rsp *http.Response) (*PostAPIRafflesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIRafflesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Raffle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIRafflesIDResponse parses an HTTP response from a GetAPIRafflesIDWithResponse call
+func ParseGetAPIRafflesIDResponse(rsp *http.Response) (*GetAPIRafflesIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIRafflesIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Raffle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIRafflesIDTicketsResponse parses an HTTP response from a GetAPIRafflesIDTicketsWithResponse call
+func ParseGetAPIRafflesIDTicketsResponse(rsp *http.Response) (*GetAPIRafflesIDTicketsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIRafflesIDTicketsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RaffleTicketsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIRafflesIDTicketsResponse parses an HTTP response from a PostAPIRafflesIDTicketsWithResponse call
+func ParsePostAPIRafflesIDTicketsResponse(rsp *http.Response) (*PostAPIRafflesIDTicketsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIRafflesIDTicketsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RaffleTicketsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIReservationsResponse parses an HTTP response from a GetAPIReservationsWithResponse call
+func ParseGetAPIReservationsResponse(rsp *http.Response) (*GetAPIReservationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIReservationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReservationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIReservationsResponse parses an HTTP response from a PostAPIReservationsWithResponse call
+func ParsePostAPIReservationsResponse(rsp *http.Response) (*PostAPIReservationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIReservationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Reservation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIReservationsIDResponse parses an HTTP response from a DeleteAPIReservationsIDWithResponse call
+func ParseDeleteAPIReservationsIDResponse(rsp *http.Response) (*DeleteAPIReservationsIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIReservationsIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIReservationsIDPurchaseResponse parses an HTTP response from a PostAPIReservationsIDPurchaseWithResponse call
+func ParsePostAPIReservationsIDPurchaseResponse(rsp *http.Response) (*PostAPIReservationsIDPurchaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIReservationsIDPurchaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIScheduledTransfersResponse parses an HTTP response from a GetAPIScheduledTransfersWithResponse call
+func ParseGetAPIScheduledTransfersResponse(rsp *http.Response) (*GetAPIScheduledTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIScheduledTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTransfersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIScheduledTransfersResponse parses an HTTP response from a PostAPIScheduledTransfersWithResponse call
+func ParsePostAPIScheduledTransfersResponse(rsp *http.Response) (*PostAPIScheduledTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIScheduledTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIScheduledTransfersIDResponse parses an HTTP response from a DeleteAPIScheduledTransfersIDWithResponse call
+func ParseDeleteAPIScheduledTransfersIDResponse(rsp *http.Response) (*DeleteAPIScheduledTransfersIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIScheduledTransfersIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduledTransfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPISendCoinResponse parses an HTTP response from a PostAPISendCoinWithResponse call
+func ParsePostAPISendCoinResponse(rsp *http.Response) (*PostAPISendCoinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPISendCoinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Transfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPISendCoinBatchResponse parses an HTTP response from a PostAPISendCoinBatchWithResponse call
+func ParsePostAPISendCoinBatchResponse(rsp *http.Response) (*PostAPISendCoinBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPISendCoinBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchSendCoinResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BatchSendCoinResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPITeamsResponse parses an HTTP response from a GetAPITeamsWithResponse call
+func ParseGetAPITeamsResponse(rsp *http.Response) (*GetAPITeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPITeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPITeamsResponse parses an HTTP response from a PostAPITeamsWithResponse call
+func ParsePostAPITeamsResponse(rsp *http.Response) (*PostAPITeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPITeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPITeamsTeamResponse parses an HTTP response from a GetAPITeamsTeamWithResponse call
+func ParseGetAPITeamsTeamResponse(rsp *http.Response) (*GetAPITeamsTeamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPITeamsTeamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPITeamsTeamFundResponse parses an HTTP response from a PostAPITeamsTeamFundWithResponse call
+func ParsePostAPITeamsTeamFundResponse(rsp *http.Response) (*PostAPITeamsTeamFundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPITeamsTeamFundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPITeamsTeamHistoryResponse parses an HTTP response from a GetAPITeamsTeamHistoryWithResponse call
+func ParseGetAPITeamsTeamHistoryResponse(rsp *http.Response) (*GetAPITeamsTeamHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPITeamsTeamHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamHistoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPITeamsTeamMembersUsernameResponse parses an HTTP response from a DeleteAPITeamsTeamMembersUsernameWithResponse call
+func ParseDeleteAPITeamsTeamMembersUsernameResponse(rsp *http.Response) (*DeleteAPITeamsTeamMembersUsernameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPITeamsTeamMembersUsernameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPITeamsTeamMembersUsernameResponse parses an HTTP response from a PutAPITeamsTeamMembersUsernameWithResponse call
+func ParsePutAPITeamsTeamMembersUsernameResponse(rsp *http.Response) (*PutAPITeamsTeamMembersUsernameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPITeamsTeamMembersUsernameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamMember
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPITeamsTeamSendCoinResponse parses an HTTP response from a PostAPITeamsTeamSendCoinWithResponse call
+func ParsePostAPITeamsTeamSendCoinResponse(rsp *http.Response) (*PostAPITeamsTeamSendCoinResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPITeamsTeamSendCoinResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPITransferLimitsResponse parses an HTTP response from a GetAPITransferLimitsWithResponse call
+func ParseGetAPITransferLimitsResponse(rsp *http.Response) (*GetAPITransferLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPITransferLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferLimits
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAPITransferLimitsResponse parses an HTTP response from a PutAPITransferLimitsWithResponse call
+func ParsePutAPITransferLimitsResponse(rsp *http.Response) (*PutAPITransferLimitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAPITransferLimitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferLimits
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPITransfersPendingResponse parses an HTTP response from a GetAPITransfersPendingWithResponse call
+func ParseGetAPITransfersPendingResponse(rsp *http.Response) (*GetAPITransfersPendingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPITransfersPendingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PendingTransfersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPITransfersIDApproveResponse parses an HTTP response from a PostAPITransfersIDApproveWithResponse call
+func ParsePostAPITransfersIDApproveResponse(rsp *http.Response) (*PostAPITransfersIDApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPITransfersIDApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPITransfersIDRejectResponse parses an HTTP response from a PostAPITransfersIDRejectWithResponse call
+func ParsePostAPITransfersIDRejectResponse(rsp *http.Response) (*PostAPITransfersIDRejectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPITransfersIDRejectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Transfer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIUsersResponse parses an HTTP response from a GetAPIUsersWithResponse call
+func ParseGetAPIUsersResponse(rsp *http.Response) (*GetAPIUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIUsersUsernameResponse parses an HTTP response from a GetAPIUsersUsernameWithResponse call
+func ParseGetAPIUsersUsernameResponse(rsp *http.Response) (*GetAPIUsersUsernameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIUsersUsernameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIUsersUsernameDeactivateResponse parses an HTTP response from a PostAPIUsersUsernameDeactivateWithResponse call
+func ParsePostAPIUsersUsernameDeactivateResponse(rsp *http.Response) (*PostAPIUsersUsernameDeactivateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIUsersUsernameDeactivateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIUsersUsernameReactivateResponse parses an HTTP response from a PostAPIUsersUsernameReactivateWithResponse call
+func ParsePostAPIUsersUsernameReactivateResponse(rsp *http.Response) (*PostAPIUsersUsernameReactivateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIUsersUsernameReactivateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIWebhooksResponse parses an HTTP response from a GetAPIWebhooksWithResponse call
+func ParseGetAPIWebhooksResponse(rsp *http.Response) (*GetAPIWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscriptionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIWebhooksResponse parses an HTTP response from a PostAPIWebhooksWithResponse call
+func ParsePostAPIWebhooksResponse(rsp *http.Response) (*PostAPIWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIWebhooksDeadLettersResponse parses an HTTP response from a GetAPIWebhooksDeadLettersWithResponse call
+func ParseGetAPIWebhooksDeadLettersResponse(rsp *http.Response) (*GetAPIWebhooksDeadLettersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIWebhooksDeadLettersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeadLettersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIWebhooksDeliveriesIDRetryResponse parses an HTTP response from a PostAPIWebhooksDeliveriesIDRetryWithResponse call
+func ParsePostAPIWebhooksDeliveriesIDRetryResponse(rsp *http.Response) (*PostAPIWebhooksDeliveriesIDRetryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIWebhooksDeliveriesIDRetryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIWebhooksIDResponse parses an HTTP response from a DeleteAPIWebhooksIDWithResponse call
+func ParseDeleteAPIWebhooksIDResponse(rsp *http.Response) (*DeleteAPIWebhooksIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIWebhooksIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIWishlistResponse parses an HTTP response from a GetAPIWishlistWithResponse call
+func ParseGetAPIWishlistResponse(rsp *http.Response) (*GetAPIWishlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIWishlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WishlistResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIWishlistResponse parses an HTTP response from a PostAPIWishlistWithResponse call
+func ParsePostAPIWishlistResponse(rsp *http.Response) (*PostAPIWishlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIWishlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WishlistItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIWishlistItemResponse parses an HTTP response from a DeleteAPIWishlistItemWithResponse call
+func ParseDeleteAPIWishlistItemResponse(rsp *http.Response) (*DeleteAPIWishlistItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIWishlistItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получить открытые аукционы, начиная с ближайших к завершению.
+	// (GET /api/auctions)
+	GetAPIAuctions(w http.ResponseWriter, r *http.Request)
+	// Создать аукцион на предмет. Доступно только администраторам.
+	// (POST /api/auctions)
+	PostAPIAuctions(w http.ResponseWriter, r *http.Request)
+	// Отменить открытый аукцион и вернуть монеты за наибольшую ставку. Доступно только администраторам.
+	// (DELETE /api/auctions/{id})
+	DeleteAPIAuctionsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Получить аукцион.
+	// (GET /api/auctions/{id})
+	GetAPIAuctionsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Получить ставки аукциона, начиная с наибольшей.
+	// (GET /api/auctions/{id}/bids)
+	GetAPIAuctionsIDBids(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Сделать ставку. Сумма ставки удерживается с баланса, а монеты за перебитую ставку возвращаются.
+	// (POST /api/auctions/{id}/bids)
+	PostAPIAuctionsIDBids(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
+	// (POST /api/auth)
+	PostAPIAuth(w http.ResponseWriter, r *http.Request)
+	// Установить бюджет на награды пользователя. Доступно только администраторам.
+	// (PUT /api/budgets/{username})
+	PutAPIBudgetsUsername(w http.ResponseWriter, r *http.Request, username string)
+	// Купить предмет за монеты.
+	// (GET /api/buy/{item})
+	GetAPIBuyItem(w http.ResponseWriter, r *http.Request, item string, params GetAPIBuyItemParams)
+	// Купить предмет за монеты в подарок другому пользователю.
+	// (POST /api/buy/{item}/gift)
+	PostAPIBuyItemGift(w http.ResponseWriter, r *http.Request, item string)
+	// Получить действующие и будущие скидочные акции.
+	// (GET /api/campaigns)
+	GetAPICampaigns(w http.ResponseWriter, r *http.Request)
+	// Создать скидочную акцию на предмет или категорию. Доступно только администраторам.
+	// (POST /api/campaigns)
+	PostAPICampaigns(w http.ResponseWriter, r *http.Request)
+	// Завершить скидочную акцию досрочно. Будущая акция удаляется. Доступно только администраторам.
+	// (DELETE /api/campaigns/{id})
+	DeleteAPICampaignsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Подписаться на события текущего пользователя в формате Server-Sent Events — получение монет, покупки и подарки, начисления из командного фонда, значки и бонусы за них.
+	// (GET /api/events)
+	GetAPIEvents(w http.ResponseWriter, r *http.Request)
+	// Получить здоровье сервиса.
+	// (GET /api/health)
+	GetAPIHealth(w http.ResponseWriter, r *http.Request)
+	// Получить изображение. Изображения не меняются, поэтому их можно кэшировать без ограничения срока.
+	// (GET /api/images/{key})
+	GetAPIImagesKey(w http.ResponseWriter, r *http.Request, key string, params GetAPIImagesKeyParams)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	// (GET /api/info)
+	GetAPIInfo(w http.ResponseWriter, r *http.Request)
+	// Передать купленные предметы из инвентаря другому пользователю.
+	// (POST /api/inventory/transfer)
+	PostAPIInventoryTransfer(w http.ResponseWriter, r *http.Request)
+	// Получить историю передачи предметов текущего пользователя.
+	// (GET /api/inventory/transfers)
+	GetAPIInventoryTransfers(w http.ResponseWriter, r *http.Request)
+	// Получить каталог предметов.
+	// (GET /api/items)
+	GetAPIItems(w http.ResponseWriter, r *http.Request, params GetAPIItemsParams)
+	// Изменить категорию и описание предмета. Доступно только администраторам.
+	// (PUT /api/items/{item})
+	PutAPIItemsItem(w http.ResponseWriter, r *http.Request, item string)
+	// Загрузить изображение предмета в формате PNG, JPEG, GIF или WebP размером до 5 МиБ. Доступно только администраторам.
+	// (PUT /api/items/{item}/image)
+	PutAPIItemsItemImage(w http.ResponseWriter, r *http.Request, item string)
+	// Снять ограничение на количество предметов у одного пользователя. Доступно только администраторам.
+	// (DELETE /api/items/{item}/purchaseLimit)
+	DeleteAPIItemsItemPurchaseLimit(w http.ResponseWriter, r *http.Request, item string)
+	// Установить ограничение на количество предметов у одного пользователя. Доступно только администраторам.
+	// (PUT /api/items/{item}/purchaseLimit)
+	PutAPIItemsItemPurchaseLimit(w http.ResponseWriter, r *http.Request, item string)
+	// Получить варианты предмета.
+	// (GET /api/items/{item}/variants)
+	GetAPIItemsItemVariants(w http.ResponseWriter, r *http.Request, item string)
+	// Добавить вариант предмета. Доступно только администраторам.
+	// (POST /api/items/{item}/variants)
+	PostAPIItemsItemVariants(w http.ResponseWriter, r *http.Request, item string)
+	// Изменить запас и цену варианта предмета. Доступно только администраторам.
+	// (PUT /api/items/{item}/variants/{id})
+	PutAPIItemsItemVariantsID(w http.ResponseWriter, r *http.Request, item string, id openapi_types.UUID)
+	// Получить ленту благодарностей — последние переводы с сообщениями между пользователями, которые согласились их показывать.
+	// (GET /api/kudos)
+	GetAPIKudos(w http.ResponseWriter, r *http.Request, params GetAPIKudosParams)
+	// Получить свои настройки ленты благодарностей.
+	// (GET /api/kudos/settings)
+	GetAPIKudosSettings(w http.ResponseWriter, r *http.Request)
+	// Изменить свои настройки ленты благодарностей.
+	// (PUT /api/kudos/settings)
+	PutAPIKudosSettings(w http.ResponseWriter, r *http.Request)
+	// Получить рейтинг пользователей за период. Доступно только администраторам.
+	// (GET /api/leaderboards/{kind})
+	GetAPILeaderboardsKind(w http.ResponseWriter, r *http.Request, kind LeaderboardKind, params GetAPILeaderboardsKindParams)
+	// Получить уведомления текущего пользователя, начиная с новых.
+	// (GET /api/notifications)
+	GetAPINotifications(w http.ResponseWriter, r *http.Request, params GetAPINotificationsParams)
+	// Отметить все уведомления текущего пользователя прочитанными.
+	// (POST /api/notifications/read)
+	PostAPINotificationsRead(w http.ResponseWriter, r *http.Request)
+	// Отметить уведомление прочитанным.
+	// (POST /api/notifications/{id}/read)
+	PostAPINotificationsIDRead(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Получить входящие ожидающие и исходящие запросы монет.
+	// (GET /api/paymentRequests)
+	GetAPIPaymentRequests(w http.ResponseWriter, r *http.Request)
+	// Запросить монеты у другого пользователя.
+	// (POST /api/paymentRequests)
+	PostAPIPaymentRequests(w http.ResponseWriter, r *http.Request)
+	// Принять запрос монет и перевести монеты запросившему пользователю. Доступно только плательщику.
+	// (POST /api/paymentRequests/{id}/accept)
+	PostAPIPaymentRequestsIDAccept(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Отклонить запрос монет. Доступно только плательщику.
+	// (POST /api/paymentRequests/{id}/decline)
+	PostAPIPaymentRequestsIDDecline(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Получить свой профиль.
+	// (GET /api/profile)
+	GetAPIProfile(w http.ResponseWriter, r *http.Request)
+	// Изменить свой профиль. Незаданные поля очищаются.
+	// (PUT /api/profile)
+	PutAPIProfile(w http.ResponseWriter, r *http.Request)
+	// Получить промокоды. Доступно только администраторам.
+	// (GET /api/promoCodes)
+	GetAPIPromoCodes(w http.ResponseWriter, r *http.Request)
+	// Создать промокод. Доступно только администраторам.
+	// (POST /api/promoCodes)
+	PostAPIPromoCodes(w http.ResponseWriter, r *http.Request)
+	// Активировать промокод на монеты.
+	// (POST /api/promoCodes/redeem)
+	PostAPIPromoCodesRedeem(w http.ResponseWriter, r *http.Request)
+	// Получить активации промокода. Доступно только администраторам.
+	// (GET /api/promoCodes/{code}/redemptions)
+	GetAPIPromoCodesCodeRedemptions(w http.ResponseWriter, r *http.Request, code string)
+	// Получить открытые розыгрыши, начиная с ближайших.
+	// (GET /api/raffles)
+	GetAPIRaffles(w http.ResponseWriter, r *http.Request)
+	// Создать розыгрыш предмета. Доступно только администраторам.
+	// (POST /api/raffles)
+	PostAPIRaffles(w http.ResponseWriter, r *http.Request)
+	// Получить розыгрыш. После розыгрыша в ответе раскрывается зерно, по которому выбраны победители.
+	// (GET /api/raffles/{id})
+	GetAPIRafflesID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Получить билеты розыгрыша по порядку номеров.
+	// (GET /api/raffles/{id}/tickets)
+	GetAPIRafflesIDTickets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Купить билеты розыгрыша за монеты.
+	// (POST /api/raffles/{id}/tickets)
+	PostAPIRafflesIDTickets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Получить активные резервы текущего пользователя, начиная с новых.
+	// (GET /api/reservations)
+	GetAPIReservations(w http.ResponseWriter, r *http.Request)
+	// Зарезервировать предмет. Резерв удерживает единицу предмета и монеты на время подтверждения покупки. Вариант можно выбрать позже, тогда удерживаются монеты за самый дорогой вариант в наличии.
+	// (POST /api/reservations)
+	PostAPIReservations(w http.ResponseWriter, r *http.Request)
+	// Отменить активный резерв и вернуть удержанные монеты.
+	// (DELETE /api/reservations/{id})
+	DeleteAPIReservationsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Купить зарезервированный предмет за удержанные монеты. Если вариант не был выбран при резервировании, его нужно выбрать сейчас.
+	// (POST /api/reservations/{id}/purchase)
+	PostAPIReservationsIDPurchase(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostAPIReservationsIDPurchaseParams)
+	// Получить запланированные переводы монет текущего пользователя.
+	// (GET /api/scheduledTransfers)
+	GetAPIScheduledTransfers(w http.ResponseWriter, r *http.Request)
+	// Запланировать разовый или повторяющийся перевод монет.
+	// (POST /api/scheduledTransfers)
+	PostAPIScheduledTransfers(w http.ResponseWriter, r *http.Request)
+	// Отменить запланированный перевод монет. Доступно только отправителю.
+	// (DELETE /api/scheduledTransfers/{id})
+	DeleteAPIScheduledTransfersID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Отправить монеты другому пользователю.
+	// (POST /api/sendCoin)
+	PostAPISendCoin(w http.ResponseWriter, r *http.Request)
+	// Отправить монеты нескольким пользователям в одной транзакции. Если хотя бы один перевод невозможен, не выполняется ни один.
+	// (POST /api/sendCoin/batch)
+	PostAPISendCoinBatch(w http.ResponseWriter, r *http.Request)
+	// Получить команды, в которых состоит пользователь.
+	// (GET /api/teams)
+	GetAPITeams(w http.ResponseWriter, r *http.Request)
+	// Создать команду. Доступно только администраторам.
+	// (POST /api/teams)
+	PostAPITeams(w http.ResponseWriter, r *http.Request)
+	// Получить команду с участниками. Доступно участникам команды и администраторам.
+	// (GET /api/teams/{team})
+	GetAPITeamsTeam(w http.ResponseWriter, r *http.Request, team string)
+	// Пополнить фонд команды новыми монетами. Доступно только администраторам.
+	// (POST /api/teams/{team}/fund)
+	PostAPITeamsTeamFund(w http.ResponseWriter, r *http.Request, team string)
+	// Получить историю фонда команды. Доступно участникам команды и администраторам.
+	// (GET /api/teams/{team}/history)
+	GetAPITeamsTeamHistory(w http.ResponseWriter, r *http.Request, team string)
+	// Удалить пользователя из команды. Доступно только администраторам.
+	// (DELETE /api/teams/{team}/members/{username})
+	DeleteAPITeamsTeamMembersUsername(w http.ResponseWriter, r *http.Request, team string, username string)
+	// Добавить пользователя в команду или изменить его роль. Доступно только администраторам.
+	// (PUT /api/teams/{team}/members/{username})
+	PutAPITeamsTeamMembersUsername(w http.ResponseWriter, r *http.Request, team string, username string)
+	// Отправить монеты из фонда команды участнику команды. Доступно только менеджерам команды.
+	// (POST /api/teams/{team}/sendCoin)
+	PostAPITeamsTeamSendCoin(w http.ResponseWriter, r *http.Request, team string)
+	// Получить ограничения на переводы монет. Доступно только администраторам.
+	// (GET /api/transferLimits)
+	GetAPITransferLimits(w http.ResponseWriter, r *http.Request)
+	// Изменить ограничения на переводы монет. Доступно только администраторам.
+	// (PUT /api/transferLimits)
+	PutAPITransferLimits(w http.ResponseWriter, r *http.Request)
+	// Получить переводы, ожидающие одобрения текущего пользователя.
+	// (GET /api/transfers/pending)
+	GetAPITransfersPending(w http.ResponseWriter, r *http.Request)
+	// Одобрить ожидающий перевод. Доступно менеджерам команд отправителя и администраторам.
+	// (POST /api/transfers/{id}/approve)
+	PostAPITransfersIDApprove(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Отклонить ожидающий перевод и вернуть монеты отправителю. Доступно менеджерам команд отправителя и администраторам.
+	// (POST /api/transfers/{id}/reject)
+	PostAPITransfersIDReject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Найти коллег по имени пользователя или отображаемому имени. Деактивированные пользователи не возвращаются.
+	// (GET /api/users)
+	GetAPIUsers(w http.ResponseWriter, r *http.Request, params GetAPIUsersParams)
+	// Получить публичный профиль пользователя.
+	// (GET /api/users/{username})
+	GetAPIUsersUsername(w http.ResponseWriter, r *http.Request, username string)
+	// Деактивировать пользователя, например уволившегося. Деактивированные пользователи не находятся в поиске коллег, не могут войти и не могут получать монеты и предметы. Доступно только администраторам.
+	// (POST /api/users/{username}/deactivate)
+	PostAPIUsersUsernameDeactivate(w http.ResponseWriter, r *http.Request, username string)
+	// Снова активировать деактивированного пользователя. Доступно только администраторам.
+	// (POST /api/users/{username}/reactivate)
+	PostAPIUsersUsernameReactivate(w http.ResponseWriter, r *http.Request, username string)
+	// Получить активные подписки на вебхуки. Доступно только администраторам.
+	// (GET /api/webhooks)
+	GetAPIWebhooks(w http.ResponseWriter, r *http.Request)
+	// Подписать URL на события. Доступно только администраторам.
+	// (POST /api/webhooks)
+	PostAPIWebhooks(w http.ResponseWriter, r *http.Request)
+	// Получить недоставленные после всех попыток вебхуки. Доступно только администраторам.
+	// (GET /api/webhooks/dead-letters)
+	GetAPIWebhooksDeadLetters(w http.ResponseWriter, r *http.Request, params GetAPIWebhooksDeadLettersParams)
+	// Повторить недоставленный вебхук. Доступно только администраторам.
+	// (POST /api/webhooks/deliveries/{id}/retry)
+	PostAPIWebhooksDeliveriesIDRetry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Удалить подписку на вебхуки. Доступно только администраторам.
+	// (DELETE /api/webhooks/{id})
+	DeleteAPIWebhooksID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Получить список желаний текущего пользователя с прогрессом накопления монет.
+	// (GET /api/wishlist)
+	GetAPIWishlist(w http.ResponseWriter, r *http.Request)
+	// Добавить предмет в список желаний.
+	// (POST /api/wishlist)
+	PostAPIWishlist(w http.ResponseWriter, r *http.Request)
+	// Удалить предмет из списка желаний.
+	// (DELETE /api/wishlist/{item})
+	DeleteAPIWishlistItem(w http.ResponseWriter, r *http.Request, item string)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAPIAuctions operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIAuctions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIAuctions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIAuctions operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIAuctions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIAuctions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIAuctionsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIAuctionsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIAuctionsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIAuctionsID operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIAuctionsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIAuctionsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIAuctionsIDBids operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIAuctionsIDBids(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIAuctionsIDBids(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIAuctionsIDBids operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIAuctionsIDBids(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIAuctionsIDBids(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIAuth operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIAuth(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIAuth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPIBudgetsUsername operation middleware
+func (siw *ServerInterfaceWrapper) PutAPIBudgetsUsername(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", r.PathValue("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPIBudgetsUsername(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIBuyItem operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIBuyItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIBuyItemParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "color" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "color", r.URL.Query(), &params.Color)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "color", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "promoCode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "promoCode", r.URL.Query(), &params.PromoCode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "promoCode", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIBuyItem(w, r, item, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIBuyItemGift operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIBuyItemGift(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIBuyItemGift(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPICampaigns operation middleware
+func (siw *ServerInterfaceWrapper) GetAPICampaigns(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPICampaigns(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPICampaigns operation middleware
+func (siw *ServerInterfaceWrapper) PostAPICampaigns(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPICampaigns(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPICampaignsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPICampaignsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPICampaignsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIEvents(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIEvents(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIHealth(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIImagesKey operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIImagesKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIImagesKeyParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIImagesKey(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIInfo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIInfo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIInventoryTransfer operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIInventoryTransfer(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIInventoryTransfer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIInventoryTransfers operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIInventoryTransfers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIInventoryTransfers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIItems operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIItemsParams
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIItems(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPIItemsItem operation middleware
+func (siw *ServerInterfaceWrapper) PutAPIItemsItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPIItemsItem(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPIItemsItemImage operation middleware
+func (siw *ServerInterfaceWrapper) PutAPIItemsItemImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPIItemsItemImage(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIItemsItemPurchaseLimit operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIItemsItemPurchaseLimit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIItemsItemPurchaseLimit(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPIItemsItemPurchaseLimit operation middleware
+func (siw *ServerInterfaceWrapper) PutAPIItemsItemPurchaseLimit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPIItemsItemPurchaseLimit(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIItemsItemVariants operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIItemsItemVariants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIItemsItemVariants(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIItemsItemVariants operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIItemsItemVariants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIItemsItemVariants(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPIItemsItemVariantsID operation middleware
+func (siw *ServerInterfaceWrapper) PutAPIItemsItemVariantsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPIItemsItemVariantsID(w, r, item, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIKudos operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKudos(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIKudosParams
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIKudos(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIKudosSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKudosSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIKudosSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPIKudosSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutAPIKudosSettings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPIKudosSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPILeaderboardsKind operation middleware
+func (siw *ServerInterfaceWrapper) GetAPILeaderboardsKind(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "kind" -------------
+	var kind LeaderboardKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", r.PathValue("kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPILeaderboardsKindParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPILeaderboardsKind(w, r, kind, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPINotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetAPINotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPINotificationsParams
+
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", r.URL.Query(), &params.Unread)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unread", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPINotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPINotificationsRead operation middleware
+func (siw *ServerInterfaceWrapper) PostAPINotificationsRead(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPINotificationsRead(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPINotificationsIDRead operation middleware
+func (siw *ServerInterfaceWrapper) PostAPINotificationsIDRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPINotificationsIDRead(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIPaymentRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIPaymentRequests(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIPaymentRequests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIPaymentRequests operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIPaymentRequests(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIPaymentRequests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIPaymentRequestsIDAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIPaymentRequestsIDAccept(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIPaymentRequestsIDAccept(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIPaymentRequestsIDDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIPaymentRequestsIDDecline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIPaymentRequestsIDDecline(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPIProfile operation middleware
+func (siw *ServerInterfaceWrapper) PutAPIProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPIProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIPromoCodes(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIPromoCodes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIPromoCodes(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIPromoCodes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIPromoCodesRedeem operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIPromoCodesRedeem(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIPromoCodesRedeem(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIPromoCodesCodeRedemptions operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIPromoCodesCodeRedemptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", r.PathValue("code"), &code, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIPromoCodesCodeRedemptions(w, r, code)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIRaffles operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIRaffles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIRaffles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIRaffles operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIRaffles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIRaffles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIRafflesID operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIRafflesID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIRafflesID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIRafflesIDTickets operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIRafflesIDTickets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIRafflesIDTickets(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIRafflesIDTickets operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIRafflesIDTickets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIRafflesIDTickets(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIReservations operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIReservations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIReservations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIReservations operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIReservations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIReservations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIReservationsID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIReservationsID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIReservationsID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIReservationsIDPurchase operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIReservationsIDPurchase(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAPIReservationsIDPurchaseParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "color" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "color", r.URL.Query(), &params.Color)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "color", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIReservationsIDPurchase(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIScheduledTransfers operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIScheduledTransfers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIScheduledTransfers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIScheduledTransfers operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIScheduledTransfers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIScheduledTransfers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIScheduledTransfersID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIScheduledTransfersID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIScheduledTransfersID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPISendCoin operation middleware
+func (siw *ServerInterfaceWrapper) PostAPISendCoin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPISendCoin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPISendCoinBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostAPISendCoinBatch(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPISendCoinBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPITeams operation middleware
+func (siw *ServerInterfaceWrapper) GetAPITeams(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPITeams(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPITeams operation middleware
+func (siw *ServerInterfaceWrapper) PostAPITeams(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPITeams(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPITeamsTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetAPITeamsTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team" -------------
+	var team string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team", r.PathValue("team"), &team, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPITeamsTeam(w, r, team)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPITeamsTeamFund operation middleware
+func (siw *ServerInterfaceWrapper) PostAPITeamsTeamFund(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team" -------------
+	var team string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team", r.PathValue("team"), &team, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPITeamsTeamFund(w, r, team)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPITeamsTeamHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetAPITeamsTeamHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team" -------------
+	var team string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team", r.PathValue("team"), &team, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPITeamsTeamHistory(w, r, team)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPITeamsTeamMembersUsername operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPITeamsTeamMembersUsername(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team" -------------
+	var team string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team", r.PathValue("team"), &team, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", r.PathValue("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPITeamsTeamMembersUsername(w, r, team, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPITeamsTeamMembersUsername operation middleware
+func (siw *ServerInterfaceWrapper) PutAPITeamsTeamMembersUsername(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team" -------------
+	var team string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team", r.PathValue("team"), &team, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", r.PathValue("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPITeamsTeamMembersUsername(w, r, team, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPITeamsTeamSendCoin operation middleware
+func (siw *ServerInterfaceWrapper) PostAPITeamsTeamSendCoin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "team" -------------
+	var team string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "team", r.PathValue("team"), &team, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPITeamsTeamSendCoin(w, r, team)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPITransferLimits operation middleware
+func (siw *ServerInterfaceWrapper) GetAPITransferLimits(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPITransferLimits(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutAPITransferLimits operation middleware
+func (siw *ServerInterfaceWrapper) PutAPITransferLimits(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAPITransferLimits(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPITransfersPending operation middleware
+func (siw *ServerInterfaceWrapper) GetAPITransfersPending(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPITransfersPending(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPITransfersIDApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostAPITransfersIDApprove(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPITransfersIDApprove(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPITransfersIDReject operation middleware
+func (siw *ServerInterfaceWrapper) PostAPITransfersIDReject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPITransfersIDReject(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIUsersParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIUsersUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIUsersUsername(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", r.PathValue("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIUsersUsername(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIUsersUsernameDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIUsersUsernameDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", r.PathValue("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIUsersUsernameDeactivate(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIUsersUsernameReactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIUsersUsernameReactivate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", r.PathValue("username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIUsersUsernameReactivate(w, r, username)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIWebhooksDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIWebhooksDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIWebhooksDeadLettersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIWebhooksDeadLetters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIWebhooksDeliveriesIDRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIWebhooksDeliveriesIDRetry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIWebhooksDeliveriesIDRetry(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIWebhooksID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIWebhooksID(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIWebhooksID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAPIWishlist operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIWishlist(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIWishlist(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAPIWishlist operation middleware
+func (siw *ServerInterfaceWrapper) PostAPIWishlist(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAPIWishlist(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAPIWishlistItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAPIWishlistItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "item" -------------
+	var item string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item", r.PathValue("item"), &item, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAPIWishlistItem(w, r, item)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/api/auctions", wrapper.GetAPIAuctions)
+	m.HandleFunc("POST "+options.BaseURL+"/api/auctions", wrapper.PostAPIAuctions)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/auctions/{id}", wrapper.DeleteAPIAuctionsID)
+	m.HandleFunc("GET "+options.BaseURL+"/api/auctions/{id}", wrapper.GetAPIAuctionsID)
+	m.HandleFunc("GET "+options.BaseURL+"/api/auctions/{id}/bids", wrapper.GetAPIAuctionsIDBids)
+	m.HandleFunc("POST "+options.BaseURL+"/api/auctions/{id}/bids", wrapper.PostAPIAuctionsIDBids)
+	m.HandleFunc("POST "+options.BaseURL+"/api/auth", wrapper.PostAPIAuth)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/budgets/{username}", wrapper.PutAPIBudgetsUsername)
+	m.HandleFunc("GET "+options.BaseURL+"/api/buy/{item}", wrapper.GetAPIBuyItem)
+	m.HandleFunc("POST "+options.BaseURL+"/api/buy/{item}/gift", wrapper.PostAPIBuyItemGift)
+	m.HandleFunc("GET "+options.BaseURL+"/api/campaigns", wrapper.GetAPICampaigns)
+	m.HandleFunc("POST "+options.BaseURL+"/api/campaigns", wrapper.PostAPICampaigns)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/campaigns/{id}", wrapper.DeleteAPICampaignsID)
+	m.HandleFunc("GET "+options.BaseURL+"/api/events", wrapper.GetAPIEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/api/health", wrapper.GetAPIHealth)
+	m.HandleFunc("GET "+options.BaseURL+"/api/images/{key}", wrapper.GetAPIImagesKey)
+	m.HandleFunc("GET "+options.BaseURL+"/api/info", wrapper.GetAPIInfo)
+	m.HandleFunc("POST "+options.BaseURL+"/api/inventory/transfer", wrapper.PostAPIInventoryTransfer)
+	m.HandleFunc("GET "+options.BaseURL+"/api/inventory/transfers", wrapper.GetAPIInventoryTransfers)
+	m.HandleFunc("GET "+options.BaseURL+"/api/items", wrapper.GetAPIItems)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/items/{item}", wrapper.PutAPIItemsItem)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/items/{item}/image", wrapper.PutAPIItemsItemImage)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/items/{item}/purchaseLimit", wrapper.DeleteAPIItemsItemPurchaseLimit)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/items/{item}/purchaseLimit", wrapper.PutAPIItemsItemPurchaseLimit)
+	m.HandleFunc("GET "+options.BaseURL+"/api/items/{item}/variants", wrapper.GetAPIItemsItemVariants)
+	m.HandleFunc("POST "+options.BaseURL+"/api/items/{item}/variants", wrapper.PostAPIItemsItemVariants)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/items/{item}/variants/{id}", wrapper.PutAPIItemsItemVariantsID)
+	m.HandleFunc("GET "+options.BaseURL+"/api/kudos", wrapper.GetAPIKudos)
+	m.HandleFunc("GET "+options.BaseURL+"/api/kudos/settings", wrapper.GetAPIKudosSettings)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/kudos/settings", wrapper.PutAPIKudosSettings)
+	m.HandleFunc("GET "+options.BaseURL+"/api/leaderboards/{kind}", wrapper.GetAPILeaderboardsKind)
+	m.HandleFunc("GET "+options.BaseURL+"/api/notifications", wrapper.GetAPINotifications)
+	m.HandleFunc("POST "+options.BaseURL+"/api/notifications/read", wrapper.PostAPINotificationsRead)
+	m.HandleFunc("POST "+options.BaseURL+"/api/notifications/{id}/read", wrapper.PostAPINotificationsIDRead)
+	m.HandleFunc("GET "+options.BaseURL+"/api/paymentRequests", wrapper.GetAPIPaymentRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/api/paymentRequests", wrapper.PostAPIPaymentRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/api/paymentRequests/{id}/accept", wrapper.PostAPIPaymentRequestsIDAccept)
+	m.HandleFunc("POST "+options.BaseURL+"/api/paymentRequests/{id}/decline", wrapper.PostAPIPaymentRequestsIDDecline)
+	m.HandleFunc("GET "+options.BaseURL+"/api/profile", wrapper.GetAPIProfile)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/profile", wrapper.PutAPIProfile)
+	m.HandleFunc("GET "+options.BaseURL+"/api/promoCodes", wrapper.GetAPIPromoCodes)
+	m.HandleFunc("POST "+options.BaseURL+"/api/promoCodes", wrapper.PostAPIPromoCodes)
+	m.HandleFunc("POST "+options.BaseURL+"/api/promoCodes/redeem", wrapper.PostAPIPromoCodesRedeem)
+	m.HandleFunc("GET "+options.BaseURL+"/api/promoCodes/{code}/redemptions", wrapper.GetAPIPromoCodesCodeRedemptions)
+	m.HandleFunc("GET "+options.BaseURL+"/api/raffles", wrapper.GetAPIRaffles)
+	m.HandleFunc("POST "+options.BaseURL+"/api/raffles", wrapper.PostAPIRaffles)
+	m.HandleFunc("GET "+options.BaseURL+"/api/raffles/{id}", wrapper.GetAPIRafflesID)
+	m.HandleFunc("GET "+options.BaseURL+"/api/raffles/{id}/tickets", wrapper.GetAPIRafflesIDTickets)
+	m.HandleFunc("POST "+options.BaseURL+"/api/raffles/{id}/tickets", wrapper.PostAPIRafflesIDTickets)
+	m.HandleFunc("GET "+options.BaseURL+"/api/reservations", wrapper.GetAPIReservations)
+	m.HandleFunc("POST "+options.BaseURL+"/api/reservations", wrapper.PostAPIReservations)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/reservations/{id}", wrapper.DeleteAPIReservationsID)
+	m.HandleFunc("POST "+options.BaseURL+"/api/reservations/{id}/purchase", wrapper.PostAPIReservationsIDPurchase)
+	m.HandleFunc("GET "+options.BaseURL+"/api/scheduledTransfers", wrapper.GetAPIScheduledTransfers)
+	m.HandleFunc("POST "+options.BaseURL+"/api/scheduledTransfers", wrapper.PostAPIScheduledTransfers)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/scheduledTransfers/{id}", wrapper.DeleteAPIScheduledTransfersID)
+	m.HandleFunc("POST "+options.BaseURL+"/api/sendCoin", wrapper.PostAPISendCoin)
+	m.HandleFunc("POST "+options.BaseURL+"/api/sendCoin/batch", wrapper.PostAPISendCoinBatch)
+	m.HandleFunc("GET "+options.BaseURL+"/api/teams", wrapper.GetAPITeams)
+	m.HandleFunc("POST "+options.BaseURL+"/api/teams", wrapper.PostAPITeams)
+	m.HandleFunc("GET "+options.BaseURL+"/api/teams/{team}", wrapper.GetAPITeamsTeam)
+	m.HandleFunc("POST "+options.BaseURL+"/api/teams/{team}/fund", wrapper.PostAPITeamsTeamFund)
+	m.HandleFunc("GET "+options.BaseURL+"/api/teams/{team}/history", wrapper.GetAPITeamsTeamHistory)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/teams/{team}/members/{username}", wrapper.DeleteAPITeamsTeamMembersUsername)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/teams/{team}/members/{username}", wrapper.PutAPITeamsTeamMembersUsername)
+	m.HandleFunc("POST "+options.BaseURL+"/api/teams/{team}/sendCoin", wrapper.PostAPITeamsTeamSendCoin)
+	m.HandleFunc("GET "+options.BaseURL+"/api/transferLimits", wrapper.GetAPITransferLimits)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/transferLimits", wrapper.PutAPITransferLimits)
+	m.HandleFunc("GET "+options.BaseURL+"/api/transfers/pending", wrapper.GetAPITransfersPending)
+	m.HandleFunc("POST "+options.BaseURL+"/api/transfers/{id}/approve", wrapper.PostAPITransfersIDApprove)
+	m.HandleFunc("POST "+options.BaseURL+"/api/transfers/{id}/reject", wrapper.PostAPITransfersIDReject)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users", wrapper.GetAPIUsers)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users/{username}", wrapper.GetAPIUsersUsername)
+	m.HandleFunc("POST "+options.BaseURL+"/api/users/{username}/deactivate", wrapper.PostAPIUsersUsernameDeactivate)
+	m.HandleFunc("POST "+options.BaseURL+"/api/users/{username}/reactivate", wrapper.PostAPIUsersUsernameReactivate)
+	m.HandleFunc("GET "+options.BaseURL+"/api/webhooks", wrapper.GetAPIWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/api/webhooks", wrapper.PostAPIWebhooks)
+	m.HandleFunc("GET "+options.BaseURL+"/api/webhooks/dead-letters", wrapper.GetAPIWebhooksDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/api/webhooks/deliveries/{id}/retry", wrapper.PostAPIWebhooksDeliveriesIDRetry)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/webhooks/{id}", wrapper.DeleteAPIWebhooksID)
+	m.HandleFunc("GET "+options.BaseURL+"/api/wishlist", wrapper.GetAPIWishlist)
+	m.HandleFunc("POST "+options.BaseURL+"/api/wishlist", wrapper.PostAPIWishlist)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/wishlist/{item}", wrapper.DeleteAPIWishlistItem)
+
+	return m
+}
+
+type GetAPIAuctionsRequestObject struct {
+}
+
+type GetAPIAuctionsResponseObject interface {
+	VisitGetAPIAuctionsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIAuctions200JSONResponse AuctionsResponse
+
+func (response GetAPIAuctions200JSONResponse) VisitGetAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctions401JSONResponse ErrorResponse
+
+func (response GetAPIAuctions401JSONResponse) VisitGetAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctions500JSONResponse ErrorResponse
+
+func (response GetAPIAuctions500JSONResponse) VisitGetAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctionsRequestObject struct {
+	Body *PostAPIAuctionsJSONRequestBody
+}
+
+type PostAPIAuctionsResponseObject interface {
+	VisitPostAPIAuctionsResponse(w http.ResponseWriter) error
+}
+
+type PostAPIAuctions200JSONResponse Auction
+
+func (response PostAPIAuctions200JSONResponse) VisitPostAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctions400JSONResponse ErrorResponse
+
+func (response PostAPIAuctions400JSONResponse) VisitPostAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctions401JSONResponse ErrorResponse
+
+func (response PostAPIAuctions401JSONResponse) VisitPostAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctions403JSONResponse ErrorResponse
+
+func (response PostAPIAuctions403JSONResponse) VisitPostAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctions404JSONResponse ErrorResponse
+
+func (response PostAPIAuctions404JSONResponse) VisitPostAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctions500JSONResponse ErrorResponse
+
+func (response PostAPIAuctions500JSONResponse) VisitPostAPIAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIAuctionsIDRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type DeleteAPIAuctionsIDResponseObject interface {
+	VisitDeleteAPIAuctionsIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIAuctionsID200Response struct {
+}
+
+func (response DeleteAPIAuctionsID200Response) VisitDeleteAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteAPIAuctionsID400JSONResponse ErrorResponse
+
+func (response DeleteAPIAuctionsID400JSONResponse) VisitDeleteAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIAuctionsID401JSONResponse ErrorResponse
+
+func (response DeleteAPIAuctionsID401JSONResponse) VisitDeleteAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIAuctionsID403JSONResponse ErrorResponse
+
+func (response DeleteAPIAuctionsID403JSONResponse) VisitDeleteAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIAuctionsID404JSONResponse ErrorResponse
+
+func (response DeleteAPIAuctionsID404JSONResponse) VisitDeleteAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIAuctionsID500JSONResponse ErrorResponse
+
+func (response DeleteAPIAuctionsID500JSONResponse) VisitDeleteAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsIDRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type GetAPIAuctionsIDResponseObject interface {
+	VisitGetAPIAuctionsIDResponse(w http.ResponseWriter) error
+}
+
+type GetAPIAuctionsID200JSONResponse Auction
+
+func (response GetAPIAuctionsID200JSONResponse) VisitGetAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsID401JSONResponse ErrorResponse
+
+func (response GetAPIAuctionsID401JSONResponse) VisitGetAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsID404JSONResponse ErrorResponse
+
+func (response GetAPIAuctionsID404JSONResponse) VisitGetAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsID500JSONResponse ErrorResponse
+
+func (response GetAPIAuctionsID500JSONResponse) VisitGetAPIAuctionsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsIDBidsRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type GetAPIAuctionsIDBidsResponseObject interface {
+	VisitGetAPIAuctionsIDBidsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIAuctionsIDBids200JSONResponse AuctionBidsResponse
+
+func (response GetAPIAuctionsIDBids200JSONResponse) VisitGetAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsIDBids401JSONResponse ErrorResponse
+
+func (response GetAPIAuctionsIDBids401JSONResponse) VisitGetAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsIDBids404JSONResponse ErrorResponse
+
+func (response GetAPIAuctionsIDBids404JSONResponse) VisitGetAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIAuctionsIDBids500JSONResponse ErrorResponse
+
+func (response GetAPIAuctionsIDBids500JSONResponse) VisitGetAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctionsIDBidsRequestObject struct {
+	ID   openapi_types.UUID `json:"id"`
+	Body *PostAPIAuctionsIDBidsJSONRequestBody
+}
+
+type PostAPIAuctionsIDBidsResponseObject interface {
+	VisitPostAPIAuctionsIDBidsResponse(w http.ResponseWriter) error
+}
+
+type PostAPIAuctionsIDBids200JSONResponse AuctionBid
+
+func (response PostAPIAuctionsIDBids200JSONResponse) VisitPostAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctionsIDBids400JSONResponse ErrorResponse
+
+func (response PostAPIAuctionsIDBids400JSONResponse) VisitPostAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctionsIDBids401JSONResponse ErrorResponse
+
+func (response PostAPIAuctionsIDBids401JSONResponse) VisitPostAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctionsIDBids404JSONResponse ErrorResponse
+
+func (response PostAPIAuctionsIDBids404JSONResponse) VisitPostAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuctionsIDBids500JSONResponse ErrorResponse
+
+func (response PostAPIAuctionsIDBids500JSONResponse) VisitPostAPIAuctionsIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuthRequestObject struct {
+	Body *PostAPIAuthJSONRequestBody
+}
+
+type PostAPIAuthResponseObject interface {
+	VisitPostAPIAuthResponse(w http.ResponseWriter) error
+}
+
+type PostAPIAuth200JSONResponse AuthResponse
+
+func (response PostAPIAuth200JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuth400JSONResponse ErrorResponse
+
+func (response PostAPIAuth400JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuth401JSONResponse ErrorResponse
+
+func (response PostAPIAuth401JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIAuth500JSONResponse ErrorResponse
+
+func (response PostAPIAuth500JSONResponse) VisitPostAPIAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIBudgetsUsernameRequestObject struct {
+	Username string `json:"username"`
+	Body     *PutAPIBudgetsUsernameJSONRequestBody
+}
+
+type PutAPIBudgetsUsernameResponseObject interface {
+	VisitPutAPIBudgetsUsernameResponse(w http.ResponseWriter) error
+}
+
+type PutAPIBudgetsUsername200JSONResponse GivingBudget
+
+func (response PutAPIBudgetsUsername200JSONResponse) VisitPutAPIBudgetsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIBudgetsUsername400JSONResponse ErrorResponse
+
+func (response PutAPIBudgetsUsername400JSONResponse) VisitPutAPIBudgetsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIBudgetsUsername401JSONResponse ErrorResponse
+
+func (response PutAPIBudgetsUsername401JSONResponse) VisitPutAPIBudgetsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIBudgetsUsername403JSONResponse ErrorResponse
+
+func (response PutAPIBudgetsUsername403JSONResponse) VisitPutAPIBudgetsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIBudgetsUsername404JSONResponse ErrorResponse
+
+func (response PutAPIBudgetsUsername404JSONResponse) VisitPutAPIBudgetsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIBudgetsUsername500JSONResponse ErrorResponse
+
+func (response PutAPIBudgetsUsername500JSONResponse) VisitPutAPIBudgetsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIBuyItemRequestObject struct {
+	Item   string `json:"item"`
+	Params GetAPIBuyItemParams
+}
+
+type GetAPIBuyItemResponseObject interface {
+	VisitGetAPIBuyItemResponse(w http.ResponseWriter) error
+}
+
+type GetAPIBuyItem200Response struct {
+}
+
+func (response GetAPIBuyItem200Response) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetAPIBuyItem400JSONResponse ErrorResponse
+
+func (response GetAPIBuyItem400JSONResponse) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIBuyItem401JSONResponse ErrorResponse
+
+func (response GetAPIBuyItem401JSONResponse) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIBuyItem500JSONResponse ErrorResponse
+
+func (response GetAPIBuyItem500JSONResponse) VisitGetAPIBuyItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIBuyItemGiftRequestObject struct {
+	Item string `json:"item"`
+	Body *PostAPIBuyItemGiftJSONRequestBody
+}
+
+type PostAPIBuyItemGiftResponseObject interface {
+	VisitPostAPIBuyItemGiftResponse(w http.ResponseWriter) error
+}
+
+type PostAPIBuyItemGift200JSONResponse Gift
+
+func (response PostAPIBuyItemGift200JSONResponse) VisitPostAPIBuyItemGiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIBuyItemGift400JSONResponse ErrorResponse
+
+func (response PostAPIBuyItemGift400JSONResponse) VisitPostAPIBuyItemGiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIBuyItemGift401JSONResponse ErrorResponse
+
+func (response PostAPIBuyItemGift401JSONResponse) VisitPostAPIBuyItemGiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIBuyItemGift500JSONResponse ErrorResponse
+
+func (response PostAPIBuyItemGift500JSONResponse) VisitPostAPIBuyItemGiftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPICampaignsRequestObject struct {
+}
+
+type GetAPICampaignsResponseObject interface {
+	VisitGetAPICampaignsResponse(w http.ResponseWriter) error
+}
+
+type GetAPICampaigns200JSONResponse CampaignsResponse
+
+func (response GetAPICampaigns200JSONResponse) VisitGetAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPICampaigns401JSONResponse ErrorResponse
+
+func (response GetAPICampaigns401JSONResponse) VisitGetAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPICampaigns500JSONResponse ErrorResponse
+
+func (response GetAPICampaigns500JSONResponse) VisitGetAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPICampaignsRequestObject struct {
+	Body *PostAPICampaignsJSONRequestBody
+}
+
+type PostAPICampaignsResponseObject interface {
+	VisitPostAPICampaignsResponse(w http.ResponseWriter) error
+}
+
+type PostAPICampaigns200JSONResponse Campaign
+
+func (response PostAPICampaigns200JSONResponse) VisitPostAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPICampaigns400JSONResponse ErrorResponse
+
+func (response PostAPICampaigns400JSONResponse) VisitPostAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPICampaigns401JSONResponse ErrorResponse
+
+func (response PostAPICampaigns401JSONResponse) VisitPostAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPICampaigns403JSONResponse ErrorResponse
+
+func (response PostAPICampaigns403JSONResponse) VisitPostAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPICampaigns404JSONResponse ErrorResponse
+
+func (response PostAPICampaigns404JSONResponse) VisitPostAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPICampaigns500JSONResponse ErrorResponse
+
+func (response PostAPICampaigns500JSONResponse) VisitPostAPICampaignsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPICampaignsIDRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type DeleteAPICampaignsIDResponseObject interface {
+	VisitDeleteAPICampaignsIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPICampaignsID200Response struct {
+}
+
+func (response DeleteAPICampaignsID200Response) VisitDeleteAPICampaignsIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteAPICampaignsID400JSONResponse ErrorResponse
+
+func (response DeleteAPICampaignsID400JSONResponse) VisitDeleteAPICampaignsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPICampaignsID401JSONResponse ErrorResponse
+
+func (response DeleteAPICampaignsID401JSONResponse) VisitDeleteAPICampaignsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPICampaignsID403JSONResponse ErrorResponse
+
+func (response DeleteAPICampaignsID403JSONResponse) VisitDeleteAPICampaignsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPICampaignsID404JSONResponse ErrorResponse
+
+func (response DeleteAPICampaignsID404JSONResponse) VisitDeleteAPICampaignsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPICampaignsID500JSONResponse ErrorResponse
+
+func (response DeleteAPICampaignsID500JSONResponse) VisitDeleteAPICampaignsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIEventsRequestObject struct {
+}
+
+type GetAPIEventsResponseObject interface {
+	VisitGetAPIEventsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIEvents200TextEventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAPIEvents200TextEventStreamResponse) VisitGetAPIEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAPIEvents400JSONResponse ErrorResponse
+
+func (response GetAPIEvents400JSONResponse) VisitGetAPIEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIEvents401JSONResponse ErrorResponse
+
+func (response GetAPIEvents401JSONResponse) VisitGetAPIEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIEvents500JSONResponse ErrorResponse
+
+func (response GetAPIEvents500JSONResponse) VisitGetAPIEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIHealthRequestObject struct {
+}
+
+type GetAPIHealthResponseObject interface {
+	VisitGetAPIHealthResponse(w http.ResponseWriter) error
+}
+
+type GetAPIHealth200JSONResponse HealthResponse
+
+func (response GetAPIHealth200JSONResponse) VisitGetAPIHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIHealth500JSONResponse ErrorResponse
+
+func (response GetAPIHealth500JSONResponse) VisitGetAPIHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIImagesKeyRequestObject struct {
+	Key    string `json:"key"`
+	Params GetAPIImagesKeyParams
+}
+
+type GetAPIImagesKeyResponseObject interface {
+	VisitGetAPIImagesKeyResponse(w http.ResponseWriter) error
+}
+
+type GetAPIImagesKey200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetAPIImagesKey200ImageResponse struct {
+	Body          io.Reader
+	Headers       GetAPIImagesKey200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetAPIImagesKey200ImageResponse) VisitGetAPIImagesKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAPIImagesKey304Response struct {
+}
+
+func (response GetAPIImagesKey304Response) VisitGetAPIImagesKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetAPIImagesKey404JSONResponse ErrorResponse
+
+func (response GetAPIImagesKey404JSONResponse) VisitGetAPIImagesKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIImagesKey500JSONResponse ErrorResponse
+
+func (response GetAPIImagesKey500JSONResponse) VisitGetAPIImagesKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIInfoRequestObject struct {
+}
+
+type GetAPIInfoResponseObject interface {
+	VisitGetAPIInfoResponse(w http.ResponseWriter) error
+}
+
+type GetAPIInfo200JSONResponse InfoResponse
+
+func (response GetAPIInfo200JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIInfo400JSONResponse ErrorResponse
+
+func (response GetAPIInfo400JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIInfo401JSONResponse ErrorResponse
+
+func (response GetAPIInfo401JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIInfo500JSONResponse ErrorResponse
+
+func (response GetAPIInfo500JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIInventoryTransferRequestObject struct {
+	Body *PostAPIInventoryTransferJSONRequestBody
+}
+
+type PostAPIInventoryTransferResponseObject interface {
+	VisitPostAPIInventoryTransferResponse(w http.ResponseWriter) error
+}
+
+type PostAPIInventoryTransfer200JSONResponse TransferItemResponse
+
+func (response PostAPIInventoryTransfer200JSONResponse) VisitPostAPIInventoryTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIInventoryTransfer400JSONResponse ErrorResponse
+
+func (response PostAPIInventoryTransfer400JSONResponse) VisitPostAPIInventoryTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIInventoryTransfer401JSONResponse ErrorResponse
+
+func (response PostAPIInventoryTransfer401JSONResponse) VisitPostAPIInventoryTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIInventoryTransfer500JSONResponse ErrorResponse
+
+func (response PostAPIInventoryTransfer500JSONResponse) VisitPostAPIInventoryTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIInventoryTransfersRequestObject struct {
+}
+
+type GetAPIInventoryTransfersResponseObject interface {
+	VisitGetAPIInventoryTransfersResponse(w http.ResponseWriter) error
+}
+
+type GetAPIInventoryTransfers200JSONResponse ItemTransfersResponse
+
+func (response GetAPIInventoryTransfers200JSONResponse) VisitGetAPIInventoryTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIInventoryTransfers401JSONResponse ErrorResponse
+
+func (response GetAPIInventoryTransfers401JSONResponse) VisitGetAPIInventoryTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIInventoryTransfers500JSONResponse ErrorResponse
+
+func (response GetAPIInventoryTransfers500JSONResponse) VisitGetAPIInventoryTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIItemsRequestObject struct {
+	Params GetAPIItemsParams
+}
+
+type GetAPIItemsResponseObject interface {
+	VisitGetAPIItemsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIItems200JSONResponse ItemsResponse
+
+func (response GetAPIItems200JSONResponse) VisitGetAPIItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIItems401JSONResponse ErrorResponse
+
+func (response GetAPIItems401JSONResponse) VisitGetAPIItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIItems500JSONResponse ErrorResponse
+
+func (response GetAPIItems500JSONResponse) VisitGetAPIItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemRequestObject struct {
+	Item string `json:"item"`
+	Body *PutAPIItemsItemJSONRequestBody
+}
+
+type PutAPIItemsItemResponseObject interface {
+	VisitPutAPIItemsItemResponse(w http.ResponseWriter) error
+}
+
+type PutAPIItemsItem200JSONResponse CatalogItem
+
+func (response PutAPIItemsItem200JSONResponse) VisitPutAPIItemsItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItem400JSONResponse ErrorResponse
+
+func (response PutAPIItemsItem400JSONResponse) VisitPutAPIItemsItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItem401JSONResponse ErrorResponse
+
+func (response PutAPIItemsItem401JSONResponse) VisitPutAPIItemsItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItem403JSONResponse ErrorResponse
+
+func (response PutAPIItemsItem403JSONResponse) VisitPutAPIItemsItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItem404JSONResponse ErrorResponse
+
+func (response PutAPIItemsItem404JSONResponse) VisitPutAPIItemsItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItem500JSONResponse ErrorResponse
+
+func (response PutAPIItemsItem500JSONResponse) VisitPutAPIItemsItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemImageRequestObject struct {
+	Item string `json:"item"`
+	Body io.Reader
+}
+
+type PutAPIItemsItemImageResponseObject interface {
+	VisitPutAPIItemsItemImageResponse(w http.ResponseWriter) error
+}
+
+type PutAPIItemsItemImage200JSONResponse CatalogItem
+
+func (response PutAPIItemsItemImage200JSONResponse) VisitPutAPIItemsItemImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemImage400JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemImage400JSONResponse) VisitPutAPIItemsItemImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemImage401JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemImage401JSONResponse) VisitPutAPIItemsItemImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemImage403JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemImage403JSONResponse) VisitPutAPIItemsItemImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemImage404JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemImage404JSONResponse) VisitPutAPIItemsItemImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemImage500JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemImage500JSONResponse) VisitPutAPIItemsItemImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIItemsItemPurchaseLimitRequestObject struct {
+	Item string `json:"item"`
+}
+
+type DeleteAPIItemsItemPurchaseLimitResponseObject interface {
+	VisitDeleteAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIItemsItemPurchaseLimit200Response struct {
+}
+
+func (response DeleteAPIItemsItemPurchaseLimit200Response) VisitDeleteAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteAPIItemsItemPurchaseLimit401JSONResponse ErrorResponse
+
+func (response DeleteAPIItemsItemPurchaseLimit401JSONResponse) VisitDeleteAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIItemsItemPurchaseLimit403JSONResponse ErrorResponse
+
+func (response DeleteAPIItemsItemPurchaseLimit403JSONResponse) VisitDeleteAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIItemsItemPurchaseLimit404JSONResponse ErrorResponse
+
+func (response DeleteAPIItemsItemPurchaseLimit404JSONResponse) VisitDeleteAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIItemsItemPurchaseLimit500JSONResponse ErrorResponse
+
+func (response DeleteAPIItemsItemPurchaseLimit500JSONResponse) VisitDeleteAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemPurchaseLimitRequestObject struct {
+	Item string `json:"item"`
+	Body *PutAPIItemsItemPurchaseLimitJSONRequestBody
+}
+
+type PutAPIItemsItemPurchaseLimitResponseObject interface {
+	VisitPutAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error
+}
+
+type PutAPIItemsItemPurchaseLimit200JSONResponse PurchaseLimit
+
+func (response PutAPIItemsItemPurchaseLimit200JSONResponse) VisitPutAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemPurchaseLimit400JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemPurchaseLimit400JSONResponse) VisitPutAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemPurchaseLimit401JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemPurchaseLimit401JSONResponse) VisitPutAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemPurchaseLimit403JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemPurchaseLimit403JSONResponse) VisitPutAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemPurchaseLimit404JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemPurchaseLimit404JSONResponse) VisitPutAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemPurchaseLimit500JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemPurchaseLimit500JSONResponse) VisitPutAPIItemsItemPurchaseLimitResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIItemsItemVariantsRequestObject struct {
+	Item string `json:"item"`
+}
+
+type GetAPIItemsItemVariantsResponseObject interface {
+	VisitGetAPIItemsItemVariantsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIItemsItemVariants200JSONResponse ItemVariantsResponse
+
+func (response GetAPIItemsItemVariants200JSONResponse) VisitGetAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIItemsItemVariants400JSONResponse ErrorResponse
+
+func (response GetAPIItemsItemVariants400JSONResponse) VisitGetAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIItemsItemVariants401JSONResponse ErrorResponse
+
+func (response GetAPIItemsItemVariants401JSONResponse) VisitGetAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIItemsItemVariants500JSONResponse ErrorResponse
+
+func (response GetAPIItemsItemVariants500JSONResponse) VisitGetAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIItemsItemVariantsRequestObject struct {
+	Item string `json:"item"`
+	Body *PostAPIItemsItemVariantsJSONRequestBody
+}
+
+type PostAPIItemsItemVariantsResponseObject interface {
+	VisitPostAPIItemsItemVariantsResponse(w http.ResponseWriter) error
+}
+
+type PostAPIItemsItemVariants200JSONResponse ItemVariant
+
+func (response PostAPIItemsItemVariants200JSONResponse) VisitPostAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIItemsItemVariants400JSONResponse ErrorResponse
+
+func (response PostAPIItemsItemVariants400JSONResponse) VisitPostAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIItemsItemVariants401JSONResponse ErrorResponse
+
+func (response PostAPIItemsItemVariants401JSONResponse) VisitPostAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIItemsItemVariants403JSONResponse ErrorResponse
+
+func (response PostAPIItemsItemVariants403JSONResponse) VisitPostAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIItemsItemVariants500JSONResponse ErrorResponse
+
+func (response PostAPIItemsItemVariants500JSONResponse) VisitPostAPIItemsItemVariantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemVariantsIDRequestObject struct {
+	Item string             `json:"item"`
+	ID   openapi_types.UUID `json:"id"`
+	Body *PutAPIItemsItemVariantsIDJSONRequestBody
+}
+
+type PutAPIItemsItemVariantsIDResponseObject interface {
+	VisitPutAPIItemsItemVariantsIDResponse(w http.ResponseWriter) error
+}
+
+type PutAPIItemsItemVariantsID200JSONResponse ItemVariant
+
+func (response PutAPIItemsItemVariantsID200JSONResponse) VisitPutAPIItemsItemVariantsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemVariantsID400JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemVariantsID400JSONResponse) VisitPutAPIItemsItemVariantsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemVariantsID401JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemVariantsID401JSONResponse) VisitPutAPIItemsItemVariantsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemVariantsID403JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemVariantsID403JSONResponse) VisitPutAPIItemsItemVariantsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemVariantsID404JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemVariantsID404JSONResponse) VisitPutAPIItemsItemVariantsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIItemsItemVariantsID500JSONResponse ErrorResponse
+
+func (response PutAPIItemsItemVariantsID500JSONResponse) VisitPutAPIItemsItemVariantsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudosRequestObject struct {
+	Params GetAPIKudosParams
+}
+
+type GetAPIKudosResponseObject interface {
+	VisitGetAPIKudosResponse(w http.ResponseWriter) error
+}
+
+type GetAPIKudos200JSONResponse KudosFeedResponse
+
+func (response GetAPIKudos200JSONResponse) VisitGetAPIKudosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudos400JSONResponse ErrorResponse
+
+func (response GetAPIKudos400JSONResponse) VisitGetAPIKudosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudos401JSONResponse ErrorResponse
+
+func (response GetAPIKudos401JSONResponse) VisitGetAPIKudosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudos500JSONResponse ErrorResponse
+
+func (response GetAPIKudos500JSONResponse) VisitGetAPIKudosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudosSettingsRequestObject struct {
+}
+
+type GetAPIKudosSettingsResponseObject interface {
+	VisitGetAPIKudosSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIKudosSettings200JSONResponse KudosSettings
+
+func (response GetAPIKudosSettings200JSONResponse) VisitGetAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudosSettings400JSONResponse ErrorResponse
+
+func (response GetAPIKudosSettings400JSONResponse) VisitGetAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudosSettings401JSONResponse ErrorResponse
+
+func (response GetAPIKudosSettings401JSONResponse) VisitGetAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKudosSettings500JSONResponse ErrorResponse
+
+func (response GetAPIKudosSettings500JSONResponse) VisitGetAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIKudosSettingsRequestObject struct {
+	Body *PutAPIKudosSettingsJSONRequestBody
+}
+
+type PutAPIKudosSettingsResponseObject interface {
+	VisitPutAPIKudosSettingsResponse(w http.ResponseWriter) error
+}
+
+type PutAPIKudosSettings200JSONResponse KudosSettings
+
+func (response PutAPIKudosSettings200JSONResponse) VisitPutAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIKudosSettings400JSONResponse ErrorResponse
+
+func (response PutAPIKudosSettings400JSONResponse) VisitPutAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIKudosSettings401JSONResponse ErrorResponse
+
+func (response PutAPIKudosSettings401JSONResponse) VisitPutAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIKudosSettings500JSONResponse ErrorResponse
+
+func (response PutAPIKudosSettings500JSONResponse) VisitPutAPIKudosSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPILeaderboardsKindRequestObject struct {
+	Kind   LeaderboardKind `json:"kind"`
+	Params GetAPILeaderboardsKindParams
+}
+
+type GetAPILeaderboardsKindResponseObject interface {
+	VisitGetAPILeaderboardsKindResponse(w http.ResponseWriter) error
+}
+
+type GetAPILeaderboardsKind200JSONResponse LeaderboardResponse
+
+func (response GetAPILeaderboardsKind200JSONResponse) VisitGetAPILeaderboardsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPILeaderboardsKind400JSONResponse ErrorResponse
+
+func (response GetAPILeaderboardsKind400JSONResponse) VisitGetAPILeaderboardsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPILeaderboardsKind401JSONResponse ErrorResponse
+
+func (response GetAPILeaderboardsKind401JSONResponse) VisitGetAPILeaderboardsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPILeaderboardsKind403JSONResponse ErrorResponse
+
+func (response GetAPILeaderboardsKind403JSONResponse) VisitGetAPILeaderboardsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPILeaderboardsKind500JSONResponse ErrorResponse
+
+func (response GetAPILeaderboardsKind500JSONResponse) VisitGetAPILeaderboardsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPINotificationsRequestObject struct {
+	Params GetAPINotificationsParams
+}
+
+type GetAPINotificationsResponseObject interface {
+	VisitGetAPINotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetAPINotifications200JSONResponse NotificationsResponse
+
+func (response GetAPINotifications200JSONResponse) VisitGetAPINotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPINotifications401JSONResponse ErrorResponse
+
+func (response GetAPINotifications401JSONResponse) VisitGetAPINotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPINotifications500JSONResponse ErrorResponse
+
+func (response GetAPINotifications500JSONResponse) VisitGetAPINotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPINotificationsReadRequestObject struct {
+}
+
+type PostAPINotificationsReadResponseObject interface {
+	VisitPostAPINotificationsReadResponse(w http.ResponseWriter) error
+}
+
+type PostAPINotificationsRead200Response struct {
+}
+
+func (response PostAPINotificationsRead200Response) VisitPostAPINotificationsReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPINotificationsRead401JSONResponse ErrorResponse
+
+func (response PostAPINotificationsRead401JSONResponse) VisitPostAPINotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPINotificationsRead500JSONResponse ErrorResponse
+
+func (response PostAPINotificationsRead500JSONResponse) VisitPostAPINotificationsReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPINotificationsIDReadRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type PostAPINotificationsIDReadResponseObject interface {
+	VisitPostAPINotificationsIDReadResponse(w http.ResponseWriter) error
+}
+
+type PostAPINotificationsIDRead200Response struct {
+}
+
+func (response PostAPINotificationsIDRead200Response) VisitPostAPINotificationsIDReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPINotificationsIDRead401JSONResponse ErrorResponse
+
+func (response PostAPINotificationsIDRead401JSONResponse) VisitPostAPINotificationsIDReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPINotificationsIDRead404JSONResponse ErrorResponse
+
+func (response PostAPINotificationsIDRead404JSONResponse) VisitPostAPINotificationsIDReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPINotificationsIDRead500JSONResponse ErrorResponse
+
+func (response PostAPINotificationsIDRead500JSONResponse) VisitPostAPINotificationsIDReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPaymentRequestsRequestObject struct {
+}
+
+type GetAPIPaymentRequestsResponseObject interface {
+	VisitGetAPIPaymentRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIPaymentRequests200JSONResponse PaymentRequestsResponse
+
+func (response GetAPIPaymentRequests200JSONResponse) VisitGetAPIPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPaymentRequests401JSONResponse ErrorResponse
+
+func (response GetAPIPaymentRequests401JSONResponse) VisitGetAPIPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPaymentRequests500JSONResponse ErrorResponse
+
+func (response GetAPIPaymentRequests500JSONResponse) VisitGetAPIPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsRequestObject struct {
+	Body *PostAPIPaymentRequestsJSONRequestBody
+}
+
+type PostAPIPaymentRequestsResponseObject interface {
+	VisitPostAPIPaymentRequestsResponse(w http.ResponseWriter) error
+}
+
+type PostAPIPaymentRequests200JSONResponse PaymentRequest
+
+func (response PostAPIPaymentRequests200JSONResponse) VisitPostAPIPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequests400JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequests400JSONResponse) VisitPostAPIPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequests401JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequests401JSONResponse) VisitPostAPIPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequests500JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequests500JSONResponse) VisitPostAPIPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDAcceptRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type PostAPIPaymentRequestsIDAcceptResponseObject interface {
+	VisitPostAPIPaymentRequestsIDAcceptResponse(w http.ResponseWriter) error
+}
+
+type PostAPIPaymentRequestsIDAccept200JSONResponse PaymentRequest
+
+func (response PostAPIPaymentRequestsIDAccept200JSONResponse) VisitPostAPIPaymentRequestsIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDAccept400JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDAccept400JSONResponse) VisitPostAPIPaymentRequestsIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDAccept401JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDAccept401JSONResponse) VisitPostAPIPaymentRequestsIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDAccept403JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDAccept403JSONResponse) VisitPostAPIPaymentRequestsIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDAccept404JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDAccept404JSONResponse) VisitPostAPIPaymentRequestsIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDAccept500JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDAccept500JSONResponse) VisitPostAPIPaymentRequestsIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDDeclineRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type PostAPIPaymentRequestsIDDeclineResponseObject interface {
+	VisitPostAPIPaymentRequestsIDDeclineResponse(w http.ResponseWriter) error
+}
+
+type PostAPIPaymentRequestsIDDecline200JSONResponse PaymentRequest
+
+func (response PostAPIPaymentRequestsIDDecline200JSONResponse) VisitPostAPIPaymentRequestsIDDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDDecline400JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDDecline400JSONResponse) VisitPostAPIPaymentRequestsIDDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDDecline401JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDDecline401JSONResponse) VisitPostAPIPaymentRequestsIDDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDDecline403JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDDecline403JSONResponse) VisitPostAPIPaymentRequestsIDDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDDecline404JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDDecline404JSONResponse) VisitPostAPIPaymentRequestsIDDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPaymentRequestsIDDecline500JSONResponse ErrorResponse
+
+func (response PostAPIPaymentRequestsIDDecline500JSONResponse) VisitPostAPIPaymentRequestsIDDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIProfileRequestObject struct {
+}
+
+type GetAPIProfileResponseObject interface {
+	VisitGetAPIProfileResponse(w http.ResponseWriter) error
+}
+
+type GetAPIProfile200JSONResponse Profile
+
+func (response GetAPIProfile200JSONResponse) VisitGetAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIProfile400JSONResponse ErrorResponse
+
+func (response GetAPIProfile400JSONResponse) VisitGetAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIProfile401JSONResponse ErrorResponse
+
+func (response GetAPIProfile401JSONResponse) VisitGetAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIProfile500JSONResponse ErrorResponse
+
+func (response GetAPIProfile500JSONResponse) VisitGetAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIProfileRequestObject struct {
+	Body *PutAPIProfileJSONRequestBody
+}
+
+type PutAPIProfileResponseObject interface {
+	VisitPutAPIProfileResponse(w http.ResponseWriter) error
+}
+
+type PutAPIProfile200JSONResponse Profile
+
+func (response PutAPIProfile200JSONResponse) VisitPutAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIProfile400JSONResponse ErrorResponse
+
+func (response PutAPIProfile400JSONResponse) VisitPutAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIProfile401JSONResponse ErrorResponse
+
+func (response PutAPIProfile401JSONResponse) VisitPutAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPIProfile500JSONResponse ErrorResponse
+
+func (response PutAPIProfile500JSONResponse) VisitPutAPIProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodesRequestObject struct {
+}
+
+type GetAPIPromoCodesResponseObject interface {
+	VisitGetAPIPromoCodesResponse(w http.ResponseWriter) error
+}
+
+type GetAPIPromoCodes200JSONResponse PromoCodesResponse
+
+func (response GetAPIPromoCodes200JSONResponse) VisitGetAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodes401JSONResponse ErrorResponse
+
+func (response GetAPIPromoCodes401JSONResponse) VisitGetAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodes403JSONResponse ErrorResponse
+
+func (response GetAPIPromoCodes403JSONResponse) VisitGetAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodes500JSONResponse ErrorResponse
+
+func (response GetAPIPromoCodes500JSONResponse) VisitGetAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodesRequestObject struct {
+	Body *PostAPIPromoCodesJSONRequestBody
+}
+
+type PostAPIPromoCodesResponseObject interface {
+	VisitPostAPIPromoCodesResponse(w http.ResponseWriter) error
+}
+
+type PostAPIPromoCodes200JSONResponse PromoCode
+
+func (response PostAPIPromoCodes200JSONResponse) VisitPostAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodes400JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodes400JSONResponse) VisitPostAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodes401JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodes401JSONResponse) VisitPostAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodes403JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodes403JSONResponse) VisitPostAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodes500JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodes500JSONResponse) VisitPostAPIPromoCodesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodesRedeemRequestObject struct {
+	Body *PostAPIPromoCodesRedeemJSONRequestBody
+}
+
+type PostAPIPromoCodesRedeemResponseObject interface {
+	VisitPostAPIPromoCodesRedeemResponse(w http.ResponseWriter) error
+}
+
+type PostAPIPromoCodesRedeem200JSONResponse PromoCodeRedemption
+
+func (response PostAPIPromoCodesRedeem200JSONResponse) VisitPostAPIPromoCodesRedeemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodesRedeem400JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodesRedeem400JSONResponse) VisitPostAPIPromoCodesRedeemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodesRedeem401JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodesRedeem401JSONResponse) VisitPostAPIPromoCodesRedeemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodesRedeem404JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodesRedeem404JSONResponse) VisitPostAPIPromoCodesRedeemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIPromoCodesRedeem500JSONResponse ErrorResponse
+
+func (response PostAPIPromoCodesRedeem500JSONResponse) VisitPostAPIPromoCodesRedeemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodesCodeRedemptionsRequestObject struct {
+	Code string `json:"code"`
+}
+
+type GetAPIPromoCodesCodeRedemptionsResponseObject interface {
+	VisitGetAPIPromoCodesCodeRedemptionsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIPromoCodesCodeRedemptions200JSONResponse PromoCodeRedemptionsResponse
+
+func (response GetAPIPromoCodesCodeRedemptions200JSONResponse) VisitGetAPIPromoCodesCodeRedemptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodesCodeRedemptions401JSONResponse ErrorResponse
+
+func (response GetAPIPromoCodesCodeRedemptions401JSONResponse) VisitGetAPIPromoCodesCodeRedemptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodesCodeRedemptions403JSONResponse ErrorResponse
+
+func (response GetAPIPromoCodesCodeRedemptions403JSONResponse) VisitGetAPIPromoCodesCodeRedemptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodesCodeRedemptions404JSONResponse ErrorResponse
+
+func (response GetAPIPromoCodesCodeRedemptions404JSONResponse) VisitGetAPIPromoCodesCodeRedemptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIPromoCodesCodeRedemptions500JSONResponse ErrorResponse
+
+func (response GetAPIPromoCodesCodeRedemptions500JSONResponse) VisitGetAPIPromoCodesCodeRedemptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesRequestObject struct {
+}
+
+type GetAPIRafflesResponseObject interface {
+	VisitGetAPIRafflesResponse(w http.ResponseWriter) error
+}
+
+type GetAPIRaffles200JSONResponse RafflesResponse
+
+func (response GetAPIRaffles200JSONResponse) VisitGetAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRaffles401JSONResponse ErrorResponse
+
+func (response GetAPIRaffles401JSONResponse) VisitGetAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRaffles500JSONResponse ErrorResponse
+
+func (response GetAPIRaffles500JSONResponse) VisitGetAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRafflesRequestObject struct {
+	Body *PostAPIRafflesJSONRequestBody
+}
+
+type PostAPIRafflesResponseObject interface {
+	VisitPostAPIRafflesResponse(w http.ResponseWriter) error
+}
+
+type PostAPIRaffles200JSONResponse Raffle
+
+func (response PostAPIRaffles200JSONResponse) VisitPostAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRaffles400JSONResponse ErrorResponse
+
+func (response PostAPIRaffles400JSONResponse) VisitPostAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRaffles401JSONResponse ErrorResponse
+
+func (response PostAPIRaffles401JSONResponse) VisitPostAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRaffles403JSONResponse ErrorResponse
+
+func (response PostAPIRaffles403JSONResponse) VisitPostAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRaffles404JSONResponse ErrorResponse
+
+func (response PostAPIRaffles404JSONResponse) VisitPostAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRaffles500JSONResponse ErrorResponse
+
+func (response PostAPIRaffles500JSONResponse) VisitPostAPIRafflesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesIDRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type GetAPIRafflesIDResponseObject interface {
+	VisitGetAPIRafflesIDResponse(w http.ResponseWriter) error
+}
+
+type GetAPIRafflesID200JSONResponse Raffle
+
+func (response GetAPIRafflesID200JSONResponse) VisitGetAPIRafflesIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesID401JSONResponse ErrorResponse
+
+func (response GetAPIRafflesID401JSONResponse) VisitGetAPIRafflesIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesID404JSONResponse ErrorResponse
+
+func (response GetAPIRafflesID404JSONResponse) VisitGetAPIRafflesIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesID500JSONResponse ErrorResponse
+
+func (response GetAPIRafflesID500JSONResponse) VisitGetAPIRafflesIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesIDTicketsRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type GetAPIRafflesIDTicketsResponseObject interface {
+	VisitGetAPIRafflesIDTicketsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIRafflesIDTickets200JSONResponse RaffleTicketsResponse
+
+func (response GetAPIRafflesIDTickets200JSONResponse) VisitGetAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesIDTickets401JSONResponse ErrorResponse
+
+func (response GetAPIRafflesIDTickets401JSONResponse) VisitGetAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesIDTickets404JSONResponse ErrorResponse
+
+func (response GetAPIRafflesIDTickets404JSONResponse) VisitGetAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIRafflesIDTickets500JSONResponse ErrorResponse
+
+func (response GetAPIRafflesIDTickets500JSONResponse) VisitGetAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRafflesIDTicketsRequestObject struct {
+	ID   openapi_types.UUID `json:"id"`
+	Body *PostAPIRafflesIDTicketsJSONRequestBody
+}
+
+type PostAPIRafflesIDTicketsResponseObject interface {
+	VisitPostAPIRafflesIDTicketsResponse(w http.ResponseWriter) error
+}
+
+type PostAPIRafflesIDTickets200JSONResponse RaffleTicketsResponse
+
+func (response PostAPIRafflesIDTickets200JSONResponse) VisitPostAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRafflesIDTickets400JSONResponse ErrorResponse
+
+func (response PostAPIRafflesIDTickets400JSONResponse) VisitPostAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRafflesIDTickets401JSONResponse ErrorResponse
+
+func (response PostAPIRafflesIDTickets401JSONResponse) VisitPostAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRafflesIDTickets404JSONResponse ErrorResponse
+
+func (response PostAPIRafflesIDTickets404JSONResponse) VisitPostAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIRafflesIDTickets500JSONResponse ErrorResponse
+
+func (response PostAPIRafflesIDTickets500JSONResponse) VisitPostAPIRafflesIDTicketsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIReservationsRequestObject struct {
+}
+
+type GetAPIReservationsResponseObject interface {
+	VisitGetAPIReservationsResponse(w http.ResponseWriter) error
+}
+
+type GetAPIReservations200JSONResponse ReservationsResponse
+
+func (response GetAPIReservations200JSONResponse) VisitGetAPIReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIReservations401JSONResponse ErrorResponse
+
+func (response GetAPIReservations401JSONResponse) VisitGetAPIReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIReservations500JSONResponse ErrorResponse
+
+func (response GetAPIReservations500JSONResponse) VisitGetAPIReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservationsRequestObject struct {
+	Body *PostAPIReservationsJSONRequestBody
+}
+
+type PostAPIReservationsResponseObject interface {
+	VisitPostAPIReservationsResponse(w http.ResponseWriter) error
+}
+
+type PostAPIReservations200JSONResponse Reservation
+
+func (response PostAPIReservations200JSONResponse) VisitPostAPIReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservations400JSONResponse ErrorResponse
+
+func (response PostAPIReservations400JSONResponse) VisitPostAPIReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservations401JSONResponse ErrorResponse
+
+func (response PostAPIReservations401JSONResponse) VisitPostAPIReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservations500JSONResponse ErrorResponse
+
+func (response PostAPIReservations500JSONResponse) VisitPostAPIReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIReservationsIDRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type DeleteAPIReservationsIDResponseObject interface {
+	VisitDeleteAPIReservationsIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIReservationsID200Response struct {
+}
+
+func (response DeleteAPIReservationsID200Response) VisitDeleteAPIReservationsIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteAPIReservationsID400JSONResponse ErrorResponse
+
+func (response DeleteAPIReservationsID400JSONResponse) VisitDeleteAPIReservationsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIReservationsID401JSONResponse ErrorResponse
+
+func (response DeleteAPIReservationsID401JSONResponse) VisitDeleteAPIReservationsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIReservationsID404JSONResponse ErrorResponse
+
+func (response DeleteAPIReservationsID404JSONResponse) VisitDeleteAPIReservationsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIReservationsID500JSONResponse ErrorResponse
+
+func (response DeleteAPIReservationsID500JSONResponse) VisitDeleteAPIReservationsIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservationsIDPurchaseRequestObject struct {
+	ID     openapi_types.UUID `json:"id"`
+	Params PostAPIReservationsIDPurchaseParams
+}
+
+type PostAPIReservationsIDPurchaseResponseObject interface {
+	VisitPostAPIReservationsIDPurchaseResponse(w http.ResponseWriter) error
+}
+
+type PostAPIReservationsIDPurchase200Response struct {
+}
+
+func (response PostAPIReservationsIDPurchase200Response) VisitPostAPIReservationsIDPurchaseResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPIReservationsIDPurchase400JSONResponse ErrorResponse
+
+func (response PostAPIReservationsIDPurchase400JSONResponse) VisitPostAPIReservationsIDPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservationsIDPurchase401JSONResponse ErrorResponse
+
+func (response PostAPIReservationsIDPurchase401JSONResponse) VisitPostAPIReservationsIDPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservationsIDPurchase404JSONResponse ErrorResponse
+
+func (response PostAPIReservationsIDPurchase404JSONResponse) VisitPostAPIReservationsIDPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIReservationsIDPurchase500JSONResponse ErrorResponse
+
+func (response PostAPIReservationsIDPurchase500JSONResponse) VisitPostAPIReservationsIDPurchaseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIScheduledTransfersRequestObject struct {
+}
+
+type GetAPIScheduledTransfersResponseObject interface {
+	VisitGetAPIScheduledTransfersResponse(w http.ResponseWriter) error
+}
+
+type GetAPIScheduledTransfers200JSONResponse ScheduledTransfersResponse
+
+func (response GetAPIScheduledTransfers200JSONResponse) VisitGetAPIScheduledTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIScheduledTransfers401JSONResponse ErrorResponse
+
+func (response GetAPIScheduledTransfers401JSONResponse) VisitGetAPIScheduledTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIScheduledTransfers500JSONResponse ErrorResponse
+
+func (response GetAPIScheduledTransfers500JSONResponse) VisitGetAPIScheduledTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIScheduledTransfersRequestObject struct {
+	Body *PostAPIScheduledTransfersJSONRequestBody
+}
+
+type PostAPIScheduledTransfersResponseObject interface {
+	VisitPostAPIScheduledTransfersResponse(w http.ResponseWriter) error
+}
+
+type PostAPIScheduledTransfers200JSONResponse ScheduledTransfer
+
+func (response PostAPIScheduledTransfers200JSONResponse) VisitPostAPIScheduledTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIScheduledTransfers400JSONResponse ErrorResponse
+
+func (response PostAPIScheduledTransfers400JSONResponse) VisitPostAPIScheduledTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIScheduledTransfers401JSONResponse ErrorResponse
+
+func (response PostAPIScheduledTransfers401JSONResponse) VisitPostAPIScheduledTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIScheduledTransfers500JSONResponse ErrorResponse
+
+func (response PostAPIScheduledTransfers500JSONResponse) VisitPostAPIScheduledTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIScheduledTransfersIDRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type DeleteAPIScheduledTransfersIDResponseObject interface {
+	VisitDeleteAPIScheduledTransfersIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIScheduledTransfersID200JSONResponse ScheduledTransfer
+
+func (response DeleteAPIScheduledTransfersID200JSONResponse) VisitDeleteAPIScheduledTransfersIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIScheduledTransfersID400JSONResponse ErrorResponse
+
+func (response DeleteAPIScheduledTransfersID400JSONResponse) VisitDeleteAPIScheduledTransfersIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIScheduledTransfersID401JSONResponse ErrorResponse
+
+func (response DeleteAPIScheduledTransfersID401JSONResponse) VisitDeleteAPIScheduledTransfersIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIScheduledTransfersID403JSONResponse ErrorResponse
+
+func (response DeleteAPIScheduledTransfersID403JSONResponse) VisitDeleteAPIScheduledTransfersIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIScheduledTransfersID404JSONResponse ErrorResponse
+
+func (response DeleteAPIScheduledTransfersID404JSONResponse) VisitDeleteAPIScheduledTransfersIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIScheduledTransfersID500JSONResponse ErrorResponse
+
+func (response DeleteAPIScheduledTransfersID500JSONResponse) VisitDeleteAPIScheduledTransfersIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoinRequestObject struct {
+	Body *PostAPISendCoinJSONRequestBody
+}
+
+type PostAPISendCoinResponseObject interface {
+	VisitPostAPISendCoinResponse(w http.ResponseWriter) error
+}
+
+type PostAPISendCoin200Response struct {
+}
+
+func (response PostAPISendCoin200Response) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPISendCoin202JSONResponse Transfer
+
+func (response PostAPISendCoin202JSONResponse) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoin400JSONResponse ErrorResponse
+
+func (response PostAPISendCoin400JSONResponse) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoin401JSONResponse ErrorResponse
+
+func (response PostAPISendCoin401JSONResponse) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoin500JSONResponse ErrorResponse
+
+func (response PostAPISendCoin500JSONResponse) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoinBatchRequestObject struct {
+	Body *PostAPISendCoinBatchJSONRequestBody
+}
+
+type PostAPISendCoinBatchResponseObject interface {
+	VisitPostAPISendCoinBatchResponse(w http.ResponseWriter) error
+}
+
+type PostAPISendCoinBatch200JSONResponse BatchSendCoinResponse
+
+func (response PostAPISendCoinBatch200JSONResponse) VisitPostAPISendCoinBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoinBatch400JSONResponse BatchSendCoinResponse
+
+func (response PostAPISendCoinBatch400JSONResponse) VisitPostAPISendCoinBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoinBatch401JSONResponse ErrorResponse
+
+func (response PostAPISendCoinBatch401JSONResponse) VisitPostAPISendCoinBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPISendCoinBatch500JSONResponse ErrorResponse
+
+func (response PostAPISendCoinBatch500JSONResponse) VisitPostAPISendCoinBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsRequestObject struct {
+}
+
+type GetAPITeamsResponseObject interface {
+	VisitGetAPITeamsResponse(w http.ResponseWriter) error
+}
+
+type GetAPITeams200JSONResponse TeamsResponse
+
+func (response GetAPITeams200JSONResponse) VisitGetAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeams400JSONResponse ErrorResponse
+
+func (response GetAPITeams400JSONResponse) VisitGetAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeams401JSONResponse ErrorResponse
+
+func (response GetAPITeams401JSONResponse) VisitGetAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeams500JSONResponse ErrorResponse
+
+func (response GetAPITeams500JSONResponse) VisitGetAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsRequestObject struct {
+	Body *PostAPITeamsJSONRequestBody
+}
+
+type PostAPITeamsResponseObject interface {
+	VisitPostAPITeamsResponse(w http.ResponseWriter) error
+}
+
+type PostAPITeams200JSONResponse Team
+
+func (response PostAPITeams200JSONResponse) VisitPostAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeams400JSONResponse ErrorResponse
+
+func (response PostAPITeams400JSONResponse) VisitPostAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeams401JSONResponse ErrorResponse
+
+func (response PostAPITeams401JSONResponse) VisitPostAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeams403JSONResponse ErrorResponse
+
+func (response PostAPITeams403JSONResponse) VisitPostAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeams500JSONResponse ErrorResponse
+
+func (response PostAPITeams500JSONResponse) VisitPostAPITeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeamRequestObject struct {
+	Team string `json:"team"`
+}
+
+type GetAPITeamsTeamResponseObject interface {
+	VisitGetAPITeamsTeamResponse(w http.ResponseWriter) error
+}
+
+type GetAPITeamsTeam200JSONResponse TeamResponse
+
+func (response GetAPITeamsTeam200JSONResponse) VisitGetAPITeamsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeam400JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeam400JSONResponse) VisitGetAPITeamsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeam401JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeam401JSONResponse) VisitGetAPITeamsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeam403JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeam403JSONResponse) VisitGetAPITeamsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeam404JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeam404JSONResponse) VisitGetAPITeamsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeam500JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeam500JSONResponse) VisitGetAPITeamsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamFundRequestObject struct {
+	Team string `json:"team"`
+	Body *PostAPITeamsTeamFundJSONRequestBody
+}
+
+type PostAPITeamsTeamFundResponseObject interface {
+	VisitPostAPITeamsTeamFundResponse(w http.ResponseWriter) error
+}
+
+type PostAPITeamsTeamFund200Response struct {
+}
+
+func (response PostAPITeamsTeamFund200Response) VisitPostAPITeamsTeamFundResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPITeamsTeamFund400JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamFund400JSONResponse) VisitPostAPITeamsTeamFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamFund401JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamFund401JSONResponse) VisitPostAPITeamsTeamFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamFund403JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamFund403JSONResponse) VisitPostAPITeamsTeamFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamFund404JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamFund404JSONResponse) VisitPostAPITeamsTeamFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamFund500JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamFund500JSONResponse) VisitPostAPITeamsTeamFundResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeamHistoryRequestObject struct {
+	Team string `json:"team"`
+}
+
+type GetAPITeamsTeamHistoryResponseObject interface {
+	VisitGetAPITeamsTeamHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetAPITeamsTeamHistory200JSONResponse TeamHistoryResponse
+
+func (response GetAPITeamsTeamHistory200JSONResponse) VisitGetAPITeamsTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeamHistory400JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeamHistory400JSONResponse) VisitGetAPITeamsTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeamHistory401JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeamHistory401JSONResponse) VisitGetAPITeamsTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeamHistory403JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeamHistory403JSONResponse) VisitGetAPITeamsTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeamHistory404JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeamHistory404JSONResponse) VisitGetAPITeamsTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITeamsTeamHistory500JSONResponse ErrorResponse
+
+func (response GetAPITeamsTeamHistory500JSONResponse) VisitGetAPITeamsTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPITeamsTeamMembersUsernameRequestObject struct {
+	Team     string `json:"team"`
+	Username string `json:"username"`
+}
+
+type DeleteAPITeamsTeamMembersUsernameResponseObject interface {
+	VisitDeleteAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPITeamsTeamMembersUsername200Response struct {
+}
+
+func (response DeleteAPITeamsTeamMembersUsername200Response) VisitDeleteAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteAPITeamsTeamMembersUsername400JSONResponse ErrorResponse
+
+func (response DeleteAPITeamsTeamMembersUsername400JSONResponse) VisitDeleteAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPITeamsTeamMembersUsername401JSONResponse ErrorResponse
+
+func (response DeleteAPITeamsTeamMembersUsername401JSONResponse) VisitDeleteAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPITeamsTeamMembersUsername403JSONResponse ErrorResponse
+
+func (response DeleteAPITeamsTeamMembersUsername403JSONResponse) VisitDeleteAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPITeamsTeamMembersUsername404JSONResponse ErrorResponse
+
+func (response DeleteAPITeamsTeamMembersUsername404JSONResponse) VisitDeleteAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPITeamsTeamMembersUsername500JSONResponse ErrorResponse
+
+func (response DeleteAPITeamsTeamMembersUsername500JSONResponse) VisitDeleteAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITeamsTeamMembersUsernameRequestObject struct {
+	Team     string `json:"team"`
+	Username string `json:"username"`
+	Body     *PutAPITeamsTeamMembersUsernameJSONRequestBody
+}
+
+type PutAPITeamsTeamMembersUsernameResponseObject interface {
+	VisitPutAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error
+}
+
+type PutAPITeamsTeamMembersUsername200JSONResponse TeamMember
+
+func (response PutAPITeamsTeamMembersUsername200JSONResponse) VisitPutAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITeamsTeamMembersUsername400JSONResponse ErrorResponse
+
+func (response PutAPITeamsTeamMembersUsername400JSONResponse) VisitPutAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITeamsTeamMembersUsername401JSONResponse ErrorResponse
+
+func (response PutAPITeamsTeamMembersUsername401JSONResponse) VisitPutAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITeamsTeamMembersUsername403JSONResponse ErrorResponse
+
+func (response PutAPITeamsTeamMembersUsername403JSONResponse) VisitPutAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITeamsTeamMembersUsername404JSONResponse ErrorResponse
+
+func (response PutAPITeamsTeamMembersUsername404JSONResponse) VisitPutAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITeamsTeamMembersUsername500JSONResponse ErrorResponse
+
+func (response PutAPITeamsTeamMembersUsername500JSONResponse) VisitPutAPITeamsTeamMembersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamSendCoinRequestObject struct {
+	Team string `json:"team"`
+	Body *PostAPITeamsTeamSendCoinJSONRequestBody
+}
+
+type PostAPITeamsTeamSendCoinResponseObject interface {
+	VisitPostAPITeamsTeamSendCoinResponse(w http.ResponseWriter) error
+}
+
+type PostAPITeamsTeamSendCoin200Response struct {
+}
+
+func (response PostAPITeamsTeamSendCoin200Response) VisitPostAPITeamsTeamSendCoinResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPITeamsTeamSendCoin400JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamSendCoin400JSONResponse) VisitPostAPITeamsTeamSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamSendCoin401JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamSendCoin401JSONResponse) VisitPostAPITeamsTeamSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamSendCoin403JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamSendCoin403JSONResponse) VisitPostAPITeamsTeamSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamSendCoin404JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamSendCoin404JSONResponse) VisitPostAPITeamsTeamSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITeamsTeamSendCoin500JSONResponse ErrorResponse
+
+func (response PostAPITeamsTeamSendCoin500JSONResponse) VisitPostAPITeamsTeamSendCoinResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITransferLimitsRequestObject struct {
+}
+
+type GetAPITransferLimitsResponseObject interface {
+	VisitGetAPITransferLimitsResponse(w http.ResponseWriter) error
+}
+
+type GetAPITransferLimits200JSONResponse TransferLimits
+
+func (response GetAPITransferLimits200JSONResponse) VisitGetAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITransferLimits401JSONResponse ErrorResponse
+
+func (response GetAPITransferLimits401JSONResponse) VisitGetAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITransferLimits403JSONResponse ErrorResponse
+
+func (response GetAPITransferLimits403JSONResponse) VisitGetAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITransferLimits500JSONResponse ErrorResponse
+
+func (response GetAPITransferLimits500JSONResponse) VisitGetAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITransferLimitsRequestObject struct {
+	Body *PutAPITransferLimitsJSONRequestBody
+}
+
+type PutAPITransferLimitsResponseObject interface {
+	VisitPutAPITransferLimitsResponse(w http.ResponseWriter) error
+}
+
+type PutAPITransferLimits200JSONResponse TransferLimits
+
+func (response PutAPITransferLimits200JSONResponse) VisitPutAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITransferLimits400JSONResponse ErrorResponse
+
+func (response PutAPITransferLimits400JSONResponse) VisitPutAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITransferLimits401JSONResponse ErrorResponse
+
+func (response PutAPITransferLimits401JSONResponse) VisitPutAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITransferLimits403JSONResponse ErrorResponse
+
+func (response PutAPITransferLimits403JSONResponse) VisitPutAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAPITransferLimits500JSONResponse ErrorResponse
+
+func (response PutAPITransferLimits500JSONResponse) VisitPutAPITransferLimitsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITransfersPendingRequestObject struct {
+}
+
+type GetAPITransfersPendingResponseObject interface {
+	VisitGetAPITransfersPendingResponse(w http.ResponseWriter) error
+}
+
+type GetAPITransfersPending200JSONResponse PendingTransfersResponse
+
+func (response GetAPITransfersPending200JSONResponse) VisitGetAPITransfersPendingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITransfersPending401JSONResponse ErrorResponse
+
+func (response GetAPITransfersPending401JSONResponse) VisitGetAPITransfersPendingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPITransfersPending500JSONResponse ErrorResponse
+
+func (response GetAPITransfersPending500JSONResponse) VisitGetAPITransfersPendingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDApproveRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type PostAPITransfersIDApproveResponseObject interface {
+	VisitPostAPITransfersIDApproveResponse(w http.ResponseWriter) error
+}
+
+type PostAPITransfersIDApprove200JSONResponse Transfer
+
+func (response PostAPITransfersIDApprove200JSONResponse) VisitPostAPITransfersIDApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDApprove400JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDApprove400JSONResponse) VisitPostAPITransfersIDApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDApprove401JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDApprove401JSONResponse) VisitPostAPITransfersIDApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDApprove403JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDApprove403JSONResponse) VisitPostAPITransfersIDApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDApprove404JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDApprove404JSONResponse) VisitPostAPITransfersIDApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDApprove500JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDApprove500JSONResponse) VisitPostAPITransfersIDApproveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDRejectRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type PostAPITransfersIDRejectResponseObject interface {
+	VisitPostAPITransfersIDRejectResponse(w http.ResponseWriter) error
+}
+
+type PostAPITransfersIDReject200JSONResponse Transfer
+
+func (response PostAPITransfersIDReject200JSONResponse) VisitPostAPITransfersIDRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDReject400JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDReject400JSONResponse) VisitPostAPITransfersIDRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDReject401JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDReject401JSONResponse) VisitPostAPITransfersIDRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDReject403JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDReject403JSONResponse) VisitPostAPITransfersIDRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDReject404JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDReject404JSONResponse) VisitPostAPITransfersIDRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPITransfersIDReject500JSONResponse ErrorResponse
+
+func (response PostAPITransfersIDReject500JSONResponse) VisitPostAPITransfersIDRejectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsersRequestObject struct {
+	Params GetAPIUsersParams
+}
+
+type GetAPIUsersResponseObject interface {
+	VisitGetAPIUsersResponse(w http.ResponseWriter) error
+}
+
+type GetAPIUsers200JSONResponse UserSearchResponse
+
+func (response GetAPIUsers200JSONResponse) VisitGetAPIUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsers400JSONResponse ErrorResponse
+
+func (response GetAPIUsers400JSONResponse) VisitGetAPIUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsers401JSONResponse ErrorResponse
+
+func (response GetAPIUsers401JSONResponse) VisitGetAPIUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsers500JSONResponse ErrorResponse
+
+func (response GetAPIUsers500JSONResponse) VisitGetAPIUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsersUsernameRequestObject struct {
+	Username string `json:"username"`
+}
+
+type GetAPIUsersUsernameResponseObject interface {
+	VisitGetAPIUsersUsernameResponse(w http.ResponseWriter) error
+}
+
+type GetAPIUsersUsername200JSONResponse Profile
+
+func (response GetAPIUsersUsername200JSONResponse) VisitGetAPIUsersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsersUsername400JSONResponse ErrorResponse
+
+func (response GetAPIUsersUsername400JSONResponse) VisitGetAPIUsersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsersUsername401JSONResponse ErrorResponse
+
+func (response GetAPIUsersUsername401JSONResponse) VisitGetAPIUsersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsersUsername404JSONResponse ErrorResponse
+
+func (response GetAPIUsersUsername404JSONResponse) VisitGetAPIUsersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIUsersUsername500JSONResponse ErrorResponse
+
+func (response GetAPIUsersUsername500JSONResponse) VisitGetAPIUsersUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameDeactivateRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostAPIUsersUsernameDeactivateResponseObject interface {
+	VisitPostAPIUsersUsernameDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostAPIUsersUsernameDeactivate200Response struct {
+}
+
+func (response PostAPIUsersUsernameDeactivate200Response) VisitPostAPIUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPIUsersUsernameDeactivate400JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameDeactivate400JSONResponse) VisitPostAPIUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameDeactivate401JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameDeactivate401JSONResponse) VisitPostAPIUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameDeactivate403JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameDeactivate403JSONResponse) VisitPostAPIUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameDeactivate404JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameDeactivate404JSONResponse) VisitPostAPIUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameDeactivate500JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameDeactivate500JSONResponse) VisitPostAPIUsersUsernameDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameReactivateRequestObject struct {
+	Username string `json:"username"`
+}
+
+type PostAPIUsersUsernameReactivateResponseObject interface {
+	VisitPostAPIUsersUsernameReactivateResponse(w http.ResponseWriter) error
+}
+
+type PostAPIUsersUsernameReactivate200Response struct {
+}
+
+func (response PostAPIUsersUsernameReactivate200Response) VisitPostAPIUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPIUsersUsernameReactivate400JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameReactivate400JSONResponse) VisitPostAPIUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameReactivate401JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameReactivate401JSONResponse) VisitPostAPIUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameReactivate403JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameReactivate403JSONResponse) VisitPostAPIUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameReactivate404JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameReactivate404JSONResponse) VisitPostAPIUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIUsersUsernameReactivate500JSONResponse ErrorResponse
+
+func (response PostAPIUsersUsernameReactivate500JSONResponse) VisitPostAPIUsersUsernameReactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooksRequestObject struct {
+}
+
+type GetAPIWebhooksResponseObject interface {
+	VisitGetAPIWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetAPIWebhooks200JSONResponse WebhookSubscriptionsResponse
+
+func (response GetAPIWebhooks200JSONResponse) VisitGetAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooks400JSONResponse ErrorResponse
+
+func (response GetAPIWebhooks400JSONResponse) VisitGetAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooks401JSONResponse ErrorResponse
+
+func (response GetAPIWebhooks401JSONResponse) VisitGetAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooks403JSONResponse ErrorResponse
+
+func (response GetAPIWebhooks403JSONResponse) VisitGetAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooks500JSONResponse ErrorResponse
+
+func (response GetAPIWebhooks500JSONResponse) VisitGetAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooksRequestObject struct {
+	Body *PostAPIWebhooksJSONRequestBody
+}
+
+type PostAPIWebhooksResponseObject interface {
+	VisitPostAPIWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostAPIWebhooks200JSONResponse WebhookSubscription
+
+func (response PostAPIWebhooks200JSONResponse) VisitPostAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooks400JSONResponse ErrorResponse
+
+func (response PostAPIWebhooks400JSONResponse) VisitPostAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooks401JSONResponse ErrorResponse
+
+func (response PostAPIWebhooks401JSONResponse) VisitPostAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooks403JSONResponse ErrorResponse
+
+func (response PostAPIWebhooks403JSONResponse) VisitPostAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooks500JSONResponse ErrorResponse
+
+func (response PostAPIWebhooks500JSONResponse) VisitPostAPIWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooksDeadLettersRequestObject struct {
+	Params GetAPIWebhooksDeadLettersParams
+}
+
+type GetAPIWebhooksDeadLettersResponseObject interface {
+	VisitGetAPIWebhooksDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetAPIWebhooksDeadLetters200JSONResponse WebhookDeadLettersResponse
+
+func (response GetAPIWebhooksDeadLetters200JSONResponse) VisitGetAPIWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooksDeadLetters400JSONResponse ErrorResponse
+
+func (response GetAPIWebhooksDeadLetters400JSONResponse) VisitGetAPIWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooksDeadLetters401JSONResponse ErrorResponse
+
+func (response GetAPIWebhooksDeadLetters401JSONResponse) VisitGetAPIWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooksDeadLetters403JSONResponse ErrorResponse
+
+func (response GetAPIWebhooksDeadLetters403JSONResponse) VisitGetAPIWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWebhooksDeadLetters500JSONResponse ErrorResponse
+
+func (response GetAPIWebhooksDeadLetters500JSONResponse) VisitGetAPIWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooksDeliveriesIDRetryRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type PostAPIWebhooksDeliveriesIDRetryResponseObject interface {
+	VisitPostAPIWebhooksDeliveriesIDRetryResponse(w http.ResponseWriter) error
+}
+
+type PostAPIWebhooksDeliveriesIDRetry200Response struct {
+}
+
+func (response PostAPIWebhooksDeliveriesIDRetry200Response) VisitPostAPIWebhooksDeliveriesIDRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAPIWebhooksDeliveriesIDRetry400JSONResponse ErrorResponse
+
+func (response PostAPIWebhooksDeliveriesIDRetry400JSONResponse) VisitPostAPIWebhooksDeliveriesIDRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooksDeliveriesIDRetry401JSONResponse ErrorResponse
+
+func (response PostAPIWebhooksDeliveriesIDRetry401JSONResponse) VisitPostAPIWebhooksDeliveriesIDRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooksDeliveriesIDRetry403JSONResponse ErrorResponse
+
+func (response PostAPIWebhooksDeliveriesIDRetry403JSONResponse) VisitPostAPIWebhooksDeliveriesIDRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooksDeliveriesIDRetry404JSONResponse ErrorResponse
+
+func (response PostAPIWebhooksDeliveriesIDRetry404JSONResponse) VisitPostAPIWebhooksDeliveriesIDRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWebhooksDeliveriesIDRetry500JSONResponse ErrorResponse
+
+func (response PostAPIWebhooksDeliveriesIDRetry500JSONResponse) VisitPostAPIWebhooksDeliveriesIDRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWebhooksIDRequestObject struct {
+	ID openapi_types.UUID `json:"id"`
+}
+
+type DeleteAPIWebhooksIDResponseObject interface {
+	VisitDeleteAPIWebhooksIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIWebhooksID200Response struct {
+}
+
+func (response DeleteAPIWebhooksID200Response) VisitDeleteAPIWebhooksIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteAPIWebhooksID400JSONResponse ErrorResponse
+
+func (response DeleteAPIWebhooksID400JSONResponse) VisitDeleteAPIWebhooksIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWebhooksID401JSONResponse ErrorResponse
+
+func (response DeleteAPIWebhooksID401JSONResponse) VisitDeleteAPIWebhooksIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWebhooksID403JSONResponse ErrorResponse
+
+func (response DeleteAPIWebhooksID403JSONResponse) VisitDeleteAPIWebhooksIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWebhooksID404JSONResponse ErrorResponse
+
+func (response DeleteAPIWebhooksID404JSONResponse) VisitDeleteAPIWebhooksIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWebhooksID500JSONResponse ErrorResponse
+
+func (response DeleteAPIWebhooksID500JSONResponse) VisitDeleteAPIWebhooksIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWishlistRequestObject struct {
+}
+
+type GetAPIWishlistResponseObject interface {
+	VisitGetAPIWishlistResponse(w http.ResponseWriter) error
+}
+
+type GetAPIWishlist200JSONResponse WishlistResponse
+
+func (response GetAPIWishlist200JSONResponse) VisitGetAPIWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWishlist401JSONResponse ErrorResponse
+
+func (response GetAPIWishlist401JSONResponse) VisitGetAPIWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIWishlist500JSONResponse ErrorResponse
+
+func (response GetAPIWishlist500JSONResponse) VisitGetAPIWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWishlistRequestObject struct {
+	Body *PostAPIWishlistJSONRequestBody
+}
+
+type PostAPIWishlistResponseObject interface {
+	VisitPostAPIWishlistResponse(w http.ResponseWriter) error
+}
+
+type PostAPIWishlist200JSONResponse WishlistItem
+
+func (response PostAPIWishlist200JSONResponse) VisitPostAPIWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWishlist400JSONResponse ErrorResponse
+
+func (response PostAPIWishlist400JSONResponse) VisitPostAPIWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWishlist401JSONResponse ErrorResponse
+
+func (response PostAPIWishlist401JSONResponse) VisitPostAPIWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAPIWishlist500JSONResponse ErrorResponse
+
+func (response PostAPIWishlist500JSONResponse) VisitPostAPIWishlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWishlistItemRequestObject struct {
+	Item string `json:"item"`
+}
+
+type DeleteAPIWishlistItemResponseObject interface {
+	VisitDeleteAPIWishlistItemResponse(w http.ResponseWriter) error
+}
+
+type DeleteAPIWishlistItem200Response struct {
+}
+
+func (response DeleteAPIWishlistItem200Response) VisitDeleteAPIWishlistItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteAPIWishlistItem401JSONResponse ErrorResponse
+
+func (response DeleteAPIWishlistItem401JSONResponse) VisitDeleteAPIWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWishlistItem404JSONResponse ErrorResponse
+
+func (response DeleteAPIWishlistItem404JSONResponse) VisitDeleteAPIWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAPIWishlistItem500JSONResponse ErrorResponse
+
+func (response DeleteAPIWishlistItem500JSONResponse) VisitDeleteAPIWishlistItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить открытые аукционы, начиная с ближайших к завершению.
+	// (GET /api/auctions)
+	GetAPIAuctions(ctx context.Context, request GetAPIAuctionsRequestObject) (GetAPIAuctionsResponseObject, error)
+	// Создать аукцион на предмет. Доступно только администраторам.
+	// (POST /api/auctions)
+	PostAPIAuctions(ctx context.Context, request PostAPIAuctionsRequestObject) (PostAPIAuctionsResponseObject, error)
+	// Отменить открытый аукцион и вернуть монеты за наибольшую ставку. Доступно только администраторам.
+	// (DELETE /api/auctions/{id})
+	DeleteAPIAuctionsID(ctx context.Context, request DeleteAPIAuctionsIDRequestObject) (DeleteAPIAuctionsIDResponseObject, error)
+	// Получить аукцион.
+	// (GET /api/auctions/{id})
+	GetAPIAuctionsID(ctx context.Context, request GetAPIAuctionsIDRequestObject) (GetAPIAuctionsIDResponseObject, error)
+	// Получить ставки аукциона, начиная с наибольшей.
+	// (GET /api/auctions/{id}/bids)
+	GetAPIAuctionsIDBids(ctx context.Context, request GetAPIAuctionsIDBidsRequestObject) (GetAPIAuctionsIDBidsResponseObject, error)
+	// Сделать ставку. Сумма ставки удерживается с баланса, а монеты за перебитую ставку возвращаются.
+	// (POST /api/auctions/{id}/bids)
+	PostAPIAuctionsIDBids(ctx context.Context, request PostAPIAuctionsIDBidsRequestObject) (PostAPIAuctionsIDBidsResponseObject, error)
+	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
+	// (POST /api/auth)
+	PostAPIAuth(ctx context.Context, request PostAPIAuthRequestObject) (PostAPIAuthResponseObject, error)
+	// Установить бюджет на награды пользователя. Доступно только администраторам.
+	// (PUT /api/budgets/{username})
+	PutAPIBudgetsUsername(ctx context.Context, request PutAPIBudgetsUsernameRequestObject) (PutAPIBudgetsUsernameResponseObject, error)
+	// Купить предмет за монеты.
+	// (GET /api/buy/{item})
+	GetAPIBuyItem(ctx context.Context, request GetAPIBuyItemRequestObject) (GetAPIBuyItemResponseObject, error)
+	// Купить предмет за монеты в подарок другому пользователю.
+	// (POST /api/buy/{item}/gift)
+	PostAPIBuyItemGift(ctx context.Context, request PostAPIBuyItemGiftRequestObject) (PostAPIBuyItemGiftResponseObject, error)
+	// Получить действующие и будущие скидочные акции.
+	// (GET /api/campaigns)
+	GetAPICampaigns(ctx context.Context, request GetAPICampaignsRequestObject) (GetAPICampaignsResponseObject, error)
+	// Создать скидочную акцию на предмет или категорию. Доступно только администраторам.
+	// (POST /api/campaigns)
+	PostAPICampaigns(ctx context.Context, request PostAPICampaignsRequestObject) (PostAPICampaignsResponseObject, error)
+	// Завершить скидочную акцию досрочно. Будущая акция удаляется. Доступно только администраторам.
+	// (DELETE /api/campaigns/{id})
+	DeleteAPICampaignsID(ctx context.Context, request DeleteAPICampaignsIDRequestObject) (DeleteAPICampaignsIDResponseObject, error)
+	// Подписаться на события текущего пользователя в формате Server-Sent Events — получение монет, покупки и подарки, начисления из командного фонда, значки и бонусы за них.
+	// (GET /api/events)
+	GetAPIEvents(ctx context.Context, request GetAPIEventsRequestObject) (GetAPIEventsResponseObject, error)
+	// Получить здоровье сервиса.
+	// (GET /api/health)
+	GetAPIHealth(ctx context.Context, request GetAPIHealthRequestObject) (GetAPIHealthResponseObject, error)
+	// Получить изображение. Изображения не меняются, поэтому их можно кэшировать без ограничения срока.
+	// (GET /api/images/{key})
+	GetAPIImagesKey(ctx context.Context, request GetAPIImagesKeyRequestObject) (GetAPIImagesKeyResponseObject, error)
+	// Получить информацию о монетах, инвентаре и истории транзакций.
+	// (GET /api/info)
+	GetAPIInfo(ctx context.Context, request GetAPIInfoRequestObject) (GetAPIInfoResponseObject, error)
+	// Передать купленные предметы из инвентаря другому пользователю.
+	// (POST /api/inventory/transfer)
+	PostAPIInventoryTransfer(ctx context.Context, request PostAPIInventoryTransferRequestObject) (PostAPIInventoryTransferResponseObject, error)
+	// Получить историю передачи предметов текущего пользователя.
+	// (GET /api/inventory/transfers)
+	GetAPIInventoryTransfers(ctx context.Context, request GetAPIInventoryTransfersRequestObject) (GetAPIInventoryTransfersResponseObject, error)
+	// Получить каталог предметов.
+	// (GET /api/items)
+	GetAPIItems(ctx context.Context, request GetAPIItemsRequestObject) (GetAPIItemsResponseObject, error)
+	// Изменить категорию и описание предмета. Доступно только администраторам.
+	// (PUT /api/items/{item})
+	PutAPIItemsItem(ctx context.Context, request PutAPIItemsItemRequestObject) (PutAPIItemsItemResponseObject, error)
+	// Загрузить изображение предмета в формате PNG, JPEG, GIF или WebP размером до 5 МиБ. Доступно только администраторам.
+	// (PUT /api/items/{item}/image)
+	PutAPIItemsItemImage(ctx context.Context, request PutAPIItemsItemImageRequestObject) (PutAPIItemsItemImageResponseObject, error)
+	// Снять ограничение на количество предметов у одного пользователя. Доступно только администраторам.
+	// (DELETE /api/items/{item}/purchaseLimit)
+	DeleteAPIItemsItemPurchaseLimit(ctx context.Context, request DeleteAPIItemsItemPurchaseLimitRequestObject) (DeleteAPIItemsItemPurchaseLimitResponseObject, error)
+	// Установить ограничение на количество предметов у одного пользователя. Доступно только администраторам.
+	// (PUT /api/items/{item}/purchaseLimit)
+	PutAPIItemsItemPurchaseLimit(ctx context.Context, request PutAPIItemsItemPurchaseLimitRequestObject) (PutAPIItemsItemPurchaseLimitResponseObject, error)
+	// Получить варианты предмета.
+	// (GET /api/items/{item}/variants)
+	GetAPIItemsItemVariants(ctx context.Context, request GetAPIItemsItemVariantsRequestObject) (GetAPIItemsItemVariantsResponseObject, error)
+	// Добавить вариант предмета. Доступно только администраторам.
+	// (POST /api/items/{item}/variants)
+	PostAPIItemsItemVariants(ctx context.Context, request PostAPIItemsItemVariantsRequestObject) (PostAPIItemsItemVariantsResponseObject, error)
+	// Изменить запас и цену варианта предмета. Доступно только администраторам.
+	// (PUT /api/items/{item}/variants/{id})
+	PutAPIItemsItemVariantsID(ctx context.Context, request PutAPIItemsItemVariantsIDRequestObject) (PutAPIItemsItemVariantsIDResponseObject, error)
+	// Получить ленту благодарностей — последние переводы с сообщениями между пользователями, которые согласились их показывать.
+	// (GET /api/kudos)
+	GetAPIKudos(ctx context.Context, request GetAPIKudosRequestObject) (GetAPIKudosResponseObject, error)
+	// Получить свои настройки ленты благодарностей.
+	// (GET /api/kudos/settings)
+	GetAPIKudosSettings(ctx context.Context, request GetAPIKudosSettingsRequestObject) (GetAPIKudosSettingsResponseObject, error)
+	// Изменить свои настройки ленты благодарностей.
+	// (PUT /api/kudos/settings)
+	PutAPIKudosSettings(ctx context.Context, request PutAPIKudosSettingsRequestObject) (PutAPIKudosSettingsResponseObject, error)
+	// Получить рейтинг пользователей за период. Доступно только администраторам.
+	// (GET /api/leaderboards/{kind})
+	GetAPILeaderboardsKind(ctx context.Context, request GetAPILeaderboardsKindRequestObject) (GetAPILeaderboardsKindResponseObject, error)
+	// Получить уведомления текущего пользователя, начиная с новых.
+	// (GET /api/notifications)
+	GetAPINotifications(ctx context.Context, request GetAPINotificationsRequestObject) (GetAPINotificationsResponseObject, error)
+	// Отметить все уведомления текущего пользователя прочитанными.
+	// (POST /api/notifications/read)
+	PostAPINotificationsRead(ctx context.Context, request PostAPINotificationsReadRequestObject) (PostAPINotificationsReadResponseObject, error)
+	// Отметить уведомление прочитанным.
+	// (POST /api/notifications/{id}/read)
+	PostAPINotificationsIDRead(ctx context.Context, request PostAPINotificationsIDReadRequestObject) (PostAPINotificationsIDReadResponseObject, error)
+	// Получить входящие ожидающие и исходящие запросы монет.
+	// (GET /api/paymentRequests)
+	GetAPIPaymentRequests(ctx context.Context, request GetAPIPaymentRequestsRequestObject) (GetAPIPaymentRequestsResponseObject, error)
+	// Запросить монеты у другого пользователя.
+	// (POST /api/paymentRequests)
+	PostAPIPaymentRequests(ctx context.Context, request PostAPIPaymentRequestsRequestObject) (PostAPIPaymentRequestsResponseObject, error)
+	// Принять запрос монет и перевести монеты запросившему пользователю. Доступно только плательщику.
+	// (POST /api/paymentRequests/{id}/accept)
+	PostAPIPaymentRequestsIDAccept(ctx context.Context, request PostAPIPaymentRequestsIDAcceptRequestObject) (PostAPIPaymentRequestsIDAcceptResponseObject, error)
+	// Отклонить запрос монет. Доступно только плательщику.
+	// (POST /api/paymentRequests/{id}/decline)
+	PostAPIPaymentRequestsIDDecline(ctx context.Context, request PostAPIPaymentRequestsIDDeclineRequestObject) (PostAPIPaymentRequestsIDDeclineResponseObject, error)
+	// Получить свой профиль.
+	// (GET /api/profile)
+	GetAPIProfile(ctx context.Context, request GetAPIProfileRequestObject) (GetAPIProfileResponseObject, error)
+	// Изменить свой профиль. Незаданные поля очищаются.
+	// (PUT /api/profile)
+	PutAPIProfile(ctx context.Context, request PutAPIProfileRequestObject) (PutAPIProfileResponseObject, error)
+	// Получить промокоды. Доступно только администраторам.
+	// (GET /api/promoCodes)
+	GetAPIPromoCodes(ctx context.Context, request GetAPIPromoCodesRequestObject) (GetAPIPromoCodesResponseObject, error)
+	// Создать промокод. Доступно только администраторам.
+	// (POST /api/promoCodes)
+	PostAPIPromoCodes(ctx context.Context, request PostAPIPromoCodesRequestObject) (PostAPIPromoCodesResponseObject, error)
+	// Активировать промокод на монеты.
+	// (POST /api/promoCodes/redeem)
+	PostAPIPromoCodesRedeem(ctx context.Context, request PostAPIPromoCodesRedeemRequestObject) (PostAPIPromoCodesRedeemResponseObject, error)
+	// Получить активации промокода. Доступно только администраторам.
+	// (GET /api/promoCodes/{code}/redemptions)
+	GetAPIPromoCodesCodeRedemptions(ctx context.Context, request GetAPIPromoCodesCodeRedemptionsRequestObject) (GetAPIPromoCodesCodeRedemptionsResponseObject, error)
+	// Получить открытые розыгрыши, начиная с ближайших.
+	// (GET /api/raffles)
+	GetAPIRaffles(ctx context.Context, request GetAPIRafflesRequestObject) (GetAPIRafflesResponseObject, error)
+	// Создать розыгрыш предмета. Доступно только администраторам.
+	// (POST /api/raffles)
+	PostAPIRaffles(ctx context.Context, request PostAPIRafflesRequestObject) (PostAPIRafflesResponseObject, error)
+	// Получить розыгрыш. После розыгрыша в ответе раскрывается зерно, по которому выбраны победители.
+	// (GET /api/raffles/{id})
+	GetAPIRafflesID(ctx context.Context, request GetAPIRafflesIDRequestObject) (GetAPIRafflesIDResponseObject, error)
+	// Получить билеты розыгрыша по порядку номеров.
+	// (GET /api/raffles/{id}/tickets)
+	GetAPIRafflesIDTickets(ctx context.Context, request GetAPIRafflesIDTicketsRequestObject) (GetAPIRafflesIDTicketsResponseObject, error)
+	// Купить билеты розыгрыша за монеты.
+	// (POST /api/raffles/{id}/tickets)
+	PostAPIRafflesIDTickets(ctx context.Context, request PostAPIRafflesIDTicketsRequestObject) (PostAPIRafflesIDTicketsResponseObject, error)
+	// Получить активные резервы текущего пользователя, начиная с новых.
+	// (GET /api/reservations)
+	GetAPIReservations(ctx context.Context, request GetAPIReservationsRequestObject) (GetAPIReservationsResponseObject, error)
+	// Зарезервировать предмет. Резерв удерживает единицу предмета и монеты на время подтверждения покупки. Вариант можно выбрать позже, тогда удерживаются монеты за самый дорогой вариант в наличии.
+	// (POST /api/reservations)
+	PostAPIReservations(ctx context.Context, request PostAPIReservationsRequestObject) (PostAPIReservationsResponseObject, error)
+	// Отменить активный резерв и вернуть удержанные монеты.
+	// (DELETE /api/reservations/{id})
+	DeleteAPIReservationsID(ctx context.Context, request DeleteAPIReservationsIDRequestObject) (DeleteAPIReservationsIDResponseObject, error)
+	// Купить зарезервированный предмет за удержанные монеты. Если вариант не был выбран при резервировании, его нужно выбрать сейчас.
+	// (POST /api/reservations/{id}/purchase)
+	PostAPIReservationsIDPurchase(ctx context.Context, request PostAPIReservationsIDPurchaseRequestObject) (PostAPIReservationsIDPurchaseResponseObject, error)
+	// Получить запланированные переводы монет текущего пользователя.
+	// (GET /api/scheduledTransfers)
+	GetAPIScheduledTransfers(ctx context.Context, request GetAPIScheduledTransfersRequestObject) (GetAPIScheduledTransfersResponseObject, error)
+	// Запланировать разовый или повторяющийся перевод монет.
+	// (POST /api/scheduledTransfers)
+	PostAPIScheduledTransfers(ctx context.Context, request PostAPIScheduledTransfersRequestObject) (PostAPIScheduledTransfersResponseObject, error)
+	// Отменить запланированный перевод монет. Доступно только отправителю.
+	// (DELETE /api/scheduledTransfers/{id})
+	DeleteAPIScheduledTransfersID(ctx context.Context, request DeleteAPIScheduledTransfersIDRequestObject) (DeleteAPIScheduledTransfersIDResponseObject, error)
+	// Отправить монеты другому пользователю.
+	// (POST /api/sendCoin)
+	PostAPISendCoin(ctx context.Context, request PostAPISendCoinRequestObject) (PostAPISendCoinResponseObject, error)
+	// Отправить монеты нескольким пользователям в одной транзакции. Если хотя бы один перевод невозможен, не выполняется ни один.
+	// (POST /api/sendCoin/batch)
+	PostAPISendCoinBatch(ctx context.Context, request PostAPISendCoinBatchRequestObject) (PostAPISendCoinBatchResponseObject, error)
+	// Получить команды, в которых состоит пользователь.
+	// (GET /api/teams)
+	GetAPITeams(ctx context.Context, request GetAPITeamsRequestObject) (GetAPITeamsResponseObject, error)
+	// Создать команду. Доступно только администраторам.
+	// (POST /api/teams)
+	PostAPITeams(ctx context.Context, request PostAPITeamsRequestObject) (PostAPITeamsResponseObject, error)
+	// Получить команду с участниками. Доступно участникам команды и администраторам.
+	// (GET /api/teams/{team})
+	GetAPITeamsTeam(ctx context.Context, request GetAPITeamsTeamRequestObject) (GetAPITeamsTeamResponseObject, error)
+	// Пополнить фонд команды новыми монетами. Доступно только администраторам.
+	// (POST /api/teams/{team}/fund)
+	PostAPITeamsTeamFund(ctx context.Context, request PostAPITeamsTeamFundRequestObject) (PostAPITeamsTeamFundResponseObject, error)
+	// Получить историю фонда команды. Доступно участникам команды и администраторам.
+	// (GET /api/teams/{team}/history)
+	GetAPITeamsTeamHistory(ctx context.Context, request GetAPITeamsTeamHistoryRequestObject) (GetAPITeamsTeamHistoryResponseObject, error)
+	// Удалить пользователя из команды. Доступно только администраторам.
+	// (DELETE /api/teams/{team}/members/{username})
+	DeleteAPITeamsTeamMembersUsername(ctx context.Context, request DeleteAPITeamsTeamMembersUsernameRequestObject) (DeleteAPITeamsTeamMembersUsernameResponseObject, error)
+	// Добавить пользователя в команду или изменить его роль. Доступно только администраторам.
+	// (PUT /api/teams/{team}/members/{username})
+	PutAPITeamsTeamMembersUsername(ctx context.Context, request PutAPITeamsTeamMembersUsernameRequestObject) (PutAPITeamsTeamMembersUsernameResponseObject, error)
+	// Отправить монеты из фонда команды участнику команды. Доступно только менеджерам команды.
+	// (POST /api/teams/{team}/sendCoin)
+	PostAPITeamsTeamSendCoin(ctx context.Context, request PostAPITeamsTeamSendCoinRequestObject) (PostAPITeamsTeamSendCoinResponseObject, error)
+	// Получить ограничения на переводы монет. Доступно только администраторам.
+	// (GET /api/transferLimits)
+	GetAPITransferLimits(ctx context.Context, request GetAPITransferLimitsRequestObject) (GetAPITransferLimitsResponseObject, error)
+	// Изменить ограничения на переводы монет. Доступно только администраторам.
+	// (PUT /api/transferLimits)
+	PutAPITransferLimits(ctx context.Context, request PutAPITransferLimitsRequestObject) (PutAPITransferLimitsResponseObject, error)
+	// Получить переводы, ожидающие одобрения текущего пользователя.
+	// (GET /api/transfers/pending)
+	GetAPITransfersPending(ctx context.Context, request GetAPITransfersPendingRequestObject) (GetAPITransfersPendingResponseObject, error)
+	// Одобрить ожидающий перевод. Доступно менеджерам команд отправителя и администраторам.
+	// (POST /api/transfers/{id}/approve)
+	PostAPITransfersIDApprove(ctx context.Context, request PostAPITransfersIDApproveRequestObject) (PostAPITransfersIDApproveResponseObject, error)
+	// Отклонить ожидающий перевод и вернуть монеты отправителю. Доступно менеджерам команд отправителя и администраторам.
+	// (POST /api/transfers/{id}/reject)
+	PostAPITransfersIDReject(ctx context.Context, request PostAPITransfersIDRejectRequestObject) (PostAPITransfersIDRejectResponseObject, error)
+	// Найти коллег по имени пользователя или отображаемому имени. Деактивированные пользователи не возвращаются.
+	// (GET /api/users)
+	GetAPIUsers(ctx context.Context, request GetAPIUsersRequestObject) (GetAPIUsersResponseObject, error)
+	// Получить публичный профиль пользователя.
+	// (GET /api/users/{username})
+	GetAPIUsersUsername(ctx context.Context, request GetAPIUsersUsernameRequestObject) (GetAPIUsersUsernameResponseObject, error)
+	// Деактивировать пользователя, например уволившегося. Деактивированные пользователи не находятся в поиске коллег, не могут войти и не могут получать монеты и предметы. Доступно только администраторам.
+	// (POST /api/users/{username}/deactivate)
+	PostAPIUsersUsernameDeactivate(ctx context.Context, request PostAPIUsersUsernameDeactivateRequestObject) (PostAPIUsersUsernameDeactivateResponseObject, error)
+	// Снова активировать деактивированного пользователя. Доступно только администраторам.
+	// (POST /api/users/{username}/reactivate)
+	PostAPIUsersUsernameReactivate(ctx context.Context, request PostAPIUsersUsernameReactivateRequestObject) (PostAPIUsersUsernameReactivateResponseObject, error)
+	// Получить активные подписки на вебхуки. Доступно только администраторам.
+	// (GET /api/webhooks)
+	GetAPIWebhooks(ctx context.Context, request GetAPIWebhooksRequestObject) (GetAPIWebhooksResponseObject, error)
+	// Подписать URL на события. Доступно только администраторам.
+	// (POST /api/webhooks)
+	PostAPIWebhooks(ctx context.Context, request PostAPIWebhooksRequestObject) (PostAPIWebhooksResponseObject, error)
+	// Получить недоставленные после всех попыток вебхуки. Доступно только администраторам.
+	// (GET /api/webhooks/dead-letters)
+	GetAPIWebhooksDeadLetters(ctx context.Context, request GetAPIWebhooksDeadLettersRequestObject) (GetAPIWebhooksDeadLettersResponseObject, error)
+	// Повторить недоставленный вебхук. Доступно только администраторам.
+	// (POST /api/webhooks/deliveries/{id}/retry)
+	PostAPIWebhooksDeliveriesIDRetry(ctx context.Context, request PostAPIWebhooksDeliveriesIDRetryRequestObject) (PostAPIWebhooksDeliveriesIDRetryResponseObject, error)
+	// Удалить подписку на вебхуки. Доступно только администраторам.
+	// (DELETE /api/webhooks/{id})
+	DeleteAPIWebhooksID(ctx context.Context, request DeleteAPIWebhooksIDRequestObject) (DeleteAPIWebhooksIDResponseObject, error)
+	// Получить список желаний текущего пользователя с прогрессом накопления монет.
+	// (GET /api/wishlist)
+	GetAPIWishlist(ctx context.Context, request GetAPIWishlistRequestObject) (GetAPIWishlistResponseObject, error)
+	// Добавить предмет в список желаний.
+	// (POST /api/wishlist)
+	PostAPIWishlist(ctx context.Context, request PostAPIWishlistRequestObject) (PostAPIWishlistResponseObject, error)
+	// Удалить предмет из списка желаний.
+	// (DELETE /api/wishlist/{item})
+	DeleteAPIWishlistItem(ctx context.Context, request DeleteAPIWishlistItemRequestObject) (DeleteAPIWishlistItemResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAPIAuctions operation middleware
+func (sh *strictHandler) GetAPIAuctions(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIAuctionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIAuctions(ctx, request.(GetAPIAuctionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIAuctions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIAuctionsResponseObject); ok {
+		if err := validResponse.VisitGetAPIAuctionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIAuctions operation middleware
+func (sh *strictHandler) PostAPIAuctions(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIAuctionsRequestObject
+
+	var body PostAPIAuctionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIAuctions(ctx, request.(PostAPIAuctionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIAuctions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIAuctionsResponseObject); ok {
+		if err := validResponse.VisitPostAPIAuctionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPIAuctionsID operation middleware
+func (sh *strictHandler) DeleteAPIAuctionsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAPIAuctionsIDRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPIAuctionsID(ctx, request.(DeleteAPIAuctionsIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPIAuctionsID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPIAuctionsIDResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIAuctionsIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIAuctionsID operation middleware
+func (sh *strictHandler) GetAPIAuctionsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAPIAuctionsIDRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIAuctionsID(ctx, request.(GetAPIAuctionsIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIAuctionsID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIAuctionsIDResponseObject); ok {
+		if err := validResponse.VisitGetAPIAuctionsIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIAuctionsIDBids operation middleware
+func (sh *strictHandler) GetAPIAuctionsIDBids(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAPIAuctionsIDBidsRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIAuctionsIDBids(ctx, request.(GetAPIAuctionsIDBidsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIAuctionsIDBids")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIAuctionsIDBidsResponseObject); ok {
+		if err := validResponse.VisitGetAPIAuctionsIDBidsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIAuctionsIDBids operation middleware
+func (sh *strictHandler) PostAPIAuctionsIDBids(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPIAuctionsIDBidsRequestObject
+
+	request.ID = id
+
+	var body PostAPIAuctionsIDBidsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIAuctionsIDBids(ctx, request.(PostAPIAuctionsIDBidsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIAuctionsIDBids")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIAuctionsIDBidsResponseObject); ok {
+		if err := validResponse.VisitPostAPIAuctionsIDBidsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIAuth operation middleware
+func (sh *strictHandler) PostAPIAuth(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIAuthRequestObject
+
+	var body PostAPIAuthJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIAuth(ctx, request.(PostAPIAuthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIAuth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIAuthResponseObject); ok {
+		if err := validResponse.VisitPostAPIAuthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPIBudgetsUsername operation middleware
+func (sh *strictHandler) PutAPIBudgetsUsername(w http.ResponseWriter, r *http.Request, username string) {
+	var request PutAPIBudgetsUsernameRequestObject
+
+	request.Username = username
+
+	var body PutAPIBudgetsUsernameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPIBudgetsUsername(ctx, request.(PutAPIBudgetsUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPIBudgetsUsername")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPIBudgetsUsernameResponseObject); ok {
+		if err := validResponse.VisitPutAPIBudgetsUsernameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIBuyItem operation middleware
+func (sh *strictHandler) GetAPIBuyItem(w http.ResponseWriter, r *http.Request, item string, params GetAPIBuyItemParams) {
+	var request GetAPIBuyItemRequestObject
+
+	request.Item = item
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIBuyItem(ctx, request.(GetAPIBuyItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIBuyItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIBuyItemResponseObject); ok {
+		if err := validResponse.VisitGetAPIBuyItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIBuyItemGift operation middleware
+func (sh *strictHandler) PostAPIBuyItemGift(w http.ResponseWriter, r *http.Request, item string) {
+	var request PostAPIBuyItemGiftRequestObject
+
+	request.Item = item
+
+	var body PostAPIBuyItemGiftJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIBuyItemGift(ctx, request.(PostAPIBuyItemGiftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIBuyItemGift")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIBuyItemGiftResponseObject); ok {
+		if err := validResponse.VisitPostAPIBuyItemGiftResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPICampaigns operation middleware
+func (sh *strictHandler) GetAPICampaigns(w http.ResponseWriter, r *http.Request) {
+	var request GetAPICampaignsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPICampaigns(ctx, request.(GetAPICampaignsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPICampaigns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPICampaignsResponseObject); ok {
+		if err := validResponse.VisitGetAPICampaignsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPICampaigns operation middleware
+func (sh *strictHandler) PostAPICampaigns(w http.ResponseWriter, r *http.Request) {
+	var request PostAPICampaignsRequestObject
+
+	var body PostAPICampaignsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPICampaigns(ctx, request.(PostAPICampaignsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPICampaigns")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPICampaignsResponseObject); ok {
+		if err := validResponse.VisitPostAPICampaignsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPICampaignsID operation middleware
+func (sh *strictHandler) DeleteAPICampaignsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAPICampaignsIDRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPICampaignsID(ctx, request.(DeleteAPICampaignsIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPICampaignsID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPICampaignsIDResponseObject); ok {
+		if err := validResponse.VisitDeleteAPICampaignsIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIEvents operation middleware
+func (sh *strictHandler) GetAPIEvents(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIEventsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIEvents(ctx, request.(GetAPIEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIEventsResponseObject); ok {
+		if err := validResponse.VisitGetAPIEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIHealth operation middleware
+func (sh *strictHandler) GetAPIHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIHealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIHealth(ctx, request.(GetAPIHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIHealthResponseObject); ok {
+		if err := validResponse.VisitGetAPIHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIImagesKey operation middleware
+func (sh *strictHandler) GetAPIImagesKey(w http.ResponseWriter, r *http.Request, key string, params GetAPIImagesKeyParams) {
+	var request GetAPIImagesKeyRequestObject
+
+	request.Key = key
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIImagesKey(ctx, request.(GetAPIImagesKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIImagesKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIImagesKeyResponseObject); ok {
+		if err := validResponse.VisitGetAPIImagesKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIInfo operation middleware
+func (sh *strictHandler) GetAPIInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIInfoRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIInfo(ctx, request.(GetAPIInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIInfo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIInfoResponseObject); ok {
+		if err := validResponse.VisitGetAPIInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIInventoryTransfer operation middleware
+func (sh *strictHandler) PostAPIInventoryTransfer(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIInventoryTransferRequestObject
+
+	var body PostAPIInventoryTransferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIInventoryTransfer(ctx, request.(PostAPIInventoryTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIInventoryTransfer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIInventoryTransferResponseObject); ok {
+		if err := validResponse.VisitPostAPIInventoryTransferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIInventoryTransfers operation middleware
+func (sh *strictHandler) GetAPIInventoryTransfers(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIInventoryTransfersRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIInventoryTransfers(ctx, request.(GetAPIInventoryTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIInventoryTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIInventoryTransfersResponseObject); ok {
+		if err := validResponse.VisitGetAPIInventoryTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIItems operation middleware
+func (sh *strictHandler) GetAPIItems(w http.ResponseWriter, r *http.Request, params GetAPIItemsParams) {
+	var request GetAPIItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIItems(ctx, request.(GetAPIItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIItemsResponseObject); ok {
+		if err := validResponse.VisitGetAPIItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPIItemsItem operation middleware
+func (sh *strictHandler) PutAPIItemsItem(w http.ResponseWriter, r *http.Request, item string) {
+	var request PutAPIItemsItemRequestObject
+
+	request.Item = item
+
+	var body PutAPIItemsItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPIItemsItem(ctx, request.(PutAPIItemsItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPIItemsItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPIItemsItemResponseObject); ok {
+		if err := validResponse.VisitPutAPIItemsItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPIItemsItemImage operation middleware
+func (sh *strictHandler) PutAPIItemsItemImage(w http.ResponseWriter, r *http.Request, item string) {
+	var request PutAPIItemsItemImageRequestObject
+
+	request.Item = item
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPIItemsItemImage(ctx, request.(PutAPIItemsItemImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPIItemsItemImage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPIItemsItemImageResponseObject); ok {
+		if err := validResponse.VisitPutAPIItemsItemImageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPIItemsItemPurchaseLimit operation middleware
+func (sh *strictHandler) DeleteAPIItemsItemPurchaseLimit(w http.ResponseWriter, r *http.Request, item string) {
+	var request DeleteAPIItemsItemPurchaseLimitRequestObject
+
+	request.Item = item
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPIItemsItemPurchaseLimit(ctx, request.(DeleteAPIItemsItemPurchaseLimitRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPIItemsItemPurchaseLimit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPIItemsItemPurchaseLimitResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIItemsItemPurchaseLimitResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPIItemsItemPurchaseLimit operation middleware
+func (sh *strictHandler) PutAPIItemsItemPurchaseLimit(w http.ResponseWriter, r *http.Request, item string) {
+	var request PutAPIItemsItemPurchaseLimitRequestObject
+
+	request.Item = item
+
+	var body PutAPIItemsItemPurchaseLimitJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPIItemsItemPurchaseLimit(ctx, request.(PutAPIItemsItemPurchaseLimitRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPIItemsItemPurchaseLimit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPIItemsItemPurchaseLimitResponseObject); ok {
+		if err := validResponse.VisitPutAPIItemsItemPurchaseLimitResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIItemsItemVariants operation middleware
+func (sh *strictHandler) GetAPIItemsItemVariants(w http.ResponseWriter, r *http.Request, item string) {
+	var request GetAPIItemsItemVariantsRequestObject
+
+	request.Item = item
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIItemsItemVariants(ctx, request.(GetAPIItemsItemVariantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIItemsItemVariants")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIItemsItemVariantsResponseObject); ok {
+		if err := validResponse.VisitGetAPIItemsItemVariantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIItemsItemVariants operation middleware
+func (sh *strictHandler) PostAPIItemsItemVariants(w http.ResponseWriter, r *http.Request, item string) {
+	var request PostAPIItemsItemVariantsRequestObject
+
+	request.Item = item
+
+	var body PostAPIItemsItemVariantsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIItemsItemVariants(ctx, request.(PostAPIItemsItemVariantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIItemsItemVariants")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIItemsItemVariantsResponseObject); ok {
+		if err := validResponse.VisitPostAPIItemsItemVariantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPIItemsItemVariantsID operation middleware
+func (sh *strictHandler) PutAPIItemsItemVariantsID(w http.ResponseWriter, r *http.Request, item string, id openapi_types.UUID) {
+	var request PutAPIItemsItemVariantsIDRequestObject
+
+	request.Item = item
+	request.ID = id
+
+	var body PutAPIItemsItemVariantsIDJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPIItemsItemVariantsID(ctx, request.(PutAPIItemsItemVariantsIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPIItemsItemVariantsID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPIItemsItemVariantsIDResponseObject); ok {
+		if err := validResponse.VisitPutAPIItemsItemVariantsIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIKudos operation middleware
+func (sh *strictHandler) GetAPIKudos(w http.ResponseWriter, r *http.Request, params GetAPIKudosParams) {
+	var request GetAPIKudosRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIKudos(ctx, request.(GetAPIKudosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIKudos")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIKudosResponseObject); ok {
+		if err := validResponse.VisitGetAPIKudosResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIKudosSettings operation middleware
+func (sh *strictHandler) GetAPIKudosSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIKudosSettingsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIKudosSettings(ctx, request.(GetAPIKudosSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIKudosSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIKudosSettingsResponseObject); ok {
+		if err := validResponse.VisitGetAPIKudosSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPIKudosSettings operation middleware
+func (sh *strictHandler) PutAPIKudosSettings(w http.ResponseWriter, r *http.Request) {
+	var request PutAPIKudosSettingsRequestObject
+
+	var body PutAPIKudosSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPIKudosSettings(ctx, request.(PutAPIKudosSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPIKudosSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPIKudosSettingsResponseObject); ok {
+		if err := validResponse.VisitPutAPIKudosSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPILeaderboardsKind operation middleware
+func (sh *strictHandler) GetAPILeaderboardsKind(w http.ResponseWriter, r *http.Request, kind LeaderboardKind, params GetAPILeaderboardsKindParams) {
+	var request GetAPILeaderboardsKindRequestObject
+
+	request.Kind = kind
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPILeaderboardsKind(ctx, request.(GetAPILeaderboardsKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPILeaderboardsKind")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPILeaderboardsKindResponseObject); ok {
+		if err := validResponse.VisitGetAPILeaderboardsKindResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPINotifications operation middleware
+func (sh *strictHandler) GetAPINotifications(w http.ResponseWriter, r *http.Request, params GetAPINotificationsParams) {
+	var request GetAPINotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPINotifications(ctx, request.(GetAPINotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPINotifications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPINotificationsResponseObject); ok {
+		if err := validResponse.VisitGetAPINotificationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPINotificationsRead operation middleware
+func (sh *strictHandler) PostAPINotificationsRead(w http.ResponseWriter, r *http.Request) {
+	var request PostAPINotificationsReadRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPINotificationsRead(ctx, request.(PostAPINotificationsReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPINotificationsRead")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPINotificationsReadResponseObject); ok {
+		if err := validResponse.VisitPostAPINotificationsReadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPINotificationsIDRead operation middleware
+func (sh *strictHandler) PostAPINotificationsIDRead(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPINotificationsIDReadRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPINotificationsIDRead(ctx, request.(PostAPINotificationsIDReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPINotificationsIDRead")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPINotificationsIDReadResponseObject); ok {
+		if err := validResponse.VisitPostAPINotificationsIDReadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIPaymentRequests operation middleware
+func (sh *strictHandler) GetAPIPaymentRequests(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIPaymentRequestsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIPaymentRequests(ctx, request.(GetAPIPaymentRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIPaymentRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIPaymentRequestsResponseObject); ok {
+		if err := validResponse.VisitGetAPIPaymentRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIPaymentRequests operation middleware
+func (sh *strictHandler) PostAPIPaymentRequests(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIPaymentRequestsRequestObject
+
+	var body PostAPIPaymentRequestsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIPaymentRequests(ctx, request.(PostAPIPaymentRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIPaymentRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIPaymentRequestsResponseObject); ok {
+		if err := validResponse.VisitPostAPIPaymentRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIPaymentRequestsIDAccept operation middleware
+func (sh *strictHandler) PostAPIPaymentRequestsIDAccept(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPIPaymentRequestsIDAcceptRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIPaymentRequestsIDAccept(ctx, request.(PostAPIPaymentRequestsIDAcceptRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIPaymentRequestsIDAccept")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIPaymentRequestsIDAcceptResponseObject); ok {
+		if err := validResponse.VisitPostAPIPaymentRequestsIDAcceptResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIPaymentRequestsIDDecline operation middleware
+func (sh *strictHandler) PostAPIPaymentRequestsIDDecline(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPIPaymentRequestsIDDeclineRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIPaymentRequestsIDDecline(ctx, request.(PostAPIPaymentRequestsIDDeclineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIPaymentRequestsIDDecline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIPaymentRequestsIDDeclineResponseObject); ok {
+		if err := validResponse.VisitPostAPIPaymentRequestsIDDeclineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIProfile operation middleware
+func (sh *strictHandler) GetAPIProfile(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIProfileRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIProfile(ctx, request.(GetAPIProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIProfileResponseObject); ok {
+		if err := validResponse.VisitGetAPIProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPIProfile operation middleware
+func (sh *strictHandler) PutAPIProfile(w http.ResponseWriter, r *http.Request) {
+	var request PutAPIProfileRequestObject
+
+	var body PutAPIProfileJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPIProfile(ctx, request.(PutAPIProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPIProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPIProfileResponseObject); ok {
+		if err := validResponse.VisitPutAPIProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIPromoCodes operation middleware
+func (sh *strictHandler) GetAPIPromoCodes(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIPromoCodesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIPromoCodes(ctx, request.(GetAPIPromoCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIPromoCodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIPromoCodesResponseObject); ok {
+		if err := validResponse.VisitGetAPIPromoCodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIPromoCodes operation middleware
+func (sh *strictHandler) PostAPIPromoCodes(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIPromoCodesRequestObject
+
+	var body PostAPIPromoCodesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIPromoCodes(ctx, request.(PostAPIPromoCodesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIPromoCodes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIPromoCodesResponseObject); ok {
+		if err := validResponse.VisitPostAPIPromoCodesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIPromoCodesRedeem operation middleware
+func (sh *strictHandler) PostAPIPromoCodesRedeem(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIPromoCodesRedeemRequestObject
+
+	var body PostAPIPromoCodesRedeemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIPromoCodesRedeem(ctx, request.(PostAPIPromoCodesRedeemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIPromoCodesRedeem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIPromoCodesRedeemResponseObject); ok {
+		if err := validResponse.VisitPostAPIPromoCodesRedeemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIPromoCodesCodeRedemptions operation middleware
+func (sh *strictHandler) GetAPIPromoCodesCodeRedemptions(w http.ResponseWriter, r *http.Request, code string) {
+	var request GetAPIPromoCodesCodeRedemptionsRequestObject
+
+	request.Code = code
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIPromoCodesCodeRedemptions(ctx, request.(GetAPIPromoCodesCodeRedemptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIPromoCodesCodeRedemptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIPromoCodesCodeRedemptionsResponseObject); ok {
+		if err := validResponse.VisitGetAPIPromoCodesCodeRedemptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIRaffles operation middleware
+func (sh *strictHandler) GetAPIRaffles(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIRafflesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIRaffles(ctx, request.(GetAPIRafflesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIRaffles")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIRafflesResponseObject); ok {
+		if err := validResponse.VisitGetAPIRafflesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIRaffles operation middleware
+func (sh *strictHandler) PostAPIRaffles(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIRafflesRequestObject
+
+	var body PostAPIRafflesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIRaffles(ctx, request.(PostAPIRafflesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIRaffles")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIRafflesResponseObject); ok {
+		if err := validResponse.VisitPostAPIRafflesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIRafflesID operation middleware
+func (sh *strictHandler) GetAPIRafflesID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAPIRafflesIDRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIRafflesID(ctx, request.(GetAPIRafflesIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIRafflesID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIRafflesIDResponseObject); ok {
+		if err := validResponse.VisitGetAPIRafflesIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIRafflesIDTickets operation middleware
+func (sh *strictHandler) GetAPIRafflesIDTickets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request GetAPIRafflesIDTicketsRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIRafflesIDTickets(ctx, request.(GetAPIRafflesIDTicketsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIRafflesIDTickets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIRafflesIDTicketsResponseObject); ok {
+		if err := validResponse.VisitGetAPIRafflesIDTicketsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIRafflesIDTickets operation middleware
+func (sh *strictHandler) PostAPIRafflesIDTickets(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPIRafflesIDTicketsRequestObject
+
+	request.ID = id
+
+	var body PostAPIRafflesIDTicketsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIRafflesIDTickets(ctx, request.(PostAPIRafflesIDTicketsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIRafflesIDTickets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIRafflesIDTicketsResponseObject); ok {
+		if err := validResponse.VisitPostAPIRafflesIDTicketsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIReservations operation middleware
+func (sh *strictHandler) GetAPIReservations(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIReservationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIReservations(ctx, request.(GetAPIReservationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIReservations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIReservationsResponseObject); ok {
+		if err := validResponse.VisitGetAPIReservationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIReservations operation middleware
+func (sh *strictHandler) PostAPIReservations(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIReservationsRequestObject
+
+	var body PostAPIReservationsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIReservations(ctx, request.(PostAPIReservationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIReservations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIReservationsResponseObject); ok {
+		if err := validResponse.VisitPostAPIReservationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPIReservationsID operation middleware
+func (sh *strictHandler) DeleteAPIReservationsID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAPIReservationsIDRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPIReservationsID(ctx, request.(DeleteAPIReservationsIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPIReservationsID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPIReservationsIDResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIReservationsIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIReservationsIDPurchase operation middleware
+func (sh *strictHandler) PostAPIReservationsIDPurchase(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostAPIReservationsIDPurchaseParams) {
+	var request PostAPIReservationsIDPurchaseRequestObject
+
+	request.ID = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIReservationsIDPurchase(ctx, request.(PostAPIReservationsIDPurchaseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIReservationsIDPurchase")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIReservationsIDPurchaseResponseObject); ok {
+		if err := validResponse.VisitPostAPIReservationsIDPurchaseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPIScheduledTransfers operation middleware
+func (sh *strictHandler) GetAPIScheduledTransfers(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIScheduledTransfersRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIScheduledTransfers(ctx, request.(GetAPIScheduledTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIScheduledTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIScheduledTransfersResponseObject); ok {
+		if err := validResponse.VisitGetAPIScheduledTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIScheduledTransfers operation middleware
+func (sh *strictHandler) PostAPIScheduledTransfers(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIScheduledTransfersRequestObject
+
+	var body PostAPIScheduledTransfersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIScheduledTransfers(ctx, request.(PostAPIScheduledTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIScheduledTransfers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIScheduledTransfersResponseObject); ok {
+		if err := validResponse.VisitPostAPIScheduledTransfersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPIScheduledTransfersID operation middleware
+func (sh *strictHandler) DeleteAPIScheduledTransfersID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAPIScheduledTransfersIDRequestObject
+
+	request.ID = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPIScheduledTransfersID(ctx, request.(DeleteAPIScheduledTransfersIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPIScheduledTransfersID")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPIScheduledTransfersIDResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIScheduledTransfersIDResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPISendCoin operation middleware
+func (sh *strictHandler) PostAPISendCoin(w http.ResponseWriter, r *http.Request) {
+	var request PostAPISendCoinRequestObject
+
+	var body PostAPISendCoinJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPISendCoin(ctx, request.(PostAPISendCoinRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPISendCoin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPISendCoinResponseObject); ok {
+		if err := validResponse.VisitPostAPISendCoinResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIHealthRequestObject struct {
+// PostAPISendCoinBatch operation middleware
+func (sh *strictHandler) PostAPISendCoinBatch(w http.ResponseWriter, r *http.Request) {
+	var request PostAPISendCoinBatchRequestObject
+
+	var body PostAPISendCoinBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPISendCoinBatch(ctx, request.(PostAPISendCoinBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPISendCoinBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPISendCoinBatchResponseObject); ok {
+		if err := validResponse.VisitPostAPISendCoinBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIHealthResponseObject interface {
-	VisitGetAPIHealthResponse(w http.ResponseWriter) error
+// GetAPITeams operation middleware
+func (sh *strictHandler) GetAPITeams(w http.ResponseWriter, r *http.Request) {
+	var request GetAPITeamsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPITeams(ctx, request.(GetAPITeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPITeams")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPITeamsResponseObject); ok {
+		if err := validResponse.VisitGetAPITeamsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIHealth200JSONResponse HealthResponse
+// PostAPITeams operation middleware
+func (sh *strictHandler) PostAPITeams(w http.ResponseWriter, r *http.Request) {
+	var request PostAPITeamsRequestObject
 
-func (response GetAPIHealth200JSONResponse) VisitGetAPIHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body PostAPITeamsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPITeams(ctx, request.(PostAPITeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPITeams")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPITeamsResponseObject); ok {
+		if err := validResponse.VisitPostAPITeamsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIHealth500JSONResponse ErrorResponse
+// GetAPITeamsTeam operation middleware
+func (sh *strictHandler) GetAPITeamsTeam(w http.ResponseWriter, r *http.Request, team string) {
+	var request GetAPITeamsTeamRequestObject
 
-func (response GetAPIHealth500JSONResponse) VisitGetAPIHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Team = team
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPITeamsTeam(ctx, request.(GetAPITeamsTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPITeamsTeam")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPITeamsTeamResponseObject); ok {
+		if err := validResponse.VisitGetAPITeamsTeamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIInfoRequestObject struct {
+// PostAPITeamsTeamFund operation middleware
+func (sh *strictHandler) PostAPITeamsTeamFund(w http.ResponseWriter, r *http.Request, team string) {
+	var request PostAPITeamsTeamFundRequestObject
+
+	request.Team = team
+
+	var body PostAPITeamsTeamFundJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPITeamsTeamFund(ctx, request.(PostAPITeamsTeamFundRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPITeamsTeamFund")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPITeamsTeamFundResponseObject); ok {
+		if err := validResponse.VisitPostAPITeamsTeamFundResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIInfoResponseObject interface {
-	VisitGetAPIInfoResponse(w http.ResponseWriter) error
+// GetAPITeamsTeamHistory operation middleware
+func (sh *strictHandler) GetAPITeamsTeamHistory(w http.ResponseWriter, r *http.Request, team string) {
+	var request GetAPITeamsTeamHistoryRequestObject
+
+	request.Team = team
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPITeamsTeamHistory(ctx, request.(GetAPITeamsTeamHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPITeamsTeamHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPITeamsTeamHistoryResponseObject); ok {
+		if err := validResponse.VisitGetAPITeamsTeamHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPITeamsTeamMembersUsername operation middleware
+func (sh *strictHandler) DeleteAPITeamsTeamMembersUsername(w http.ResponseWriter, r *http.Request, team string, username string) {
+	var request DeleteAPITeamsTeamMembersUsernameRequestObject
+
+	request.Team = team
+	request.Username = username
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPITeamsTeamMembersUsername(ctx, request.(DeleteAPITeamsTeamMembersUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPITeamsTeamMembersUsername")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPITeamsTeamMembersUsernameResponseObject); ok {
+		if err := validResponse.VisitDeleteAPITeamsTeamMembersUsernameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPITeamsTeamMembersUsername operation middleware
+func (sh *strictHandler) PutAPITeamsTeamMembersUsername(w http.ResponseWriter, r *http.Request, team string, username string) {
+	var request PutAPITeamsTeamMembersUsernameRequestObject
+
+	request.Team = team
+	request.Username = username
+
+	var body PutAPITeamsTeamMembersUsernameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPITeamsTeamMembersUsername(ctx, request.(PutAPITeamsTeamMembersUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPITeamsTeamMembersUsername")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPITeamsTeamMembersUsernameResponseObject); ok {
+		if err := validResponse.VisitPutAPITeamsTeamMembersUsernameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPITeamsTeamSendCoin operation middleware
+func (sh *strictHandler) PostAPITeamsTeamSendCoin(w http.ResponseWriter, r *http.Request, team string) {
+	var request PostAPITeamsTeamSendCoinRequestObject
+
+	request.Team = team
+
+	var body PostAPITeamsTeamSendCoinJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPITeamsTeamSendCoin(ctx, request.(PostAPITeamsTeamSendCoinRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPITeamsTeamSendCoin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPITeamsTeamSendCoinResponseObject); ok {
+		if err := validResponse.VisitPostAPITeamsTeamSendCoinResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPITransferLimits operation middleware
+func (sh *strictHandler) GetAPITransferLimits(w http.ResponseWriter, r *http.Request) {
+	var request GetAPITransferLimitsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPITransferLimits(ctx, request.(GetAPITransferLimitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPITransferLimits")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPITransferLimitsResponseObject); ok {
+		if err := validResponse.VisitGetAPITransferLimitsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAPITransferLimits operation middleware
+func (sh *strictHandler) PutAPITransferLimits(w http.ResponseWriter, r *http.Request) {
+	var request PutAPITransferLimitsRequestObject
+
+	var body PutAPITransferLimitsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAPITransferLimits(ctx, request.(PutAPITransferLimitsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAPITransferLimits")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutAPITransferLimitsResponseObject); ok {
+		if err := validResponse.VisitPutAPITransferLimitsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAPITransfersPending operation middleware
+func (sh *strictHandler) GetAPITransfersPending(w http.ResponseWriter, r *http.Request) {
+	var request GetAPITransfersPendingRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPITransfersPending(ctx, request.(GetAPITransfersPendingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPITransfersPending")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPITransfersPendingResponseObject); ok {
+		if err := validResponse.VisitGetAPITransfersPendingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIInfo200JSONResponse InfoResponse
-
-func (response GetAPIInfo200JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostAPITransfersIDApprove operation middleware
+func (sh *strictHandler) PostAPITransfersIDApprove(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPITransfersIDApproveRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ID = id
 
-type GetAPIInfo400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPITransfersIDApprove(ctx, request.(PostAPITransfersIDApproveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPITransfersIDApprove")
+	}
 
-func (response GetAPIInfo400JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPITransfersIDApproveResponseObject); ok {
+		if err := validResponse.VisitPostAPITransfersIDApproveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIInfo401JSONResponse ErrorResponse
+// PostAPITransfersIDReject operation middleware
+func (sh *strictHandler) PostAPITransfersIDReject(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPITransfersIDRejectRequestObject
 
-func (response GetAPIInfo401JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.ID = id
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPITransfersIDReject(ctx, request.(PostAPITransfersIDRejectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPITransfersIDReject")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPITransfersIDRejectResponseObject); ok {
+		if err := validResponse.VisitPostAPITransfersIDRejectResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAPIInfo500JSONResponse ErrorResponse
+// GetAPIUsers operation middleware
+func (sh *strictHandler) GetAPIUsers(w http.ResponseWriter, r *http.Request, params GetAPIUsersParams) {
+	var request GetAPIUsersRequestObject
 
-func (response GetAPIInfo500JSONResponse) VisitGetAPIInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIUsers(ctx, request.(GetAPIUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIUsers")
+	}
 
-type PostAPISendCoinRequestObject struct {
-	Body *PostAPISendCoinJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostAPISendCoinResponseObject interface {
-	VisitPostAPISendCoinResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIUsersResponseObject); ok {
+		if err := validResponse.VisitGetAPIUsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostAPISendCoin200Response struct {
-}
+// GetAPIUsersUsername operation middleware
+func (sh *strictHandler) GetAPIUsersUsername(w http.ResponseWriter, r *http.Request, username string) {
+	var request GetAPIUsersUsernameRequestObject
 
-func (response PostAPISendCoin200Response) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	request.Username = username
 
-type PostAPISendCoin400JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIUsersUsername(ctx, request.(GetAPIUsersUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIUsersUsername")
+	}
 
-func (response PostAPISendCoin400JSONResponse) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIUsersUsernameResponseObject); ok {
+		if err := validResponse.VisitGetAPIUsersUsernameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostAPISendCoin401JSONResponse ErrorResponse
-
-func (response PostAPISendCoin401JSONResponse) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// PostAPIUsersUsernameDeactivate operation middleware
+func (sh *strictHandler) PostAPIUsersUsernameDeactivate(w http.ResponseWriter, r *http.Request, username string) {
+	var request PostAPIUsersUsernameDeactivateRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Username = username
 
-type PostAPISendCoin500JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIUsersUsernameDeactivate(ctx, request.(PostAPIUsersUsernameDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIUsersUsernameDeactivate")
+	}
 
-func (response PostAPISendCoin500JSONResponse) VisitPostAPISendCoinResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIUsersUsernameDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostAPIUsersUsernameDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Аутентификация и получение JWT-токена. При первой аутентификации пользователь создается автоматически.
-	// (POST /api/auth)
-	PostAPIAuth(ctx context.Context, request PostAPIAuthRequestObject) (PostAPIAuthResponseObject, error)
-	// Купить предмет за монеты.
-	// (GET /api/buy/{item})
-	GetAPIBuyItem(ctx context.Context, request GetAPIBuyItemRequestObject) (GetAPIBuyItemResponseObject, error)
-	// Получить здоровье сервиса.
-	// (GET /api/health)
-	GetAPIHealth(ctx context.Context, request GetAPIHealthRequestObject) (GetAPIHealthResponseObject, error)
-	// Получить информацию о монетах, инвентаре и истории транзакций.
-	// (GET /api/info)
-	GetAPIInfo(ctx context.Context, request GetAPIInfoRequestObject) (GetAPIInfoResponseObject, error)
-	// Отправить монеты другому пользователю.
-	// (POST /api/sendCoin)
-	PostAPISendCoin(ctx context.Context, request PostAPISendCoinRequestObject) (PostAPISendCoinResponseObject, error)
-}
+// PostAPIUsersUsernameReactivate operation middleware
+func (sh *strictHandler) PostAPIUsersUsernameReactivate(w http.ResponseWriter, r *http.Request, username string) {
+	var request PostAPIUsersUsernameReactivateRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Username = username
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAPIUsersUsernameReactivate(ctx, request.(PostAPIUsersUsernameReactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAPIUsersUsernameReactivate")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAPIUsersUsernameReactivateResponseObject); ok {
+		if err := validResponse.VisitPostAPIUsersUsernameReactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetAPIWebhooks operation middleware
+func (sh *strictHandler) GetAPIWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIWebhooksRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIWebhooks(ctx, request.(GetAPIWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetAPIWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostAPIAuth operation middleware
-func (sh *strictHandler) PostAPIAuth(w http.ResponseWriter, r *http.Request) {
-	var request PostAPIAuthRequestObject
+// PostAPIWebhooks operation middleware
+func (sh *strictHandler) PostAPIWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIWebhooksRequestObject
 
-	var body PostAPIAuthJSONRequestBody
+	var body PostAPIWebhooksJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1418,18 +23471,18 @@ func (sh *strictHandler) PostAPIAuth(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAPIAuth(ctx, request.(PostAPIAuthRequestObject))
+		return sh.ssi.PostAPIWebhooks(ctx, request.(PostAPIWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAPIAuth")
+		handler = middleware(handler, "PostAPIWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostAPIAuthResponseObject); ok {
-		if err := validResponse.VisitPostAPIAuthResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostAPIWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostAPIWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1437,25 +23490,25 @@ func (sh *strictHandler) PostAPIAuth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetAPIBuyItem operation middleware
-func (sh *strictHandler) GetAPIBuyItem(w http.ResponseWriter, r *http.Request, item string) {
-	var request GetAPIBuyItemRequestObject
+// GetAPIWebhooksDeadLetters operation middleware
+func (sh *strictHandler) GetAPIWebhooksDeadLetters(w http.ResponseWriter, r *http.Request, params GetAPIWebhooksDeadLettersParams) {
+	var request GetAPIWebhooksDeadLettersRequestObject
 
-	request.Item = item
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAPIBuyItem(ctx, request.(GetAPIBuyItemRequestObject))
+		return sh.ssi.GetAPIWebhooksDeadLetters(ctx, request.(GetAPIWebhooksDeadLettersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAPIBuyItem")
+		handler = middleware(handler, "GetAPIWebhooksDeadLetters")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAPIBuyItemResponseObject); ok {
-		if err := validResponse.VisitGetAPIBuyItemResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetAPIWebhooksDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetAPIWebhooksDeadLettersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1463,23 +23516,25 @@ func (sh *strictHandler) GetAPIBuyItem(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetAPIHealth operation middleware
-func (sh *strictHandler) GetAPIHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetAPIHealthRequestObject
+// PostAPIWebhooksDeliveriesIDRetry operation middleware
+func (sh *strictHandler) PostAPIWebhooksDeliveriesIDRetry(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request PostAPIWebhooksDeliveriesIDRetryRequestObject
+
+	request.ID = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAPIHealth(ctx, request.(GetAPIHealthRequestObject))
+		return sh.ssi.PostAPIWebhooksDeliveriesIDRetry(ctx, request.(PostAPIWebhooksDeliveriesIDRetryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAPIHealth")
+		handler = middleware(handler, "PostAPIWebhooksDeliveriesIDRetry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAPIHealthResponseObject); ok {
-		if err := validResponse.VisitGetAPIHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostAPIWebhooksDeliveriesIDRetryResponseObject); ok {
+		if err := validResponse.VisitPostAPIWebhooksDeliveriesIDRetryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1487,23 +23542,25 @@ func (sh *strictHandler) GetAPIHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetAPIInfo operation middleware
-func (sh *strictHandler) GetAPIInfo(w http.ResponseWriter, r *http.Request) {
-	var request GetAPIInfoRequestObject
+// DeleteAPIWebhooksID operation middleware
+func (sh *strictHandler) DeleteAPIWebhooksID(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAPIWebhooksIDRequestObject
+
+	request.ID = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAPIInfo(ctx, request.(GetAPIInfoRequestObject))
+		return sh.ssi.DeleteAPIWebhooksID(ctx, request.(DeleteAPIWebhooksIDRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAPIInfo")
+		handler = middleware(handler, "DeleteAPIWebhooksID")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAPIInfoResponseObject); ok {
-		if err := validResponse.VisitGetAPIInfoResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteAPIWebhooksIDResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIWebhooksIDResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1511,11 +23568,35 @@ func (sh *strictHandler) GetAPIInfo(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// PostAPISendCoin operation middleware
-func (sh *strictHandler) PostAPISendCoin(w http.ResponseWriter, r *http.Request) {
-	var request PostAPISendCoinRequestObject
+// GetAPIWishlist operation middleware
+func (sh *strictHandler) GetAPIWishlist(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIWishlistRequestObject
 
-	var body PostAPISendCoinJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIWishlist(ctx, request.(GetAPIWishlistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIWishlist")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIWishlistResponseObject); ok {
+		if err := validResponse.VisitGetAPIWishlistResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAPIWishlist operation middleware
+func (sh *strictHandler) PostAPIWishlist(w http.ResponseWriter, r *http.Request) {
+	var request PostAPIWishlistRequestObject
+
+	var body PostAPIWishlistJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1523,18 +23604,44 @@ func (sh *strictHandler) PostAPISendCoin(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAPISendCoin(ctx, request.(PostAPISendCoinRequestObject))
+		return sh.ssi.PostAPIWishlist(ctx, request.(PostAPIWishlistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAPISendCoin")
+		handler = middleware(handler, "PostAPIWishlist")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostAPISendCoinResponseObject); ok {
-		if err := validResponse.VisitPostAPISendCoinResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostAPIWishlistResponseObject); ok {
+		if err := validResponse.VisitPostAPIWishlistResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAPIWishlistItem operation middleware
+func (sh *strictHandler) DeleteAPIWishlistItem(w http.ResponseWriter, r *http.Request, item string) {
+	var request DeleteAPIWishlistItemRequestObject
+
+	request.Item = item
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAPIWishlistItem(ctx, request.(DeleteAPIWishlistItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAPIWishlistItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAPIWishlistItemResponseObject); ok {
+		if err := validResponse.VisitDeleteAPIWishlistItemResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
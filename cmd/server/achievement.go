@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/achievement"
+)
+
+// badgesResponse returns the badges awarded to the user for their profile.
+func (h *Handler) badgesResponse(ctx context.Context, userID uuid.UUID) (*[]merch.Badge, error) {
+	achievementGetter := achievement.NewGetter(h.db)
+	awards, err := achievementGetter.GetAwardsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	badges := make([]merch.Badge, len(awards))
+	for i, a := range awards {
+		name := string(a.Badge)
+		badges[i] = merch.Badge{
+			Name:      &name,
+			Bonus:     &a.Bonus,
+			AwardedAt: &a.AwardedAt,
+		}
+		// Badges of removed rules are still listed, just without a title.
+		if r := achievement.DefaultRule(a.Badge); r != nil {
+			badges[i].Title = &r.Title
+			badges[i].Description = &r.Description
+		}
+	}
+
+	return &badges, nil
+}
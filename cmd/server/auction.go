@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auction"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/item"
+)
+
+// GetAPIAuctions implements merch.StrictServerInterface.
+func (h *Handler) GetAPIAuctions(ctx context.Context, request merch.GetAPIAuctionsRequestObject) (merch.GetAPIAuctionsResponseObject, error) {
+	auctionGetter := auction.NewGetter(h.db)
+	auctions, err := auctionGetter.GetOpenAuctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responseAuctions := make([]merch.Auction, len(auctions))
+	for i, a := range auctions {
+		responseAuctions[i] = auctionResponse(a)
+	}
+
+	return merch.GetAPIAuctions200JSONResponse{Auctions: &responseAuctions}, nil
+}
+
+// PostAPIAuctions implements merch.StrictServerInterface.
+func (h *Handler) PostAPIAuctions(ctx context.Context, request merch.PostAPIAuctionsRequestObject) (merch.PostAPIAuctionsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	itemName := request.Body.Item
+	if itemName == "" {
+		errors := "empty item body value"
+		return merch.PostAPIAuctions400JSONResponse{Errors: &errors}, nil
+	}
+
+	startingPrice := request.Body.StartingPrice
+	if startingPrice < 0 {
+		errors := "negative startingPrice body value"
+		return merch.PostAPIAuctions400JSONResponse{Errors: &errors}, nil
+	}
+
+	minIncrement := 1
+	if request.Body.MinIncrement != nil {
+		minIncrement = *request.Body.MinIncrement
+	}
+	if minIncrement <= 0 {
+		errors := "non-positive minIncrement body value"
+		return merch.PostAPIAuctions400JSONResponse{Errors: &errors}, nil
+	}
+
+	endsAt := request.Body.EndsAt
+	if !endsAt.After(time.Now()) {
+		errors := "endsAt body value not after now"
+		return merch.PostAPIAuctions400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIAuctions403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	auctionCreator := auction.NewCreator(h.db)
+	a, err := auctionCreator.CreateAuction(ctx, &auction.CreatorCreateAuctionParams{
+		ItemName:      itemName,
+		StartingPrice: startingPrice,
+		MinIncrement:  minIncrement,
+		EndsAt:        endsAt,
+		CreatedBy:     userID,
+	})
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPIAuctions404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, auction.ErrItemHasVariants) {
+			errors := "item has variants"
+			return merch.PostAPIAuctions400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIAuctions200JSONResponse(auctionResponse(a)), nil
+}
+
+// GetAPIAuctionsID implements merch.StrictServerInterface.
+func (h *Handler) GetAPIAuctionsID(ctx context.Context, request merch.GetAPIAuctionsIDRequestObject) (merch.GetAPIAuctionsIDResponseObject, error) {
+	auctionGetter := auction.NewGetter(h.db)
+	a, err := auctionGetter.GetAuction(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, auction.ErrNotExist) {
+			errors := "auction does not exist"
+			return merch.GetAPIAuctionsID404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.GetAPIAuctionsID200JSONResponse(auctionResponse(a)), nil
+}
+
+// DeleteAPIAuctionsID implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPIAuctionsID(ctx context.Context, request merch.DeleteAPIAuctionsIDRequestObject) (merch.DeleteAPIAuctionsIDResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.DeleteAPIAuctionsID403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	auctionCloser := auction.NewCloser(h.db)
+	err = auctionCloser.Cancel(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, auction.ErrNotExist) {
+			errors := "auction does not exist"
+			return merch.DeleteAPIAuctionsID404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, auction.ErrClosed) {
+			errors := "auction closed"
+			return merch.DeleteAPIAuctionsID400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPIAuctionsID200Response{}, nil
+}
+
+// GetAPIAuctionsIDBids implements merch.StrictServerInterface.
+func (h *Handler) GetAPIAuctionsIDBids(ctx context.Context, request merch.GetAPIAuctionsIDBidsRequestObject) (merch.GetAPIAuctionsIDBidsResponseObject, error) {
+	auctionGetter := auction.NewGetter(h.db)
+	_, err := auctionGetter.GetAuction(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, auction.ErrNotExist) {
+			errors := "auction does not exist"
+			return merch.GetAPIAuctionsIDBids404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	bids, err := auctionGetter.GetBidsByAuctionID(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBids := make([]merch.AuctionBid, len(bids))
+	for i, b := range bids {
+		responseBids[i] = auctionBidResponse(b)
+	}
+
+	return merch.GetAPIAuctionsIDBids200JSONResponse{Bids: &responseBids}, nil
+}
+
+// PostAPIAuctionsIDBids implements merch.StrictServerInterface.
+func (h *Handler) PostAPIAuctionsIDBids(ctx context.Context, request merch.PostAPIAuctionsIDBidsRequestObject) (merch.PostAPIAuctionsIDBidsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	amount := request.Body.Amount
+	if amount <= 0 {
+		errors := "non-positive amount body value"
+		return merch.PostAPIAuctionsIDBids400JSONResponse{Errors: &errors}, nil
+	}
+
+	auctionBidder := auction.NewBidder(h.db)
+	b, err := auctionBidder.PlaceBid(ctx, request.ID, userID, amount)
+	if err != nil {
+		if errors.Is(err, auction.ErrNotExist) {
+			errors := "auction does not exist"
+			return merch.PostAPIAuctionsIDBids404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, auction.ErrClosed) {
+			errors := "auction closed"
+			return merch.PostAPIAuctionsIDBids400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, auction.ErrBidTooLow) {
+			errors := "bid too low"
+			return merch.PostAPIAuctionsIDBids400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, coin.ErrNotEnough) {
+			errors := "not enough coin"
+			return merch.PostAPIAuctionsIDBids400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIAuctionsIDBids200JSONResponse(auctionBidResponse(b)), nil
+}
+
+func auctionResponse(a *auction.Auction) merch.Auction {
+	status := string(a.Status)
+	minBid := a.MinBid()
+	response := merch.Auction{
+		ID:            &a.ID,
+		Item:          &a.ItemName,
+		StartingPrice: &a.StartingPrice,
+		MinIncrement:  &a.MinIncrement,
+		BidCount:      &a.BidCount,
+		Status:        &status,
+		EndsAt:        &a.EndsAt,
+		ClosedAt:      a.ClosedAt,
+		CreatedAt:     &a.CreatedAt,
+	}
+	if a.Status == auction.StatusOpen {
+		response.MinBid = &minBid
+	}
+	if a.HighestBidderID != nil {
+		response.HighestBid = &a.HighestBid
+		response.HighestBidder = &a.HighestBidderUsername
+	}
+	return response
+}
+
+func auctionBidResponse(b *auction.Bid) merch.AuctionBid {
+	status := string(b.Status)
+	return merch.AuctionBid{
+		ID:        &b.ID,
+		User:      &b.Username,
+		Amount:    &b.Amount,
+		Status:    &status,
+		CreatedAt: &b.CreatedAt,
+	}
+}
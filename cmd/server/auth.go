@@ -9,7 +9,10 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
+
 	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/app"
 	"github.com/k11v/merch/internal/auth"
 	"github.com/k11v/merch/internal/user"
 )
@@ -40,6 +43,10 @@ func (h *Handler) PostAPIAuth(ctx context.Context, request merch.PostAPIAuthRequ
 			errors := "invalid username or password"
 			return merch.PostAPIAuth401JSONResponse{Errors: &errors}, nil
 		}
+		if errors.Is(err, auth.ErrDeactivated) {
+			errors := "user is deactivated"
+			return merch.PostAPIAuth401JSONResponse{Errors: &errors}, nil
+		}
 		return nil, err
 	}
 
@@ -52,12 +59,30 @@ func (h *Handler) PostAPIAuth(ctx context.Context, request merch.PostAPIAuthRequ
 	return merch.PostAPIAuth200JSONResponse{Token: &token}, nil
 }
 
-func Authentication(jwtVerificationKey ed25519.PublicKey) func(next http.Handler) http.Handler {
+// isAdmin reports whether the user is an admin.
+// Use it when admins get access in addition to other users,
+// otherwise use auth.AdminAuthorizer directly.
+func (h *Handler) isAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Authentication authenticates requests by their bearer tokens and rejects deactivated users,
+// so they lose access when deactivated rather than when their tokens expire.
+func Authentication(db app.PgxExecutor, jwtVerificationKey ed25519.PublicKey) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			switch {
 			case r.Method == "POST" && r.URL.Path == "/api/auth":
 			case r.Method == "GET" && r.URL.Path == "/api/health":
+			case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/api/images/"):
 			default:
 				const headerAuthorization = "Authorization"
 				authorizationHeader := r.Header.Get(headerAuthorization)
@@ -105,6 +130,25 @@ func Authentication(jwtVerificationKey ed25519.PublicKey) func(next http.Handler
 				userID := authData.UserID
 
 				ctx := r.Context()
+				activeAuthorizer := auth.NewActiveAuthorizer(db)
+				err = activeAuthorizer.AuthorizeActive(ctx, userID)
+				if err != nil {
+					if !errors.Is(err, auth.ErrDeactivated) {
+						serveResponseError(w, r, err)
+						return
+					}
+					errors := "user is deactivated"
+					response := merch.ErrorResponse{Errors: &errors}
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusUnauthorized)
+					err = json.NewEncoder(w).Encode(response)
+					if err != nil {
+						serveResponseError(w, r, err)
+						return
+					}
+					return
+				}
+
 				ctx = context.WithValue(ctx, ContextValueUserID, userID)
 				r = r.WithContext(ctx)
 			}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/budget"
+	"github.com/k11v/merch/internal/user"
+)
+
+// PutAPIBudgetsUsername implements merch.StrictServerInterface.
+func (h *Handler) PutAPIBudgetsUsername(ctx context.Context, request merch.PutAPIBudgetsUsernameRequestObject) (merch.PutAPIBudgetsUsernameResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	allowance := request.Body.Allowance
+	if allowance < 0 {
+		errors := "negative allowance body value"
+		return merch.PutAPIBudgetsUsername400JSONResponse{Errors: &errors}, nil
+	}
+
+	period := budget.Period(request.Body.Period)
+	if !period.Valid() {
+		errors := "invalid period body value"
+		return merch.PutAPIBudgetsUsername400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PutAPIBudgetsUsername403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	budgetSetter := budget.NewSetter(h.db)
+	b, err := budgetSetter.SetBudgetByUsername(ctx, request.Username, allowance, period)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			errors := "user doesn't exist"
+			return merch.PutAPIBudgetsUsername404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PutAPIBudgetsUsername200JSONResponse(*givingBudgetResponseOrNil(b)), nil
+}
+
+// givingBudgetResponseOrNil returns the response representation of b as of now or nil if b is nil.
+func givingBudgetResponseOrNil(b *budget.Budget) *merch.GivingBudget {
+	if b == nil {
+		return nil
+	}
+	now := time.Now()
+	b = b.At(now)
+	period := merch.BudgetPeriod(b.Period)
+	resetsAt := b.Period.End(now)
+	return &merch.GivingBudget{
+		Allowance: &b.Allowance,
+		Period:    &period,
+		Remaining: &b.Remaining,
+		ResetsAt:  &resetsAt,
+	}
+}
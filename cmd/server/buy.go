@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
@@ -27,12 +28,27 @@ func (h *Handler) GetAPIBuyItem(ctx context.Context, request merch.GetAPIBuyItem
 	}
 
 	purchaser := purchase.NewPurchaser(h.db)
-	_, err := purchaser.PurchaseByName(ctx, itemName, userID)
+	_, err := purchaser.Purchase(ctx, &purchase.PurchaserPurchaseParams{
+		ItemName:  itemName,
+		BuyerID:   userID,
+		Variant:   variantSelectorOrNil(request.Params.Size, request.Params.Color),
+		PromoCode: valueOrZero(request.Params.PromoCode),
+	})
 	if err != nil {
 		if errors.Is(err, item.ErrNotExist) {
 			errors := "item does not exist"
 			return merch.GetAPIBuyItem400JSONResponse{Errors: &errors}, nil
 		}
+		if message, ok := variantErrorMessage(err); ok {
+			return merch.GetAPIBuyItem400JSONResponse{Errors: &message}, nil
+		}
+		if message, ok := promoCodeErrorMessage(err); ok {
+			return merch.GetAPIBuyItem400JSONResponse{Errors: &message}, nil
+		}
+		if errors.Is(err, purchase.ErrLimitReached) {
+			errors := "purchase limit reached"
+			return merch.GetAPIBuyItem400JSONResponse{Errors: &errors}, nil
+		}
 		if errors.Is(err, coin.ErrNotEnough) {
 			errors := "not enough coin"
 			return merch.GetAPIBuyItem400JSONResponse{Errors: &errors}, nil
@@ -42,3 +58,114 @@ func (h *Handler) GetAPIBuyItem(ctx context.Context, request merch.GetAPIBuyItem
 
 	return merch.GetAPIBuyItem200Response{}, nil
 }
+
+const maxGiftNoteLen = 200
+
+// PostAPIBuyItemGift implements merch.StrictServerInterface.
+func (h *Handler) PostAPIBuyItemGift(ctx context.Context, request merch.PostAPIBuyItemGiftRequestObject) (merch.PostAPIBuyItemGiftResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	itemName := request.Item
+	if itemName == "" {
+		errors := "empty item"
+		return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+	}
+
+	toUsername := request.Body.ToUser
+	if toUsername == "" {
+		errors := "empty toUser body value"
+		return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+	}
+
+	note := valueOrZero(request.Body.Note)
+	if utf8.RuneCountInString(note) > maxGiftNoteLen {
+		errors := fmt.Sprintf("note body value longer than %d characters", maxGiftNoteLen)
+		return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+	}
+
+	purchaser := purchase.NewPurchaser(h.db)
+	p, err := purchaser.Purchase(ctx, &purchase.PurchaserPurchaseParams{
+		ItemName:          itemName,
+		BuyerID:           userID,
+		Variant:           variantSelectorOrNil(request.Body.Size, request.Body.Color),
+		RecipientUsername: toUsername,
+		Note:              note,
+		PromoCode:         valueOrZero(request.Body.PromoCode),
+	})
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+		}
+		if message, ok := variantErrorMessage(err); ok {
+			return merch.PostAPIBuyItemGift400JSONResponse{Errors: &message}, nil
+		}
+		if message, ok := promoCodeErrorMessage(err); ok {
+			return merch.PostAPIBuyItemGift400JSONResponse{Errors: &message}, nil
+		}
+		if errors.Is(err, purchase.ErrRecipientNotFound) {
+			errors := "toUser doesn't exist"
+			return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, purchase.ErrBuyerAndRecipientEqual) {
+			errors := "fromUser and toUser are equal"
+			return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, purchase.ErrLimitReached) {
+			errors := "purchase limit reached"
+			return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, coin.ErrNotEnough) {
+			errors := "not enough coin"
+			return merch.PostAPIBuyItemGift400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIBuyItemGift200JSONResponse(giftResponse(p)), nil
+}
+
+func giftResponse(p *purchase.Purchase) merch.Gift {
+	return merch.Gift{
+		Item:            &p.ItemName,
+		Size:            nonEmptyStringOrNil(p.VariantSize),
+		Color:           nonEmptyStringOrNil(p.VariantColor),
+		FromUser:        nonEmptyStringOrNil(p.BuyerUsername),
+		FromDisplayName: nonEmptyStringOrNil(p.BuyerDisplayName),
+		ToUser:          nonEmptyStringOrNil(p.Username),
+		ToDisplayName:   nonEmptyStringOrNil(p.DisplayName),
+		Amount:          &p.Amount,
+		ListPrice:       &p.ListPrice,
+		Note:            nonEmptyStringOrNil(p.Note),
+		CreatedAt:       &p.CreatedAt,
+	}
+}
+
+// variantSelectorOrNil returns the selector of the variant with the size and color
+// or nil if neither is given.
+func variantSelectorOrNil(size *string, color *string) *item.VariantSelector {
+	if size == nil && color == nil {
+		return nil
+	}
+	return &item.VariantSelector{
+		Size:  valueOrZero(size),
+		Color: valueOrZero(color),
+	}
+}
+
+// variantErrorMessage returns the response message for item variant errors.
+func variantErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, item.ErrVariantNotExist):
+		return "item variant does not exist", true
+	case errors.Is(err, purchase.ErrVariantRequired):
+		return "item variant required", true
+	case errors.Is(err, purchase.ErrOutOfStock):
+		return "item variant out of stock", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/campaign"
+	"github.com/k11v/merch/internal/item"
+)
+
+// GetAPICampaigns implements merch.StrictServerInterface.
+func (h *Handler) GetAPICampaigns(ctx context.Context, request merch.GetAPICampaignsRequestObject) (merch.GetAPICampaignsResponseObject, error) {
+	campaignGetter := campaign.NewGetter(h.db)
+	campaigns, err := campaignGetter.GetCampaignsEndingAfter(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	responseCampaigns := make([]merch.Campaign, len(campaigns))
+	for i, c := range campaigns {
+		responseCampaigns[i] = campaignResponse(c)
+	}
+
+	return merch.GetAPICampaigns200JSONResponse{Campaigns: &responseCampaigns}, nil
+}
+
+// PostAPICampaigns implements merch.StrictServerInterface.
+func (h *Handler) PostAPICampaigns(ctx context.Context, request merch.PostAPICampaignsRequestObject) (merch.PostAPICampaignsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	name := request.Body.Name
+	if name == "" {
+		errors := "empty name body value"
+		return merch.PostAPICampaigns400JSONResponse{Errors: &errors}, nil
+	}
+
+	kind := campaign.Kind(request.Body.Kind)
+	if !kind.Valid() {
+		errors := "invalid kind body value"
+		return merch.PostAPICampaigns400JSONResponse{Errors: &errors}, nil
+	}
+
+	value := request.Body.Value
+	if value <= 0 || (kind == campaign.KindPercent && value > 100) {
+		errors := "invalid value body value"
+		return merch.PostAPICampaigns400JSONResponse{Errors: &errors}, nil
+	}
+
+	itemName := valueOrZero(request.Body.Item)
+	category := valueOrZero(request.Body.Category)
+	if (itemName == "") == (category == "") {
+		errors := "exactly one of item and category body values required"
+		return merch.PostAPICampaigns400JSONResponse{Errors: &errors}, nil
+	}
+
+	startsAt := time.Now()
+	if request.Body.StartsAt != nil {
+		startsAt = *request.Body.StartsAt
+	}
+	endsAt := request.Body.EndsAt
+	if !endsAt.After(startsAt) || !endsAt.After(time.Now()) {
+		errors := "endsAt body value not after startsAt and now"
+		return merch.PostAPICampaigns400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPICampaigns403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	campaignCreator := campaign.NewCreator(h.db)
+	c, err := campaignCreator.CreateCampaign(ctx, &campaign.CreatorCreateCampaignParams{
+		Name:     name,
+		Kind:     kind,
+		Value:    value,
+		ItemName: itemName,
+		Category: category,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	})
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPICampaigns404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPICampaigns200JSONResponse(campaignResponse(c)), nil
+}
+
+// DeleteAPICampaignsID implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPICampaignsID(ctx context.Context, request merch.DeleteAPICampaignsIDRequestObject) (merch.DeleteAPICampaignsIDResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.DeleteAPICampaignsID403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	campaignEnder := campaign.NewEnder(h.db)
+	err = campaignEnder.EndCampaign(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, campaign.ErrNotExist) {
+			errors := "campaign doesn't exist"
+			return merch.DeleteAPICampaignsID404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, campaign.ErrEnded) {
+			errors := "campaign already ended"
+			return merch.DeleteAPICampaignsID400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPICampaignsID200Response{}, nil
+}
+
+func campaignResponse(c *campaign.Campaign) merch.Campaign {
+	kind := merch.CampaignKind(c.Kind)
+	return merch.Campaign{
+		ID:       &c.ID,
+		Name:     &c.Name,
+		Kind:     &kind,
+		Value:    &c.Value,
+		Item:     nonEmptyStringOrNil(c.ItemName),
+		Category: c.Category,
+		StartsAt: &c.StartsAt,
+		EndsAt:   &c.EndsAt,
+	}
+}
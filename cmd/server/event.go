@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/stream"
+)
+
+const eventStreamKeepAliveInterval = 30 * time.Second
+
+// GetAPIEvents implements merch.StrictServerInterface.
+func (h *Handler) GetAPIEvents(ctx context.Context, request merch.GetAPIEventsRequestObject) (merch.GetAPIEventsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	return eventStreamResponse{ctx: ctx, listener: h.eventListener, userID: userID}, nil
+}
+
+// eventStreamResponse writes the user's events as Server-Sent Events until the request is done.
+// The generated text/event-stream response copies a body without flushing, so it can't be used for a stream.
+type eventStreamResponse struct {
+	ctx      context.Context
+	listener *stream.Listener
+	userID   uuid.UUID
+}
+
+func (response eventStreamResponse) VisitGetAPIEventsResponse(w http.ResponseWriter) error {
+	events, unsubscribe := response.listener.Subscribe(response.userID)
+	defer unsubscribe()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(200)
+	err := rc.Flush()
+	if err != nil {
+		return err
+	}
+
+	keepAlive := time.NewTicker(eventStreamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-response.ctx.Done():
+			return nil
+		case e := <-events:
+			data, err := json.Marshal(stream.Message{Kind: e.Kind, UserID: e.UserID, ID: e.ID})
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Kind, data)
+			if err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			// Comments keep proxies from closing idle streams.
+			_, err = fmt.Fprint(w, ": keep-alive\n\n")
+			if err != nil {
+				return err
+			}
+		}
+		err = rc.Flush()
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -2,12 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
 	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/achievement"
+	"github.com/k11v/merch/internal/budget"
 	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/inventory"
 	"github.com/k11v/merch/internal/purchase"
 	"github.com/k11v/merch/internal/transfer"
 )
@@ -19,8 +23,13 @@ func (h *Handler) GetAPIInfo(ctx context.Context, request merch.GetAPIInfoReques
 		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
 	}
 
+	inventoryGetter := inventory.NewGetter(h.db)
+	itemCounts, err := inventoryGetter.GetItemCountsByOwnerID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 	purchaseGetter := purchase.NewGetter(h.db)
-	itemCounts, err := purchaseGetter.GetItemCountsByUserID(ctx, userID)
+	gifts, err := purchaseGetter.GetGiftsByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -34,14 +43,35 @@ func (h *Handler) GetAPIInfo(ctx context.Context, request merch.GetAPIInfoReques
 	if err != nil {
 		return nil, err
 	}
+	budgetGetter := budget.NewGetter(h.db)
+	givingBudget, err := budgetGetter.GetBudget(ctx, userID)
+	if err != nil && !errors.Is(err, budget.ErrNotExist) {
+		return nil, err
+	}
+	achievementGetter := achievement.NewGetter(h.db)
+	awards, err := achievementGetter.GetAwardsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	type receivedHistoryItem = struct {
-		Amount   *int    `json:"amount,omitempty"`
-		FromUser *string `json:"fromUser,omitempty"`
+		Amount          *int                  `json:"amount,omitempty"`
+		Badge           *string               `json:"badge,omitempty"`
+		FromBudget      *bool                 `json:"fromBudget,omitempty"`
+		FromDisplayName *string               `json:"fromDisplayName,omitempty"`
+		FromTeam        *string               `json:"fromTeam,omitempty"`
+		FromUser        *string               `json:"fromUser,omitempty"`
+		Message         *string               `json:"message,omitempty"`
+		Status          *merch.TransferStatus `json:"status,omitempty"`
 	}
 	type sentHistoryItem = struct {
-		Amount *int    `json:"amount,omitempty"`
-		ToUser *string `json:"toUser,omitempty"`
+		Amount        *int                  `json:"amount,omitempty"`
+		FromBudget    *bool                 `json:"fromBudget,omitempty"`
+		FromTeam      *string               `json:"fromTeam,omitempty"`
+		Message       *string               `json:"message,omitempty"`
+		Status        *merch.TransferStatus `json:"status,omitempty"`
+		ToDisplayName *string               `json:"toDisplayName,omitempty"`
+		ToUser        *string               `json:"toUser,omitempty"`
 	}
 	type history = struct {
 		Received *[]receivedHistoryItem `json:"received,omitempty"`
@@ -49,39 +79,113 @@ func (h *Handler) GetAPIInfo(ctx context.Context, request merch.GetAPIInfoReques
 	}
 	received := make([]receivedHistoryItem, 0)
 	sent := make([]sentHistoryItem, 0)
+
+	// Bonus coins of badges are received too, they are listed among transfers in the order they were credited.
+	bonuses := make([]*achievement.Award, 0)
+	for _, a := range awards {
+		if a.Bonus > 0 {
+			bonuses = append(bonuses, a)
+		}
+	}
+	completed := merch.TransferStatusCompleted
+	bonusHistoryItem := func(a *achievement.Award) receivedHistoryItem {
+		badge := string(a.Badge)
+		return receivedHistoryItem{Amount: &a.Bonus, Badge: &badge, Status: &completed}
+	}
+
 	for _, t := range transfers {
+		status := merch.TransferStatus(t.Status)
 		if t.SrcUserID == userID {
 			sent = append(sent, sentHistoryItem{
-				Amount: &t.Amount,
-				ToUser: &t.DstUsername,
+				Amount:        &t.Amount,
+				FromBudget:    trueOrNil(t.FromBudget),
+				FromTeam:      nonEmptyStringOrNil(t.TeamName),
+				Message:       nonEmptyStringOrNil(t.Message),
+				Status:        &status,
+				ToDisplayName: nonEmptyStringOrNil(t.DstDisplayName),
+				ToUser:        &t.DstUsername,
 			})
 		}
 		if t.DstUserID == userID {
+			for len(bonuses) > 0 && bonuses[0].AwardedAt.Before(t.CreatedAt) {
+				received = append(received, bonusHistoryItem(bonuses[0]))
+				bonuses = bonuses[1:]
+			}
 			received = append(received, receivedHistoryItem{
-				Amount:   &t.Amount,
-				FromUser: &t.SrcUsername,
+				Amount:          &t.Amount,
+				FromBudget:      trueOrNil(t.FromBudget),
+				FromDisplayName: nonEmptyStringOrNil(t.SrcDisplayName),
+				FromTeam:        nonEmptyStringOrNil(t.TeamName),
+				FromUser:        &t.SrcUsername,
+				Message:         nonEmptyStringOrNil(t.Message),
+				Status:          &status,
 			})
 		}
 	}
+	for _, a := range bonuses {
+		received = append(received, bonusHistoryItem(a))
+	}
 
 	type inventoryItem = struct {
+		Color    *string `json:"color,omitempty"`
 		Quantity *int    `json:"quantity,omitempty"`
+		Size     *string `json:"size,omitempty"`
 		Type     *string `json:"type,omitempty"`
 	}
 	inventory := make([]inventoryItem, len(itemCounts))
 	for i, ic := range itemCounts {
 		inventory[i] = inventoryItem{
+			Color:    nonEmptyStringOrNil(ic.VariantColor),
 			Quantity: &ic.Count,
+			Size:     nonEmptyStringOrNil(ic.VariantSize),
 			Type:     &ic.ItemName,
 		}
 	}
 
+	type giftHistory = struct {
+		Received *[]merch.Gift `json:"received,omitempty"`
+		Sent     *[]merch.Gift `json:"sent,omitempty"`
+	}
+	receivedGifts := make([]merch.Gift, 0)
+	sentGifts := make([]merch.Gift, 0)
+	for _, g := range gifts {
+		if g.BuyerID == userID {
+			sentGifts = append(sentGifts, giftResponse(g))
+		}
+		if g.UserID == userID {
+			receivedGifts = append(receivedGifts, giftResponse(g))
+		}
+	}
+
 	return merch.GetAPIInfo200JSONResponse{
 		CoinHistory: &history{
 			Received: &received,
 			Sent:     &sent,
 		},
-		Coins:     &balance,
-		Inventory: &inventory,
+		Coins: &balance,
+		GiftHistory: &giftHistory{
+			Received: &receivedGifts,
+			Sent:     &sentGifts,
+		},
+		GivingBudget: givingBudgetResponseOrNil(givingBudget),
+		Inventory:    &inventory,
 	}, nil
 }
+
+// nonEmptyStringOrNil returns a pointer to s or nil if s is empty.
+// It is used for optional response values that are stored as empty strings.
+func nonEmptyStringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// trueOrNil returns a pointer to true or nil if b is false.
+// It is used for optional flags that are omitted from responses unless set.
+func trueOrNil(b bool) *bool {
+	if !b {
+		return nil
+	}
+	return &b
+}
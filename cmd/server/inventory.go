@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/inventory"
+	"github.com/k11v/merch/internal/item"
+)
+
+const maxItemTransferQuantity = 100
+
+// PostAPIInventoryTransfer implements merch.StrictServerInterface.
+func (h *Handler) PostAPIInventoryTransfer(ctx context.Context, request merch.PostAPIInventoryTransferRequestObject) (merch.PostAPIInventoryTransferResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	itemName := request.Body.Item
+	if itemName == "" {
+		errors := "empty item body value"
+		return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+	}
+
+	toUsername := request.Body.ToUser
+	if toUsername == "" {
+		errors := "empty toUser body value"
+		return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+	}
+
+	quantity := 1
+	if request.Body.Quantity != nil {
+		quantity = *request.Body.Quantity
+	}
+	if quantity <= 0 {
+		errors := "non-positive quantity body value"
+		return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+	}
+	if quantity > maxItemTransferQuantity {
+		errors := fmt.Sprintf("quantity body value greater than %d", maxItemTransferQuantity)
+		return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+	}
+
+	transferer := inventory.NewTransferer(h.db)
+	variant := variantSelectorOrNil(request.Body.Size, request.Body.Color)
+	transfers, err := transferer.TransferByName(ctx, itemName, variant, userID, toUsername, quantity)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, item.ErrVariantNotExist) {
+			errors := "item variant does not exist"
+			return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, inventory.ErrDstUserNotFound) {
+			errors := "toUser doesn't exist"
+			return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, inventory.ErrDstUserDeactivated) {
+			errors := "toUser is deactivated"
+			return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, inventory.ErrSrcUserAndDstUserEqual) {
+			errors := "fromUser and toUser are equal"
+			return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, inventory.ErrNotEnough) {
+			errors := "not enough items"
+			return merch.PostAPIInventoryTransfer400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	responseTransfers := make([]merch.ItemTransfer, len(transfers))
+	for i, t := range transfers {
+		responseTransfers[i] = itemTransferResponse(t)
+	}
+
+	return merch.PostAPIInventoryTransfer200JSONResponse{Transfers: &responseTransfers}, nil
+}
+
+// GetAPIInventoryTransfers implements merch.StrictServerInterface.
+func (h *Handler) GetAPIInventoryTransfers(ctx context.Context, request merch.GetAPIInventoryTransfersRequestObject) (merch.GetAPIInventoryTransfersResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	inventoryGetter := inventory.NewGetter(h.db)
+	transfers, err := inventoryGetter.GetTransfersByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	received := make([]merch.ItemTransfer, 0)
+	sent := make([]merch.ItemTransfer, 0)
+	for _, t := range transfers {
+		if t.SrcUserID == userID {
+			sent = append(sent, itemTransferResponse(t))
+		}
+		if t.DstUserID == userID {
+			received = append(received, itemTransferResponse(t))
+		}
+	}
+
+	return merch.GetAPIInventoryTransfers200JSONResponse{
+		Received: &received,
+		Sent:     &sent,
+	}, nil
+}
+
+func itemTransferResponse(t *inventory.Transfer) merch.ItemTransfer {
+	return merch.ItemTransfer{
+		Item:      &t.ItemName,
+		Size:      nonEmptyStringOrNil(t.VariantSize),
+		Color:     nonEmptyStringOrNil(t.VariantColor),
+		FromUser:  &t.SrcUsername,
+		ToUser:    &t.DstUsername,
+		CreatedAt: &t.CreatedAt,
+	}
+}
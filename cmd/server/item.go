@@ -0,0 +1,444 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/campaign"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/purchase"
+	"github.com/k11v/merch/internal/storage"
+)
+
+const (
+	maxItemCategoryLen    = 50
+	maxItemDescriptionLen = 2000
+)
+
+// GetAPIItems implements merch.StrictServerInterface.
+func (h *Handler) GetAPIItems(ctx context.Context, request merch.GetAPIItemsRequestObject) (merch.GetAPIItemsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	itemGetter := item.NewGetter(h.db)
+	items, err := itemGetter.GetItems(ctx, valueOrZero(request.Params.Category))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	campaignGetter := campaign.NewGetter(h.db)
+	campaigns, err := campaignGetter.GetCampaignsEndingAfter(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseGetter := purchase.NewGetter(h.db)
+	allowances, err := purchaseGetter.GetAllowancesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	allowancesMap := make(map[uuid.UUID]*purchase.Allowance)
+	for _, a := range allowances {
+		allowancesMap[a.ItemID] = a
+	}
+
+	responseItems := make([]merch.CatalogItem, len(items))
+	for j, i := range items {
+		var itemCampaigns []*campaign.Campaign
+		for _, c := range campaigns {
+			if c.ActiveAt(now) && c.AppliesTo(i.ID, i.Category) {
+				itemCampaigns = append(itemCampaigns, c)
+			}
+		}
+		responseItems[j] = catalogItemResponse(i)
+		if salePrice, c := campaign.BestPrice(itemCampaigns, i.Price); c != nil {
+			responseItems[j].SalePrice = &salePrice
+		}
+		if a, ok := allowancesMap[i.ID]; ok {
+			limit := purchaseLimitResponse(&a.Limit, i.Name)
+			remaining := a.Remaining()
+			responseItems[j].PurchaseLimit = &limit
+			responseItems[j].RemainingPurchases = &remaining
+		}
+	}
+
+	return merch.GetAPIItems200JSONResponse{Items: &responseItems}, nil
+}
+
+// PutAPIItemsItem implements merch.StrictServerInterface.
+func (h *Handler) PutAPIItemsItem(ctx context.Context, request merch.PutAPIItemsItemRequestObject) (merch.PutAPIItemsItemResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	category := valueOrZero(request.Body.Category)
+	if utf8.RuneCountInString(category) > maxItemCategoryLen {
+		errors := fmt.Sprintf("category body value longer than %d characters", maxItemCategoryLen)
+		return merch.PutAPIItemsItem400JSONResponse{Errors: &errors}, nil
+	}
+
+	description := valueOrZero(request.Body.Description)
+	if utf8.RuneCountInString(description) > maxItemDescriptionLen {
+		errors := fmt.Sprintf("description body value longer than %d characters", maxItemDescriptionLen)
+		return merch.PutAPIItemsItem400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PutAPIItemsItem403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	itemUpdater := item.NewUpdater(h.db, h.imageStorage)
+	i, err := itemUpdater.UpdateDetailsByName(ctx, request.Item, category, description)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PutAPIItemsItem404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PutAPIItemsItem200JSONResponse(catalogItemResponse(i)), nil
+}
+
+// PutAPIItemsItemImage implements merch.StrictServerInterface.
+func (h *Handler) PutAPIItemsItemImage(ctx context.Context, request merch.PutAPIItemsItemImageRequestObject) (merch.PutAPIItemsItemImageResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PutAPIItemsItemImage403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	itemUpdater := item.NewUpdater(h.db, h.imageStorage)
+	i, err := itemUpdater.UpdateImageByName(ctx, request.Item, request.Body)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PutAPIItemsItemImage404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, item.ErrImageTooLarge) {
+			errors := fmt.Sprintf("image larger than %d bytes", item.MaxImageSize)
+			return merch.PutAPIItemsItemImage400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, item.ErrImageUnsupported) {
+			errors := "image format unsupported"
+			return merch.PutAPIItemsItemImage400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PutAPIItemsItemImage200JSONResponse(catalogItemResponse(i)), nil
+}
+
+// GetAPIImagesKey implements merch.StrictServerInterface.
+// Image keys are derived from the image content, so images are cached as immutable.
+func (h *Handler) GetAPIImagesKey(ctx context.Context, request merch.GetAPIImagesKeyRequestObject) (merch.GetAPIImagesKeyResponseObject, error) {
+	etag := `"` + strings.TrimSuffix(request.Key, path.Ext(request.Key)) + `"`
+	if request.Params.IfNoneMatch != nil && *request.Params.IfNoneMatch == etag {
+		return merch.GetAPIImagesKey304Response{}, nil
+	}
+
+	image, err := h.imageStorage.Open(ctx, request.Key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotExist) || errors.Is(err, storage.ErrInvalidKey) {
+			errors := "image does not exist"
+			return merch.GetAPIImagesKey404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	size, err := image.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = image.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		_ = image.Close()
+		return nil, err
+	}
+
+	return merch.GetAPIImagesKey200ImageResponse{
+		Body: image,
+		Headers: merch.GetAPIImagesKey200ResponseHeaders{
+			CacheControl: "public, max-age=31536000, immutable",
+			ETag:         etag,
+		},
+		ContentType:   item.ImageContentType(request.Key),
+		ContentLength: size,
+	}, nil
+}
+
+// GetAPIItemsItemVariants implements merch.StrictServerInterface.
+func (h *Handler) GetAPIItemsItemVariants(ctx context.Context, request merch.GetAPIItemsItemVariantsRequestObject) (merch.GetAPIItemsItemVariantsResponseObject, error) {
+	itemGetter := item.NewGetter(h.db)
+	i, err := itemGetter.GetItemByName(ctx, request.Item)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.GetAPIItemsItemVariants400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	variants, err := itemGetter.GetVariantsByItemID(ctx, i.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseVariants := make([]merch.ItemVariant, len(variants))
+	for j, v := range variants {
+		responseVariants[j] = itemVariantResponse(v, i)
+	}
+
+	return merch.GetAPIItemsItemVariants200JSONResponse{Variants: &responseVariants}, nil
+}
+
+// PostAPIItemsItemVariants implements merch.StrictServerInterface.
+func (h *Handler) PostAPIItemsItemVariants(ctx context.Context, request merch.PostAPIItemsItemVariantsRequestObject) (merch.PostAPIItemsItemVariantsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	selector := variantSelectorOrNil(request.Body.Size, request.Body.Color)
+	if selector == nil || (selector.Size == "" && selector.Color == "") {
+		errors := "empty size and color body values"
+		return merch.PostAPIItemsItemVariants400JSONResponse{Errors: &errors}, nil
+	}
+
+	stock := request.Body.Stock
+	if stock < 0 {
+		errors := "negative stock body value"
+		return merch.PostAPIItemsItemVariants400JSONResponse{Errors: &errors}, nil
+	}
+
+	price := request.Body.Price
+	if price != nil && *price < 0 {
+		errors := "negative price body value"
+		return merch.PostAPIItemsItemVariants400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIItemsItemVariants403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	i, err := item.NewGetter(h.db).GetItemByName(ctx, request.Item)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPIItemsItemVariants400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	variantSetter := item.NewVariantSetter(h.db)
+	v, err := variantSetter.CreateVariant(ctx, &item.VariantSetterCreateVariantParams{
+		ItemID:   i.ID,
+		Selector: *selector,
+		Stock:    stock,
+		Price:    price,
+	})
+	if err != nil {
+		if errors.Is(err, item.ErrVariantExist) {
+			errors := "item variant already exists"
+			return merch.PostAPIItemsItemVariants400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, item.ErrOnAuctionOrRaffle) {
+			errors := "item is on an open auction or raffle"
+			return merch.PostAPIItemsItemVariants400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIItemsItemVariants200JSONResponse(itemVariantResponse(v, i)), nil
+}
+
+// PutAPIItemsItemVariantsID implements merch.StrictServerInterface.
+func (h *Handler) PutAPIItemsItemVariantsID(ctx context.Context, request merch.PutAPIItemsItemVariantsIDRequestObject) (merch.PutAPIItemsItemVariantsIDResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	stock := request.Body.Stock
+	if stock < 0 {
+		errors := "negative stock body value"
+		return merch.PutAPIItemsItemVariantsID400JSONResponse{Errors: &errors}, nil
+	}
+
+	price := request.Body.Price
+	if price != nil && *price < 0 {
+		errors := "negative price body value"
+		return merch.PutAPIItemsItemVariantsID400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PutAPIItemsItemVariantsID403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	i, err := item.NewGetter(h.db).GetItemByName(ctx, request.Item)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PutAPIItemsItemVariantsID404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	variantSetter := item.NewVariantSetter(h.db)
+	v, err := variantSetter.UpdateVariant(ctx, i.ID, request.ID, stock, price)
+	if err != nil {
+		if errors.Is(err, item.ErrVariantNotExist) {
+			errors := "item variant does not exist"
+			return merch.PutAPIItemsItemVariantsID404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PutAPIItemsItemVariantsID200JSONResponse(itemVariantResponse(v, i)), nil
+}
+
+func itemVariantResponse(v *item.Variant, i *item.Item) merch.ItemVariant {
+	price := v.PriceOr(i.Price)
+	return merch.ItemVariant{
+		ID:    &v.ID,
+		Size:  nonEmptyStringOrNil(v.Size),
+		Color: nonEmptyStringOrNil(v.Color),
+		Stock: &v.Stock,
+		Price: &price,
+	}
+}
+
+// PutAPIItemsItemPurchaseLimit implements merch.StrictServerInterface.
+func (h *Handler) PutAPIItemsItemPurchaseLimit(ctx context.Context, request merch.PutAPIItemsItemPurchaseLimitRequestObject) (merch.PutAPIItemsItemPurchaseLimitResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	maxCount := request.Body.MaxCount
+	if maxCount <= 0 {
+		errors := "non-positive maxCount body value"
+		return merch.PutAPIItemsItemPurchaseLimit400JSONResponse{Errors: &errors}, nil
+	}
+
+	periodDays := request.Body.PeriodDays
+	if periodDays != nil && *periodDays <= 0 {
+		errors := "non-positive periodDays body value"
+		return merch.PutAPIItemsItemPurchaseLimit400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PutAPIItemsItemPurchaseLimit403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	limitSetter := purchase.NewLimitSetter(h.db)
+	l, err := limitSetter.SetLimitByItemName(ctx, request.Item, maxCount, periodDays)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PutAPIItemsItemPurchaseLimit404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PutAPIItemsItemPurchaseLimit200JSONResponse(purchaseLimitResponse(l, l.ItemName)), nil
+}
+
+// DeleteAPIItemsItemPurchaseLimit implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPIItemsItemPurchaseLimit(ctx context.Context, request merch.DeleteAPIItemsItemPurchaseLimitRequestObject) (merch.DeleteAPIItemsItemPurchaseLimitResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.DeleteAPIItemsItemPurchaseLimit403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	limitSetter := purchase.NewLimitSetter(h.db)
+	err = limitSetter.DeleteLimitByItemName(ctx, request.Item)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.DeleteAPIItemsItemPurchaseLimit404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPIItemsItemPurchaseLimit200Response{}, nil
+}
+
+func purchaseLimitResponse(l *purchase.Limit, itemName string) merch.PurchaseLimit {
+	return merch.PurchaseLimit{
+		Item:       &itemName,
+		MaxCount:   &l.MaxCount,
+		PeriodDays: l.PeriodDays,
+	}
+}
+
+func catalogItemResponse(i *item.Item) merch.CatalogItem {
+	var imageURL *string
+	if i.ImageKey != "" {
+		u := "/api/images/" + i.ImageKey
+		imageURL = &u
+	}
+	return merch.CatalogItem{
+		Name:        &i.Name,
+		Price:       &i.Price,
+		Category:    nonEmptyStringOrNil(i.Category),
+		Description: nonEmptyStringOrNil(i.Description),
+		ImageURL:    imageURL,
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/kudos"
+)
+
+const (
+	defaultKudosFeedLimit = 20
+	maxKudosFeedLimit     = 100
+)
+
+// GetAPIKudos implements merch.StrictServerInterface.
+func (h *Handler) GetAPIKudos(ctx context.Context, request merch.GetAPIKudosRequestObject) (merch.GetAPIKudosResponseObject, error) {
+	limit := defaultKudosFeedLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if limit <= 0 || limit > maxKudosFeedLimit {
+		errors := fmt.Sprintf("limit query value not between 1 and %d", maxKudosFeedLimit)
+		return merch.GetAPIKudos400JSONResponse{Errors: &errors}, nil
+	}
+
+	kudosGetter := kudos.NewGetter(h.db)
+	entries, err := kudosGetter.GetFeed(ctx, &kudos.GetterGetFeedParams{
+		Before: request.Params.Before,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responseEntries := make([]merch.KudosEntry, len(entries))
+	for i, e := range entries {
+		responseEntries[i] = kudosEntryResponse(e)
+	}
+	var nextBefore *uuid.UUID
+	if len(entries) == limit {
+		nextBefore = &entries[len(entries)-1].ID
+	}
+
+	return merch.GetAPIKudos200JSONResponse{Entries: &responseEntries, NextBefore: nextBefore}, nil
+}
+
+// GetAPIKudosSettings implements merch.StrictServerInterface.
+func (h *Handler) GetAPIKudosSettings(ctx context.Context, request merch.GetAPIKudosSettingsRequestObject) (merch.GetAPIKudosSettingsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	kudosGetter := kudos.NewGetter(h.db)
+	s, err := kudosGetter.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.GetAPIKudosSettings200JSONResponse(kudosSettingsResponse(s)), nil
+}
+
+// PutAPIKudosSettings implements merch.StrictServerInterface.
+func (h *Handler) PutAPIKudosSettings(ctx context.Context, request merch.PutAPIKudosSettingsRequestObject) (merch.PutAPIKudosSettingsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	kudosUpdater := kudos.NewUpdater(h.db)
+	s, err := kudosUpdater.UpdateSettings(ctx, userID, &kudos.UpdaterUpdateSettingsParams{
+		Public:      request.Body.Public,
+		ShowAmounts: request.Body.ShowAmounts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PutAPIKudosSettings200JSONResponse(kudosSettingsResponse(s)), nil
+}
+
+func kudosEntryResponse(e *kudos.Entry) merch.KudosEntry {
+	return merch.KudosEntry{
+		ID:              &e.ID,
+		FromUser:        &e.SrcUsername,
+		FromDisplayName: nonEmptyStringOrNil(e.SrcDisplayName),
+		ToUser:          &e.DstUsername,
+		ToDisplayName:   nonEmptyStringOrNil(e.DstDisplayName),
+		Message:         &e.Message,
+		Amount:          e.Amount,
+		CreatedAt:       &e.CreatedAt,
+	}
+}
+
+func kudosSettingsResponse(s *kudos.Settings) merch.KudosSettings {
+	return merch.KudosSettings{
+		Public:      s.Public,
+		ShowAmounts: s.ShowAmounts,
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/leaderboard"
+)
+
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+// GetAPILeaderboardsKind implements merch.StrictServerInterface.
+func (h *Handler) GetAPILeaderboardsKind(ctx context.Context, request merch.GetAPILeaderboardsKindRequestObject) (merch.GetAPILeaderboardsKindResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	kind := leaderboard.Kind(request.Kind)
+	if !kind.Valid() {
+		errors := "invalid kind path value"
+		return merch.GetAPILeaderboardsKind400JSONResponse{Errors: &errors}, nil
+	}
+
+	from, to := request.Params.From, request.Params.To
+	if request.Params.Period != nil {
+		if from != nil || to != nil {
+			errors := "period query value can't be used with from and to query values"
+			return merch.GetAPILeaderboardsKind400JSONResponse{Errors: &errors}, nil
+		}
+		period := leaderboard.Period(*request.Params.Period)
+		if !period.Valid() {
+			errors := "invalid period query value"
+			return merch.GetAPILeaderboardsKind400JSONResponse{Errors: &errors}, nil
+		}
+		from = period.Start(time.Now())
+	} else if from == nil && to == nil {
+		from = leaderboard.PeriodMonth.Start(time.Now())
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		errors := "from query value not before to query value"
+		return merch.GetAPILeaderboardsKind400JSONResponse{Errors: &errors}, nil
+	}
+
+	limit := defaultLeaderboardLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if limit <= 0 || limit > maxLeaderboardLimit {
+		errors := fmt.Sprintf("limit query value not between 1 and %d", maxLeaderboardLimit)
+		return merch.GetAPILeaderboardsKind400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.GetAPILeaderboardsKind403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	leaderboardGetter := leaderboard.NewGetter(h.db)
+	entries, err := leaderboardGetter.GetLeaderboard(ctx, &leaderboard.GetterGetLeaderboardParams{
+		Kind:  kind,
+		From:  from,
+		To:    to,
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	responseEntries := make([]merch.LeaderboardEntry, len(entries))
+	for i, e := range entries {
+		responseEntries[i] = merch.LeaderboardEntry{
+			Rank:        &e.Rank,
+			Username:    &e.Username,
+			DisplayName: nonEmptyStringOrNil(e.DisplayName),
+			Value:       &e.Value,
+		}
+	}
+	responseKind := merch.LeaderboardKind(kind)
+
+	return merch.GetAPILeaderboardsKind200JSONResponse{
+		Kind:    &responseKind,
+		From:    from,
+		To:      to,
+		Entries: &responseEntries,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+// GetAPITransferLimits implements merch.StrictServerInterface.
+func (h *Handler) GetAPITransferLimits(ctx context.Context, request merch.GetAPITransferLimitsRequestObject) (merch.GetAPITransferLimitsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.GetAPITransferLimits403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	transferGetter := transfer.NewGetter(h.db)
+	limits, err := transferGetter.GetLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.GetAPITransferLimits200JSONResponse(transferLimitsResponse(limits)), nil
+}
+
+// PutAPITransferLimits implements merch.StrictServerInterface.
+func (h *Handler) PutAPITransferLimits(ctx context.Context, request merch.PutAPITransferLimitsRequestObject) (merch.PutAPITransferLimitsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	if request.Body.MaxPerTransfer < 0 || request.Body.MaxPerDay < 0 || request.Body.MaxPerRecipient < 0 {
+		errors := "negative limit body value"
+		return merch.PutAPITransferLimits400JSONResponse{Errors: &errors}, nil
+	}
+	if request.Body.RecipientPeriodDays < 1 {
+		errors := "non-positive recipientPeriodDays body value"
+		return merch.PutAPITransferLimits400JSONResponse{Errors: &errors}, nil
+	}
+	if request.Body.ApprovalThreshold < 0 {
+		errors := "negative approvalThreshold body value"
+		return merch.PutAPITransferLimits400JSONResponse{Errors: &errors}, nil
+	}
+	if request.Body.ApprovalTimeoutHours < 1 {
+		errors := "non-positive approvalTimeoutHours body value"
+		return merch.PutAPITransferLimits400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PutAPITransferLimits403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	limitsUpdater := transfer.NewLimitsUpdater(h.db)
+	limits, err := limitsUpdater.UpdateLimits(ctx, &transfer.Limits{
+		MaxPerTransfer:       request.Body.MaxPerTransfer,
+		MaxPerDay:            request.Body.MaxPerDay,
+		MaxPerRecipient:      request.Body.MaxPerRecipient,
+		RecipientPeriodDays:  request.Body.RecipientPeriodDays,
+		ApprovalThreshold:    request.Body.ApprovalThreshold,
+		ApprovalTimeoutHours: request.Body.ApprovalTimeoutHours,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PutAPITransferLimits200JSONResponse(transferLimitsResponse(limits)), nil
+}
+
+func transferLimitsResponse(l *transfer.Limits) merch.TransferLimits {
+	return merch.TransferLimits{
+		MaxPerTransfer:       l.MaxPerTransfer,
+		MaxPerDay:            l.MaxPerDay,
+		MaxPerRecipient:      l.MaxPerRecipient,
+		RecipientPeriodDays:  l.RecipientPeriodDays,
+		ApprovalThreshold:    l.ApprovalThreshold,
+		ApprovalTimeoutHours: l.ApprovalTimeoutHours,
+	}
+}
@@ -16,7 +16,10 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/achievement"
 	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/storage"
+	"github.com/k11v/merch/internal/stream"
 )
 
 func main() {
@@ -66,7 +69,58 @@ func main() {
 		os.Exit(1)
 	}
 
-	err := run(host, port, postgresURL, jwtVerificationKeyFile, jwtSignatureKeyFile)
+	const envPaymentRequestTTL = "APP_PAYMENT_REQUEST_TTL"
+	paymentRequestTTL := 7 * 24 * time.Hour
+	paymentRequestTTLEnv := os.Getenv(envPaymentRequestTTL)
+	if paymentRequestTTLEnv != "" {
+		var err error
+		paymentRequestTTL, err = time.ParseDuration(paymentRequestTTLEnv)
+		if err != nil {
+			err = fmt.Errorf("%s env: %w", envPaymentRequestTTL, err)
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		if paymentRequestTTL <= 0 {
+			err := fmt.Errorf("%s env is not positive", envPaymentRequestTTL)
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	const envReservationTTL = "APP_RESERVATION_TTL"
+	reservationTTL := 15 * time.Minute
+	reservationTTLEnv := os.Getenv(envReservationTTL)
+	if reservationTTLEnv != "" {
+		var err error
+		reservationTTL, err = time.ParseDuration(reservationTTLEnv)
+		if err != nil {
+			err = fmt.Errorf("%s env: %w", envReservationTTL, err)
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		if reservationTTL <= 0 {
+			err := fmt.Errorf("%s env is not positive", envReservationTTL)
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	const envImageDir = "APP_IMAGE_DIR"
+	imageDir := os.Getenv(envImageDir)
+	if imageDir == "" {
+		imageDir = ".app/images"
+	}
+
+	err := run(
+		host,
+		port,
+		postgresURL,
+		jwtVerificationKeyFile,
+		jwtSignatureKeyFile,
+		paymentRequestTTL,
+		reservationTTL,
+		imageDir,
+	)
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
 		os.Exit(1)
@@ -75,7 +129,16 @@ func main() {
 	os.Exit(0)
 }
 
-func run(host string, port int, postgresURL, jwtVerificationKeyFile, jwtSignatureKeyFile string) error {
+func run(
+	host string,
+	port int,
+	postgresURL string,
+	jwtVerificationKeyFile string,
+	jwtSignatureKeyFile string,
+	paymentRequestTTL time.Duration,
+	reservationTTL time.Duration,
+	imageDir string,
+) error {
 	ctx := context.Background()
 
 	postgresPool, err := app.NewPostgresPool(ctx, postgresURL)
@@ -94,7 +157,33 @@ func run(host string, port int, postgresURL, jwtVerificationKeyFile, jwtSignatur
 		return err
 	}
 
-	httpServer := newHTTPServer(postgresPool, host, port, jwtVerificationKey, jwtSignatureKey)
+	// Committed changes are passed to these handlers from the outbox by a worker,
+	// so they see changes made by workers too and don't depend on the request that made them.
+	outboxEvents := app.EventHandlers{
+		achievement.NewEvaluator(postgresPool, achievement.DefaultRules()),
+		stream.NewNotifier(),
+	}
+
+	workerCtx, cancelWorkers := context.WithCancel(ctx)
+	defer cancelWorkers()
+	startWorkers(workerCtx, postgresPool, outboxEvents)
+
+	eventListener := stream.NewListener(postgresPool)
+	go eventListener.Listen(workerCtx)
+
+	imageStorage := storage.NewFileStorage(imageDir)
+
+	httpServer := newHTTPServer(
+		postgresPool,
+		host,
+		port,
+		jwtVerificationKey,
+		jwtSignatureKey,
+		paymentRequestTTL,
+		reservationTTL,
+		imageStorage,
+		eventListener,
+	)
 
 	slog.Info("starting HTTP server", "addr", httpServer.Addr)
 	err = httpServer.ListenAndServe()
@@ -105,8 +194,18 @@ func run(host string, port int, postgresURL, jwtVerificationKeyFile, jwtSignatur
 	return nil
 }
 
-func newHTTPServer(db *pgxpool.Pool, host string, port int, jwtVerificationKey ed25519.PublicKey, jwtSignatureKey ed25519.PrivateKey) *http.Server {
-	handler := NewHandler(db, jwtSignatureKey)
+func newHTTPServer(
+	db *pgxpool.Pool,
+	host string,
+	port int,
+	jwtVerificationKey ed25519.PublicKey,
+	jwtSignatureKey ed25519.PrivateKey,
+	paymentRequestTTL time.Duration,
+	reservationTTL time.Duration,
+	imageStorage storage.Storage,
+	eventListener *stream.Listener,
+) *http.Server {
+	handler := NewHandler(db, jwtSignatureKey, paymentRequestTTL, reservationTTL, imageStorage, eventListener)
 
 	mux := http.NewServeMux()
 	ssi := merch.StrictServerInterface(handler)
@@ -120,7 +219,7 @@ func newHTTPServer(db *pgxpool.Pool, host string, port int, jwtVerificationKey e
 	})
 
 	middlewares := []func(next http.Handler) http.Handler{
-		Authentication(jwtVerificationKey),
+		Authentication(db, jwtVerificationKey),
 	}
 	for _, m := range middlewares {
 		h = m(h)
@@ -165,10 +264,28 @@ func serveResponseError(w http.ResponseWriter, r *http.Request, err error) {
 var _ merch.StrictServerInterface = (*Handler)(nil)
 
 type Handler struct {
-	db              *pgxpool.Pool
-	jwtSignatureKey ed25519.PrivateKey
+	db                *pgxpool.Pool
+	jwtSignatureKey   ed25519.PrivateKey
+	paymentRequestTTL time.Duration
+	reservationTTL    time.Duration
+	imageStorage      storage.Storage
+	eventListener     *stream.Listener
 }
 
-func NewHandler(db *pgxpool.Pool, jwtSignatureKey ed25519.PrivateKey) *Handler {
-	return &Handler{db: db, jwtSignatureKey: jwtSignatureKey}
+func NewHandler(
+	db *pgxpool.Pool,
+	jwtSignatureKey ed25519.PrivateKey,
+	paymentRequestTTL time.Duration,
+	reservationTTL time.Duration,
+	imageStorage storage.Storage,
+	eventListener *stream.Listener,
+) *Handler {
+	return &Handler{
+		db:                db,
+		jwtSignatureKey:   jwtSignatureKey,
+		paymentRequestTTL: paymentRequestTTL,
+		reservationTTL:    reservationTTL,
+		imageStorage:      imageStorage,
+		eventListener:     eventListener,
+	}
 }
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/notification"
+)
+
+// GetAPINotifications implements merch.StrictServerInterface.
+func (h *Handler) GetAPINotifications(ctx context.Context, request merch.GetAPINotificationsRequestObject) (merch.GetAPINotificationsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	notificationGetter := notification.NewGetter(h.db)
+	notifications, err := notificationGetter.GetNotificationsByUserID(ctx, userID, valueOrZero(request.Params.Unread))
+	if err != nil {
+		return nil, err
+	}
+
+	responseNotifications := make([]merch.Notification, len(notifications))
+	for i, n := range notifications {
+		responseNotifications[i] = notificationResponse(n)
+	}
+
+	return merch.GetAPINotifications200JSONResponse{Notifications: &responseNotifications}, nil
+}
+
+// PostAPINotificationsRead implements merch.StrictServerInterface.
+func (h *Handler) PostAPINotificationsRead(ctx context.Context, request merch.PostAPINotificationsReadRequestObject) (merch.PostAPINotificationsReadResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	notificationReader := notification.NewReader(h.db)
+	_, err := notificationReader.MarkAllRead(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PostAPINotificationsRead200Response{}, nil
+}
+
+// PostAPINotificationsIDRead implements merch.StrictServerInterface.
+func (h *Handler) PostAPINotificationsIDRead(ctx context.Context, request merch.PostAPINotificationsIDReadRequestObject) (merch.PostAPINotificationsIDReadResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	notificationReader := notification.NewReader(h.db)
+	err := notificationReader.MarkRead(ctx, request.ID, userID)
+	if err != nil {
+		if errors.Is(err, notification.ErrNotExist) {
+			errors := "notification doesn't exist"
+			return merch.PostAPINotificationsIDRead404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPINotificationsIDRead200Response{}, nil
+}
+
+func notificationResponse(n *notification.Notification) merch.Notification {
+	kind := string(n.Kind)
+	read := n.ReadAt != nil
+	return merch.Notification{
+		ID:        &n.ID,
+		Kind:      &kind,
+		Message:   &n.Message,
+		Item:      nonEmptyStringOrNil(n.ItemName),
+		Read:      &read,
+		CreatedAt: &n.CreatedAt,
+	}
+}
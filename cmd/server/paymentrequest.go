@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/paymentrequest"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+const maxPaymentRequestNoteLen = 200
+
+// GetAPIPaymentRequests implements merch.StrictServerInterface.
+func (h *Handler) GetAPIPaymentRequests(ctx context.Context, request merch.GetAPIPaymentRequestsRequestObject) (merch.GetAPIPaymentRequestsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	paymentRequestGetter := paymentrequest.NewGetter(h.db)
+	incoming, err := paymentRequestGetter.GetPendingPaymentRequestsByPayerID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	outgoing, err := paymentRequestGetter.GetPaymentRequestsByRequesterID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseIncoming := make([]merch.PaymentRequest, len(incoming))
+	for i, pr := range incoming {
+		responseIncoming[i] = paymentRequestResponse(pr, nil)
+	}
+	responseOutgoing := make([]merch.PaymentRequest, len(outgoing))
+	for i, pr := range outgoing {
+		responseOutgoing[i] = paymentRequestResponse(pr, nil)
+	}
+
+	return merch.GetAPIPaymentRequests200JSONResponse{
+		Incoming: &responseIncoming,
+		Outgoing: &responseOutgoing,
+	}, nil
+}
+
+// PostAPIPaymentRequests implements merch.StrictServerInterface.
+func (h *Handler) PostAPIPaymentRequests(ctx context.Context, request merch.PostAPIPaymentRequestsRequestObject) (merch.PostAPIPaymentRequestsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	fromUsername := request.Body.FromUser
+	if fromUsername == "" {
+		errors := "empty fromUser body value"
+		return merch.PostAPIPaymentRequests400JSONResponse{Errors: &errors}, nil
+	}
+
+	amount := request.Body.Amount
+	if amount <= 0 {
+		errors := "non-positive amount body value"
+		return merch.PostAPIPaymentRequests400JSONResponse{Errors: &errors}, nil
+	}
+
+	note := valueOrZero(request.Body.Note)
+	if utf8.RuneCountInString(note) > maxPaymentRequestNoteLen {
+		errors := fmt.Sprintf("note body value longer than %d characters", maxPaymentRequestNoteLen)
+		return merch.PostAPIPaymentRequests400JSONResponse{Errors: &errors}, nil
+	}
+
+	paymentRequestCreator := paymentrequest.NewCreator(h.db)
+	pr, err := paymentRequestCreator.CreatePaymentRequest(ctx, &paymentrequest.CreatorCreatePaymentRequestParams{
+		RequesterID:   userID,
+		PayerUsername: fromUsername,
+		Amount:        amount,
+		Note:          note,
+		TTL:           h.paymentRequestTTL,
+	})
+	if err != nil {
+		if errors.Is(err, paymentrequest.ErrPayerNotFound) {
+			errors := "fromUser doesn't exist"
+			return merch.PostAPIPaymentRequests400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, paymentrequest.ErrRequesterAndPayerEqual) {
+			errors := "fromUser and toUser are equal"
+			return merch.PostAPIPaymentRequests400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	paymentRequestGetter := paymentrequest.NewGetter(h.db)
+	pr, err = paymentRequestGetter.GetPaymentRequest(ctx, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PostAPIPaymentRequests200JSONResponse(paymentRequestResponse(pr, nil)), nil
+}
+
+// PostAPIPaymentRequestsIDAccept implements merch.StrictServerInterface.
+func (h *Handler) PostAPIPaymentRequestsIDAccept(ctx context.Context, request merch.PostAPIPaymentRequestsIDAcceptRequestObject) (merch.PostAPIPaymentRequestsIDAcceptResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	decider := paymentrequest.NewDecider(h.db)
+	_, t, err := decider.Accept(ctx, request.ID, userID)
+	if err != nil {
+		if errors.Is(err, paymentrequest.ErrNotExist) {
+			errors := "payment request doesn't exist"
+			return merch.PostAPIPaymentRequestsIDAccept404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, paymentrequest.ErrNotPayer) {
+			errors := "not the payer of the payment request"
+			return merch.PostAPIPaymentRequestsIDAccept403JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, paymentrequest.ErrNotPending) {
+			errors := "payment request is not pending"
+			return merch.PostAPIPaymentRequestsIDAccept400JSONResponse{Errors: &errors}, nil
+		}
+		var limitExceededErr *transfer.LimitExceededError
+		if errors.As(err, &limitExceededErr) {
+			errors := limitExceededMessage(limitExceededErr)
+			return merch.PostAPIPaymentRequestsIDAccept400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, coin.ErrNotEnough) {
+			errors := "not enough coin"
+			return merch.PostAPIPaymentRequestsIDAccept400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	paymentRequestGetter := paymentrequest.NewGetter(h.db)
+	pr, err := paymentRequestGetter.GetPaymentRequest(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PostAPIPaymentRequestsIDAccept200JSONResponse(paymentRequestResponse(pr, t)), nil
+}
+
+// PostAPIPaymentRequestsIDDecline implements merch.StrictServerInterface.
+func (h *Handler) PostAPIPaymentRequestsIDDecline(ctx context.Context, request merch.PostAPIPaymentRequestsIDDeclineRequestObject) (merch.PostAPIPaymentRequestsIDDeclineResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	decider := paymentrequest.NewDecider(h.db)
+	_, err := decider.Decline(ctx, request.ID, userID)
+	if err != nil {
+		if errors.Is(err, paymentrequest.ErrNotExist) {
+			errors := "payment request doesn't exist"
+			return merch.PostAPIPaymentRequestsIDDecline404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, paymentrequest.ErrNotPayer) {
+			errors := "not the payer of the payment request"
+			return merch.PostAPIPaymentRequestsIDDecline403JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, paymentrequest.ErrNotPending) {
+			errors := "payment request is not pending"
+			return merch.PostAPIPaymentRequestsIDDecline400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	paymentRequestGetter := paymentrequest.NewGetter(h.db)
+	pr, err := paymentRequestGetter.GetPaymentRequest(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PostAPIPaymentRequestsIDDecline200JSONResponse(paymentRequestResponse(pr, nil)), nil
+}
+
+// paymentRequestResponse returns the response representation of pr.
+// If t is not nil, it is the transfer made on accept, and its status is included.
+func paymentRequestResponse(pr *paymentrequest.PaymentRequest, t *transfer.Transfer) merch.PaymentRequest {
+	status := merch.PaymentRequestStatus(pr.Status)
+	var transferStatus *merch.TransferStatus
+	if t != nil {
+		s := merch.TransferStatus(t.Status)
+		transferStatus = &s
+	}
+	return merch.PaymentRequest{
+		ID:             &pr.ID,
+		FromUser:       &pr.PayerUsername,
+		ToUser:         &pr.RequesterUsername,
+		Amount:         &pr.Amount,
+		Note:           nonEmptyStringOrNil(pr.Note),
+		Status:         &status,
+		TransferStatus: transferStatus,
+		CreatedAt:      &pr.CreatedAt,
+		ExpiresAt:      &pr.ExpiresAt,
+	}
+}
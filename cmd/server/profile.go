@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/profile"
+	"github.com/k11v/merch/internal/user"
+)
+
+const maxProfileFieldLen = 100
+
+const (
+	defaultUserSearchLimit = 20
+	maxUserSearchLimit     = 100
+)
+
+// GetAPIProfile implements merch.StrictServerInterface.
+func (h *Handler) GetAPIProfile(ctx context.Context, request merch.GetAPIProfileRequestObject) (merch.GetAPIProfileResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	profileGetter := profile.NewGetter(h.db)
+	p, err := profileGetter.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := profileResponse(p)
+	response.Badges, err = h.badgesResponse(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.GetAPIProfile200JSONResponse(response), nil
+}
+
+// PutAPIProfile implements merch.StrictServerInterface.
+func (h *Handler) PutAPIProfile(ctx context.Context, request merch.PutAPIProfileRequestObject) (merch.PutAPIProfileResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	params := &profile.UpdaterUpdateProfileParams{
+		DisplayName: valueOrZero(request.Body.DisplayName),
+		Department:  valueOrZero(request.Body.Department),
+		Title:       valueOrZero(request.Body.Title),
+		AvatarURL:   valueOrZero(request.Body.AvatarURL),
+	}
+	if request.Body.StartDate != nil {
+		startDate := request.Body.StartDate.Time
+		params.StartDate = &startDate
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{name: "displayName", value: params.DisplayName},
+		{name: "department", value: params.Department},
+		{name: "title", value: params.Title},
+		{name: "avatarUrl", value: params.AvatarURL},
+	} {
+		if utf8.RuneCountInString(field.value) > maxProfileFieldLen {
+			errors := fmt.Sprintf("%s body value longer than %d characters", field.name, maxProfileFieldLen)
+			return merch.PutAPIProfile400JSONResponse{Errors: &errors}, nil
+		}
+	}
+
+	if params.AvatarURL != "" {
+		avatarURL, err := url.Parse(params.AvatarURL)
+		if err != nil || !avatarURL.IsAbs() || (avatarURL.Scheme != "http" && avatarURL.Scheme != "https") {
+			errors := "avatarUrl body value is not an absolute http or https URL"
+			return merch.PutAPIProfile400JSONResponse{Errors: &errors}, nil
+		}
+	}
+
+	profileUpdater := profile.NewUpdater(h.db)
+	p, err := profileUpdater.UpdateProfile(ctx, userID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	response := profileResponse(p)
+	response.Badges, err = h.badgesResponse(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PutAPIProfile200JSONResponse(response), nil
+}
+
+// GetAPIUsersUsername implements merch.StrictServerInterface.
+func (h *Handler) GetAPIUsersUsername(ctx context.Context, request merch.GetAPIUsersUsernameRequestObject) (merch.GetAPIUsersUsernameResponseObject, error) {
+	username := request.Username
+	if username == "" {
+		errors := "empty username"
+		return merch.GetAPIUsersUsername400JSONResponse{Errors: &errors}, nil
+	}
+
+	profileGetter := profile.NewGetter(h.db)
+	p, err := profileGetter.GetProfileByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			errors := "user doesn't exist"
+			return merch.GetAPIUsersUsername404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	response := profileResponse(p)
+	response.Badges, err = h.badgesResponse(ctx, p.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.GetAPIUsersUsername200JSONResponse(response), nil
+}
+
+// GetAPIUsers implements merch.StrictServerInterface.
+func (h *Handler) GetAPIUsers(ctx context.Context, request merch.GetAPIUsersRequestObject) (merch.GetAPIUsersResponseObject, error) {
+	query := valueOrZero(request.Params.Q)
+	if utf8.RuneCountInString(query) > maxProfileFieldLen {
+		errors := fmt.Sprintf("q query value longer than %d characters", maxProfileFieldLen)
+		return merch.GetAPIUsers400JSONResponse{Errors: &errors}, nil
+	}
+
+	limit := defaultUserSearchLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if limit <= 0 || limit > maxUserSearchLimit {
+		errors := fmt.Sprintf("limit query value not between 1 and %d", maxUserSearchLimit)
+		return merch.GetAPIUsers400JSONResponse{Errors: &errors}, nil
+	}
+
+	offset := valueOrZero(request.Params.Offset)
+	if offset < 0 {
+		errors := "negative offset query value"
+		return merch.GetAPIUsers400JSONResponse{Errors: &errors}, nil
+	}
+
+	profileGetter := profile.NewGetter(h.db)
+	profiles, err := profileGetter.SearchProfiles(ctx, &profile.GetterSearchProfilesParams{
+		Query:  query,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]merch.Profile, len(profiles))
+	for i, p := range profiles {
+		users[i] = profileResponse(p)
+	}
+
+	return merch.GetAPIUsers200JSONResponse{Users: &users}, nil
+}
+
+// PostAPIUsersUsernameDeactivate implements merch.StrictServerInterface.
+func (h *Handler) PostAPIUsersUsernameDeactivate(ctx context.Context, request merch.PostAPIUsersUsernameDeactivateRequestObject) (merch.PostAPIUsersUsernameDeactivateResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	username := request.Username
+	if username == "" {
+		errors := "empty username"
+		return merch.PostAPIUsersUsernameDeactivate400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIUsersUsernameDeactivate403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	userDeactivator := user.NewDeactivator(h.db)
+	err = userDeactivator.Deactivate(ctx, username)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			errors := "user doesn't exist"
+			return merch.PostAPIUsersUsernameDeactivate404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIUsersUsernameDeactivate200Response{}, nil
+}
+
+// PostAPIUsersUsernameReactivate implements merch.StrictServerInterface.
+func (h *Handler) PostAPIUsersUsernameReactivate(ctx context.Context, request merch.PostAPIUsersUsernameReactivateRequestObject) (merch.PostAPIUsersUsernameReactivateResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	username := request.Username
+	if username == "" {
+		errors := "empty username"
+		return merch.PostAPIUsersUsernameReactivate400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIUsersUsernameReactivate403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	userDeactivator := user.NewDeactivator(h.db)
+	err = userDeactivator.Reactivate(ctx, username)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			errors := "user doesn't exist"
+			return merch.PostAPIUsersUsernameReactivate404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIUsersUsernameReactivate200Response{}, nil
+}
+
+func profileResponse(p *profile.Profile) merch.Profile {
+	var startDate *openapi_types.Date
+	if p.StartDate != nil {
+		startDate = &openapi_types.Date{Time: p.StartDate.In(time.UTC)}
+	}
+	return merch.Profile{
+		Username:    &p.Username,
+		DisplayName: nonEmptyStringOrNil(p.DisplayName),
+		Department:  nonEmptyStringOrNil(p.Department),
+		Title:       nonEmptyStringOrNil(p.Title),
+		AvatarURL:   nonEmptyStringOrNil(p.AvatarURL),
+		StartDate:   startDate,
+	}
+}
+
+func valueOrZero[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/promo"
+)
+
+// GetAPIPromoCodes implements merch.StrictServerInterface.
+func (h *Handler) GetAPIPromoCodes(ctx context.Context, request merch.GetAPIPromoCodesRequestObject) (merch.GetAPIPromoCodesResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.GetAPIPromoCodes403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	promoGetter := promo.NewGetter(h.db)
+	pcs, err := promoGetter.GetPromoCodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responsePromoCodes := make([]merch.PromoCode, len(pcs))
+	for i, pc := range pcs {
+		responsePromoCodes[i] = promoCodeResponse(pc)
+	}
+
+	return merch.GetAPIPromoCodes200JSONResponse{PromoCodes: &responsePromoCodes}, nil
+}
+
+// PostAPIPromoCodes implements merch.StrictServerInterface.
+func (h *Handler) PostAPIPromoCodes(ctx context.Context, request merch.PostAPIPromoCodesRequestObject) (merch.PostAPIPromoCodesResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	kind := promo.Kind(request.Body.Kind)
+	if !kind.Valid() {
+		errors := "invalid kind body value"
+		return merch.PostAPIPromoCodes400JSONResponse{Errors: &errors}, nil
+	}
+
+	expiresAt := request.Body.ExpiresAt
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		errors := "expiresAt body value not in the future"
+		return merch.PostAPIPromoCodes400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIPromoCodes403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	promoCreator := promo.NewCreator(h.db)
+	pc, err := promoCreator.CreatePromoCode(ctx, &promo.CreatorCreatePromoCodeParams{
+		CreatedBy:      userID,
+		Code:           request.Body.Code,
+		Kind:           kind,
+		Value:          request.Body.Value,
+		MaxRedemptions: request.Body.MaxRedemptions,
+		NewUserDays:    request.Body.NewUserDays,
+		ExpiresAt:      expiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, promo.ErrInvalidCode) {
+			errors := "invalid code body value"
+			return merch.PostAPIPromoCodes400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, promo.ErrInvalidValue) {
+			errors := "invalid value, maxRedemptions or newUserDays body value"
+			return merch.PostAPIPromoCodes400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, promo.ErrExist) {
+			errors := "promo code already exists"
+			return merch.PostAPIPromoCodes400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIPromoCodes200JSONResponse(promoCodeResponse(pc)), nil
+}
+
+// PostAPIPromoCodesRedeem implements merch.StrictServerInterface.
+func (h *Handler) PostAPIPromoCodesRedeem(ctx context.Context, request merch.PostAPIPromoCodesRedeemRequestObject) (merch.PostAPIPromoCodesRedeemResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	code := request.Body.Code
+	if code == "" {
+		errors := "empty code body value"
+		return merch.PostAPIPromoCodesRedeem400JSONResponse{Errors: &errors}, nil
+	}
+
+	promoRedeemer := promo.NewRedeemer(h.db)
+	redemption, err := promoRedeemer.Redeem(ctx, code, userID)
+	if err != nil {
+		if errors.Is(err, promo.ErrNotExist) {
+			errors := "promo code doesn't exist"
+			return merch.PostAPIPromoCodesRedeem404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, promo.ErrNotCoins) {
+			errors := "promo code is a discount, use it when buying an item"
+			return merch.PostAPIPromoCodesRedeem400JSONResponse{Errors: &errors}, nil
+		}
+		if message, ok := promoCodeErrorMessage(err); ok {
+			return merch.PostAPIPromoCodesRedeem400JSONResponse{Errors: &message}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIPromoCodesRedeem200JSONResponse(promoCodeRedemptionResponse(redemption)), nil
+}
+
+// GetAPIPromoCodesCodeRedemptions implements merch.StrictServerInterface.
+func (h *Handler) GetAPIPromoCodesCodeRedemptions(ctx context.Context, request merch.GetAPIPromoCodesCodeRedemptionsRequestObject) (merch.GetAPIPromoCodesCodeRedemptionsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.GetAPIPromoCodesCodeRedemptions403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	promoGetter := promo.NewGetter(h.db)
+	pc, err := promoGetter.GetPromoCodeByCode(ctx, request.Code)
+	if err != nil {
+		if errors.Is(err, promo.ErrNotExist) {
+			errors := "promo code doesn't exist"
+			return merch.GetAPIPromoCodesCodeRedemptions404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	redemptions, err := promoGetter.GetRedemptionsByPromoCodeID(ctx, pc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseRedemptions := make([]merch.PromoCodeRedemption, len(redemptions))
+	for i, r := range redemptions {
+		responseRedemptions[i] = promoCodeRedemptionResponse(r)
+	}
+
+	return merch.GetAPIPromoCodesCodeRedemptions200JSONResponse{Redemptions: &responseRedemptions}, nil
+}
+
+// promoCodeErrorMessage returns the response message for errors of redeeming a promo code.
+func promoCodeErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, promo.ErrNotExist):
+		return "promo code doesn't exist", true
+	case errors.Is(err, promo.ErrExpired):
+		return "promo code expired", true
+	case errors.Is(err, promo.ErrExhausted):
+		return "promo code redemption limit reached", true
+	case errors.Is(err, promo.ErrAlreadyRedeemed):
+		return "promo code already redeemed", true
+	case errors.Is(err, promo.ErrNotNewUser):
+		return "promo code is only for new users", true
+	case errors.Is(err, promo.ErrNotDiscount):
+		return "promo code is not a discount, redeem it instead", true
+	default:
+		return "", false
+	}
+}
+
+func promoCodeResponse(pc *promo.PromoCode) merch.PromoCode {
+	kind := merch.PromoCodeKind(pc.Kind)
+	return merch.PromoCode{
+		Code:            &pc.Code,
+		Kind:            &kind,
+		Value:           &pc.Value,
+		MaxRedemptions:  pc.MaxRedemptions,
+		RedemptionCount: &pc.RedemptionCount,
+		NewUserDays:     pc.NewUserDays,
+		ExpiresAt:       pc.ExpiresAt,
+		CreatedAt:       &pc.CreatedAt,
+	}
+}
+
+func promoCodeRedemptionResponse(r *promo.Redemption) merch.PromoCodeRedemption {
+	kind := merch.PromoCodeKind(r.Kind)
+	return merch.PromoCodeRedemption{
+		Code:      &r.Code,
+		Kind:      &kind,
+		User:      nonEmptyStringOrNil(r.Username),
+		Amount:    &r.Amount,
+		CreatedAt: &r.CreatedAt,
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/raffle"
+)
+
+const maxRaffleTicketsPerRequest = 100
+
+// GetAPIRaffles implements merch.StrictServerInterface.
+func (h *Handler) GetAPIRaffles(ctx context.Context, request merch.GetAPIRafflesRequestObject) (merch.GetAPIRafflesResponseObject, error) {
+	raffleGetter := raffle.NewGetter(h.db)
+	raffles, err := raffleGetter.GetOpenRaffles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responseRaffles := make([]merch.Raffle, len(raffles))
+	for i, r := range raffles {
+		responseRaffles[i] = raffleResponse(r)
+	}
+
+	return merch.GetAPIRaffles200JSONResponse{Raffles: &responseRaffles}, nil
+}
+
+// PostAPIRaffles implements merch.StrictServerInterface.
+func (h *Handler) PostAPIRaffles(ctx context.Context, request merch.PostAPIRafflesRequestObject) (merch.PostAPIRafflesResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	name := request.Body.Name
+	if name == "" {
+		errors := "empty name body value"
+		return merch.PostAPIRaffles400JSONResponse{Errors: &errors}, nil
+	}
+
+	itemName := request.Body.Item
+	if itemName == "" {
+		errors := "empty item body value"
+		return merch.PostAPIRaffles400JSONResponse{Errors: &errors}, nil
+	}
+
+	ticketPrice := request.Body.TicketPrice
+	if ticketPrice <= 0 {
+		errors := "non-positive ticketPrice body value"
+		return merch.PostAPIRaffles400JSONResponse{Errors: &errors}, nil
+	}
+
+	winnerCount := 1
+	if request.Body.WinnerCount != nil {
+		winnerCount = *request.Body.WinnerCount
+	}
+	if winnerCount <= 0 {
+		errors := "non-positive winnerCount body value"
+		return merch.PostAPIRaffles400JSONResponse{Errors: &errors}, nil
+	}
+
+	maxTicketsPerUser := request.Body.MaxTicketsPerUser
+	if maxTicketsPerUser != nil && *maxTicketsPerUser <= 0 {
+		errors := "non-positive maxTicketsPerUser body value"
+		return merch.PostAPIRaffles400JSONResponse{Errors: &errors}, nil
+	}
+
+	drawsAt := request.Body.DrawsAt
+	if !drawsAt.After(time.Now()) {
+		errors := "drawsAt body value not after now"
+		return merch.PostAPIRaffles400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIRaffles403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	raffleCreator := raffle.NewCreator(h.db)
+	r, err := raffleCreator.CreateRaffle(ctx, &raffle.CreatorCreateRaffleParams{
+		Name:              name,
+		ItemName:          itemName,
+		TicketPrice:       ticketPrice,
+		WinnerCount:       winnerCount,
+		MaxTicketsPerUser: maxTicketsPerUser,
+		DrawsAt:           drawsAt,
+		CreatedBy:         userID,
+	})
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPIRaffles404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, raffle.ErrItemHasVariants) {
+			errors := "item has variants"
+			return merch.PostAPIRaffles400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIRaffles200JSONResponse(raffleResponse(r)), nil
+}
+
+// GetAPIRafflesID implements merch.StrictServerInterface.
+func (h *Handler) GetAPIRafflesID(ctx context.Context, request merch.GetAPIRafflesIDRequestObject) (merch.GetAPIRafflesIDResponseObject, error) {
+	raffleGetter := raffle.NewGetter(h.db)
+	r, err := raffleGetter.GetRaffle(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, raffle.ErrNotExist) {
+			errors := "raffle does not exist"
+			return merch.GetAPIRafflesID404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.GetAPIRafflesID200JSONResponse(raffleResponse(r)), nil
+}
+
+// GetAPIRafflesIDTickets implements merch.StrictServerInterface.
+func (h *Handler) GetAPIRafflesIDTickets(ctx context.Context, request merch.GetAPIRafflesIDTicketsRequestObject) (merch.GetAPIRafflesIDTicketsResponseObject, error) {
+	raffleGetter := raffle.NewGetter(h.db)
+	_, err := raffleGetter.GetRaffle(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, raffle.ErrNotExist) {
+			errors := "raffle does not exist"
+			return merch.GetAPIRafflesIDTickets404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	tickets, err := raffleGetter.GetTicketsByRaffleID(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.GetAPIRafflesIDTickets200JSONResponse{Tickets: raffleTicketsResponse(tickets)}, nil
+}
+
+// PostAPIRafflesIDTickets implements merch.StrictServerInterface.
+func (h *Handler) PostAPIRafflesIDTickets(ctx context.Context, request merch.PostAPIRafflesIDTicketsRequestObject) (merch.PostAPIRafflesIDTicketsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	count := 1
+	if request.Body.Count != nil {
+		count = *request.Body.Count
+	}
+	if count <= 0 || count > maxRaffleTicketsPerRequest {
+		errors := fmt.Sprintf("count body value not between 1 and %d", maxRaffleTicketsPerRequest)
+		return merch.PostAPIRafflesIDTickets400JSONResponse{Errors: &errors}, nil
+	}
+
+	ticketBuyer := raffle.NewTicketBuyer(h.db)
+	tickets, err := ticketBuyer.BuyTickets(ctx, request.ID, userID, count)
+	if err != nil {
+		if errors.Is(err, raffle.ErrNotExist) {
+			errors := "raffle does not exist"
+			return merch.PostAPIRafflesIDTickets404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, raffle.ErrClosed) {
+			errors := "raffle closed"
+			return merch.PostAPIRafflesIDTickets400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, raffle.ErrTicketLimitReached) {
+			errors := "ticket limit reached"
+			return merch.PostAPIRafflesIDTickets400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, coin.ErrNotEnough) {
+			errors := "not enough coin"
+			return merch.PostAPIRafflesIDTickets400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIRafflesIDTickets200JSONResponse{Tickets: raffleTicketsResponse(tickets)}, nil
+}
+
+// raffleResponse reveals the seed only after the draw, so it can't be used to predict winners.
+func raffleResponse(r *raffle.Raffle) merch.Raffle {
+	status := string(r.Status)
+	response := merch.Raffle{
+		ID:                &r.ID,
+		Name:              &r.Name,
+		Item:              &r.ItemName,
+		TicketPrice:       &r.TicketPrice,
+		WinnerCount:       &r.WinnerCount,
+		MaxTicketsPerUser: r.MaxTicketsPerUser,
+		TicketCount:       &r.TicketCount,
+		Status:            &status,
+		DrawsAt:           &r.DrawsAt,
+		DrawnAt:           r.DrawnAt,
+		SeedHash:          &r.SeedHash,
+		CreatedAt:         &r.CreatedAt,
+	}
+	if r.Status == raffle.StatusDrawn {
+		response.Seed = &r.Seed
+	}
+	return response
+}
+
+func raffleTicketsResponse(tickets []*raffle.Ticket) *[]merch.RaffleTicket {
+	responseTickets := make([]merch.RaffleTicket, len(tickets))
+	for i, t := range tickets {
+		won := t.Won()
+		responseTickets[i] = merch.RaffleTicket{
+			Number:    &t.Number,
+			User:      &t.Username,
+			Won:       &won,
+			CreatedAt: &t.CreatedAt,
+		}
+	}
+	return &responseTickets
+}
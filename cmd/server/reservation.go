@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/purchase"
+)
+
+// GetAPIReservations implements merch.StrictServerInterface.
+func (h *Handler) GetAPIReservations(ctx context.Context, request merch.GetAPIReservationsRequestObject) (merch.GetAPIReservationsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	purchaseGetter := purchase.NewGetter(h.db)
+	reservations, err := purchaseGetter.GetActiveReservationsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseReservations := make([]merch.Reservation, len(reservations))
+	for i, r := range reservations {
+		responseReservations[i] = reservationResponse(r)
+	}
+
+	return merch.GetAPIReservations200JSONResponse{Reservations: &responseReservations}, nil
+}
+
+// PostAPIReservations implements merch.StrictServerInterface.
+func (h *Handler) PostAPIReservations(ctx context.Context, request merch.PostAPIReservationsRequestObject) (merch.PostAPIReservationsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	itemName := request.Body.Item
+	if itemName == "" {
+		errors := "empty item body value"
+		return merch.PostAPIReservations400JSONResponse{Errors: &errors}, nil
+	}
+
+	reserver := purchase.NewReserver(h.db)
+	r, err := reserver.Reserve(ctx, &purchase.ReserverReserveParams{
+		ItemName: itemName,
+		UserID:   userID,
+		Variant:  variantSelectorOrNil(request.Body.Size, request.Body.Color),
+		TTL:      h.reservationTTL,
+	})
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPIReservations400JSONResponse{Errors: &errors}, nil
+		}
+		if message, ok := variantErrorMessage(err); ok {
+			return merch.PostAPIReservations400JSONResponse{Errors: &message}, nil
+		}
+		if errors.Is(err, purchase.ErrLimitReached) {
+			errors := "purchase limit reached"
+			return merch.PostAPIReservations400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, coin.ErrNotEnough) {
+			errors := "not enough coin"
+			return merch.PostAPIReservations400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIReservations200JSONResponse(reservationResponse(r)), nil
+}
+
+// DeleteAPIReservationsID implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPIReservationsID(ctx context.Context, request merch.DeleteAPIReservationsIDRequestObject) (merch.DeleteAPIReservationsIDResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	reserver := purchase.NewReserver(h.db)
+	_, err := reserver.Cancel(ctx, request.ID, userID)
+	if err != nil {
+		if errors.Is(err, purchase.ErrReservationNotExist) {
+			errors := "reservation does not exist"
+			return merch.DeleteAPIReservationsID404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, purchase.ErrReservationNotActive) {
+			errors := "reservation not active"
+			return merch.DeleteAPIReservationsID400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPIReservationsID200Response{}, nil
+}
+
+// PostAPIReservationsIDPurchase implements merch.StrictServerInterface.
+func (h *Handler) PostAPIReservationsIDPurchase(ctx context.Context, request merch.PostAPIReservationsIDPurchaseRequestObject) (merch.PostAPIReservationsIDPurchaseResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	purchaser := purchase.NewPurchaser(h.db)
+	_, err := purchaser.PurchaseReservation(ctx, &purchase.PurchaserPurchaseReservationParams{
+		ReservationID: request.ID,
+		UserID:        userID,
+		Variant:       variantSelectorOrNil(request.Params.Size, request.Params.Color),
+	})
+	if err != nil {
+		if errors.Is(err, purchase.ErrReservationNotExist) {
+			errors := "reservation does not exist"
+			return merch.PostAPIReservationsIDPurchase404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, purchase.ErrReservationNotActive) {
+			errors := "reservation not active"
+			return merch.PostAPIReservationsIDPurchase400JSONResponse{Errors: &errors}, nil
+		}
+		if message, ok := variantErrorMessage(err); ok {
+			return merch.PostAPIReservationsIDPurchase400JSONResponse{Errors: &message}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIReservationsIDPurchase200Response{}, nil
+}
+
+func reservationResponse(r *purchase.Reservation) merch.Reservation {
+	status := string(r.Status)
+	return merch.Reservation{
+		ID:        &r.ID,
+		Item:      &r.ItemName,
+		Size:      nonEmptyStringOrNil(r.VariantSize),
+		Color:     nonEmptyStringOrNil(r.VariantColor),
+		Amount:    &r.Amount,
+		ListPrice: &r.ListPrice,
+		Status:    &status,
+		ExpiresAt: &r.ExpiresAt,
+		CreatedAt: &r.CreatedAt,
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/schedule"
+)
+
+// GetAPIScheduledTransfers implements merch.StrictServerInterface.
+func (h *Handler) GetAPIScheduledTransfers(ctx context.Context, request merch.GetAPIScheduledTransfersRequestObject) (merch.GetAPIScheduledTransfersResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	scheduleGetter := schedule.NewGetter(h.db)
+	sts, err := scheduleGetter.GetScheduledTransfersBySrcUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseScheduledTransfers := make([]merch.ScheduledTransfer, len(sts))
+	for i, st := range sts {
+		responseScheduledTransfers[i] = scheduledTransferResponse(st)
+	}
+
+	return merch.GetAPIScheduledTransfers200JSONResponse{ScheduledTransfers: &responseScheduledTransfers}, nil
+}
+
+// PostAPIScheduledTransfers implements merch.StrictServerInterface.
+func (h *Handler) PostAPIScheduledTransfers(ctx context.Context, request merch.PostAPIScheduledTransfersRequestObject) (merch.PostAPIScheduledTransfersResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	toUsername := request.Body.ToUser
+	if toUsername == "" {
+		errors := "empty toUser body value"
+		return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+	}
+
+	amount := request.Body.Amount
+	if amount <= 0 {
+		errors := "non-positive amount body value"
+		return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+	}
+
+	runAt := request.Body.RunAt
+	if runAt == nil && request.Body.Cron == nil {
+		errors := "neither runAt nor cron body value"
+		return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+	}
+	if runAt != nil && !runAt.After(time.Now()) {
+		errors := "runAt body value not in the future"
+		return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+	}
+
+	var cron *schedule.Cron
+	if request.Body.Cron != nil {
+		var err error
+		cron, err = schedule.ParseCron(*request.Body.Cron)
+		if err != nil {
+			if errors.Is(err, schedule.ErrInvalidCron) {
+				errors := "invalid cron body value"
+				return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+			}
+			return nil, err
+		}
+	}
+
+	scheduleCreator := schedule.NewCreator(h.db)
+	st, err := scheduleCreator.CreateScheduledTransfer(ctx, &schedule.CreatorCreateScheduledTransferParams{
+		SrcUserID:   userID,
+		DstUsername: toUsername,
+		Amount:      amount,
+		RunAt:       runAt,
+		Cron:        cron,
+	})
+	if err != nil {
+		if errors.Is(err, schedule.ErrDstUserNotFound) {
+			errors := "toUser doesn't exist"
+			return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, schedule.ErrDstUserDeactivated) {
+			errors := "toUser is deactivated"
+			return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, schedule.ErrSrcUserAndDstUserEqual) {
+			errors := "fromUser and toUser are equal"
+			return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, schedule.ErrNeverRuns) {
+			errors := "cron body value never matches"
+			return merch.PostAPIScheduledTransfers400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIScheduledTransfers200JSONResponse(scheduledTransferResponse(st)), nil
+}
+
+// DeleteAPIScheduledTransfersID implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPIScheduledTransfersID(ctx context.Context, request merch.DeleteAPIScheduledTransfersIDRequestObject) (merch.DeleteAPIScheduledTransfersIDResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	scheduleCanceler := schedule.NewCanceler(h.db)
+	st, err := scheduleCanceler.Cancel(ctx, request.ID, userID)
+	if err != nil {
+		if errors.Is(err, schedule.ErrNotExist) {
+			errors := "scheduled transfer doesn't exist"
+			return merch.DeleteAPIScheduledTransfersID404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, schedule.ErrNotOwner) {
+			errors := "not the sender of the scheduled transfer"
+			return merch.DeleteAPIScheduledTransfersID403JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, schedule.ErrNotActive) {
+			errors := "scheduled transfer is not active"
+			return merch.DeleteAPIScheduledTransfersID400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPIScheduledTransfersID200JSONResponse(scheduledTransferResponse(st)), nil
+}
+
+func scheduledTransferResponse(st *schedule.ScheduledTransfer) merch.ScheduledTransfer {
+	status := merch.ScheduledTransferStatus(st.Status)
+	return merch.ScheduledTransfer{
+		ID:        &st.ID,
+		ToUser:    &st.DstUsername,
+		Amount:    &st.Amount,
+		Cron:      st.Cron,
+		Status:    &status,
+		NextRunAt: st.NextRunAt,
+		LastRunAt: st.LastRunAt,
+		LastError: nonEmptyStringOrNil(st.LastError),
+		CreatedAt: &st.CreatedAt,
+	}
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
 	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/budget"
 	"github.com/k11v/merch/internal/coin"
 	"github.com/k11v/merch/internal/transfer"
 )
 
+const maxTransferMessageLen = 200
+
 // PostAPISendCoin implements merch.StrictServerInterface.
 func (h *Handler) PostAPISendCoin(ctx context.Context, request merch.PostAPISendCoinRequestObject) (merch.PostAPISendCoinResponseObject, error) {
 	requestUserID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
@@ -31,19 +35,50 @@ func (h *Handler) PostAPISendCoin(ctx context.Context, request merch.PostAPISend
 		return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
 	}
 
+	message := valueOrZero(request.Body.Message)
+	if utf8.RuneCountInString(message) > maxTransferMessageLen {
+		errors := fmt.Sprintf("message body value longer than %d characters", maxTransferMessageLen)
+		return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
+	}
+
 	fromUserID := requestUserID
 
+	fromBudget := valueOrZero(request.Body.FromBudget)
+
 	transferer := transfer.NewTransferer(h.db)
-	err := transferer.TransferByUsername(ctx, toUsername, fromUserID, amount)
+	t, err := transferer.Transfer(ctx, &transfer.TransfererTransferParams{
+		DstUsername: toUsername,
+		SrcUserID:   fromUserID,
+		Amount:      amount,
+		Message:     message,
+		FromBudget:  fromBudget,
+	})
 	if err != nil {
 		if errors.Is(err, transfer.ErrDstUserNotFound) {
 			errors := "toUser doesn't exist"
 			return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
 		}
+		if errors.Is(err, transfer.ErrDstUserDeactivated) {
+			errors := "toUser is deactivated"
+			return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
+		}
 		if errors.Is(err, transfer.ErrSrcUserAndDstUserEqual) {
 			errors := "fromUser and toUser are equal"
 			return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
 		}
+		var limitExceededErr *transfer.LimitExceededError
+		if errors.As(err, &limitExceededErr) {
+			errors := limitExceededMessage(limitExceededErr)
+			return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, budget.ErrNotExist) {
+			errors := "no giving budget"
+			return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, coin.ErrNotEnough) && fromBudget {
+			errors := "not enough coin in giving budget"
+			return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
+		}
 		if errors.Is(err, coin.ErrNotEnough) {
 			errors := "not enough coin"
 			return merch.PostAPISendCoin400JSONResponse{Errors: &errors}, nil
@@ -51,5 +86,103 @@ func (h *Handler) PostAPISendCoin(ctx context.Context, request merch.PostAPISend
 		return nil, err
 	}
 
+	if t.Status == transfer.StatusPending {
+		return merch.PostAPISendCoin202JSONResponse(transferResponse(t)), nil
+	}
+
 	return merch.PostAPISendCoin200Response{}, nil
 }
+
+const maxBatchSendCoinItems = 100
+
+// PostAPISendCoinBatch implements merch.StrictServerInterface.
+func (h *Handler) PostAPISendCoinBatch(ctx context.Context, request merch.PostAPISendCoinBatchRequestObject) (merch.PostAPISendCoinBatchResponseObject, error) {
+	requestUserID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	requestItems := request.Body.Transfers
+	if len(requestItems) == 0 {
+		errors := "empty transfers body value"
+		return merch.PostAPISendCoinBatch400JSONResponse{Errors: &errors}, nil
+	}
+	if len(requestItems) > maxBatchSendCoinItems {
+		errors := fmt.Sprintf("transfers body value longer than %d items", maxBatchSendCoinItems)
+		return merch.PostAPISendCoinBatch400JSONResponse{Errors: &errors}, nil
+	}
+
+	results := make([]merch.BatchSendCoinResult, len(requestItems))
+	items := make([]transfer.BatchItem, len(requestItems))
+	for i, ri := range requestItems {
+		results[i] = merch.BatchSendCoinResult{ToUser: &ri.ToUser, Amount: &ri.Amount}
+		items[i] = transfer.BatchItem{DstUsername: ri.ToUser, Amount: ri.Amount, Message: valueOrZero(ri.Message)}
+	}
+	for i, ri := range requestItems {
+		var itemErrors string
+		switch {
+		case ri.ToUser == "":
+			itemErrors = "empty toUser body value"
+		case ri.Amount <= 0:
+			itemErrors = "non-positive amount body value"
+		case utf8.RuneCountInString(valueOrZero(ri.Message)) > maxTransferMessageLen:
+			itemErrors = fmt.Sprintf("message body value longer than %d characters", maxTransferMessageLen)
+		default:
+			continue
+		}
+		errors := fmt.Sprintf("transfers[%d]: %s", i, itemErrors)
+		results[i].Error = &itemErrors
+		return merch.PostAPISendCoinBatch400JSONResponse{Errors: &errors, Results: &results}, nil
+	}
+
+	transferer := transfer.NewTransferer(h.db)
+	transfers, err := transferer.BatchTransferByUsernames(ctx, requestUserID, items)
+	if err != nil {
+		var batchItemErr *transfer.BatchItemError
+		if !errors.As(err, &batchItemErr) {
+			return nil, err
+		}
+
+		var itemErrors string
+		var limitExceededErr *transfer.LimitExceededError
+		switch {
+		case errors.Is(err, transfer.ErrDstUserNotFound):
+			itemErrors = "toUser doesn't exist"
+		case errors.Is(err, transfer.ErrDstUserDeactivated):
+			itemErrors = "toUser is deactivated"
+		case errors.Is(err, transfer.ErrSrcUserAndDstUserEqual):
+			itemErrors = "fromUser and toUser are equal"
+		case errors.Is(err, transfer.ErrDstUserDuplicate):
+			itemErrors = "toUser is duplicate"
+		case errors.As(err, &limitExceededErr):
+			itemErrors = limitExceededMessage(limitExceededErr)
+		case errors.Is(err, coin.ErrNotEnough):
+			itemErrors = "not enough coin"
+		default:
+			return nil, err
+		}
+		errors := fmt.Sprintf("transfers[%d]: %s", batchItemErr.Index, itemErrors)
+		results[batchItemErr.Index].Error = &itemErrors
+		return merch.PostAPISendCoinBatch400JSONResponse{Errors: &errors, Results: &results}, nil
+	}
+
+	for i, t := range transfers {
+		status := merch.TransferStatus(t.Status)
+		results[i].Status = &status
+	}
+
+	return merch.PostAPISendCoinBatch200JSONResponse{Results: &results}, nil
+}
+
+func limitExceededMessage(e *transfer.LimitExceededError) string {
+	switch e.Kind {
+	case transfer.LimitKindPerTransfer:
+		return fmt.Sprintf("transfer limit exceeded: at most %d coins per transfer", e.Max)
+	case transfer.LimitKindPerDay:
+		return fmt.Sprintf("transfer limit exceeded: at most %d coins per day, %d left", e.Max, e.Available)
+	case transfer.LimitKindPerRecipient:
+		return fmt.Sprintf("transfer limit exceeded: at most %d coins per recipient, %d left", e.Max, e.Available)
+	default:
+		return "transfer limit exceeded"
+	}
+}
@@ -0,0 +1,396 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/team"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user"
+)
+
+const maxTeamNameLen = 100
+
+// GetAPITeams implements merch.StrictServerInterface.
+func (h *Handler) GetAPITeams(ctx context.Context, request merch.GetAPITeamsRequestObject) (merch.GetAPITeamsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	teamGetter := team.NewGetter(h.db)
+	teams, err := teamGetter.GetTeamsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseTeams := make([]merch.Team, len(teams))
+	for i, t := range teams {
+		responseTeams[i] = merch.Team{
+			Name:    &t.Name,
+			Balance: &t.Balance,
+		}
+	}
+
+	return merch.GetAPITeams200JSONResponse{Teams: &responseTeams}, nil
+}
+
+// PostAPITeams implements merch.StrictServerInterface.
+func (h *Handler) PostAPITeams(ctx context.Context, request merch.PostAPITeamsRequestObject) (merch.PostAPITeamsResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	name := request.Body.Name
+	if name == "" {
+		errors := "empty name body value"
+		return merch.PostAPITeams400JSONResponse{Errors: &errors}, nil
+	}
+	if utf8.RuneCountInString(name) > maxTeamNameLen {
+		errors := fmt.Sprintf("name body value longer than %d characters", maxTeamNameLen)
+		return merch.PostAPITeams400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPITeams403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	teamCreator := team.NewCreator(h.db)
+	t, err := teamCreator.CreateTeam(ctx, name)
+	if err != nil {
+		if errors.Is(err, team.ErrExist) {
+			errors := "team already exists"
+			return merch.PostAPITeams400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPITeams200JSONResponse{Name: &t.Name, Balance: &t.Balance}, nil
+}
+
+// GetAPITeamsTeam implements merch.StrictServerInterface.
+func (h *Handler) GetAPITeamsTeam(ctx context.Context, request merch.GetAPITeamsTeamRequestObject) (merch.GetAPITeamsTeamResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	teamGetter := team.NewGetter(h.db)
+	t, err := teamGetter.GetTeamByName(ctx, request.Team)
+	if err != nil {
+		if errors.Is(err, team.ErrNotExist) {
+			errors := "team doesn't exist"
+			return merch.GetAPITeamsTeam404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	allowed, err := h.isTeamMemberOrAdmin(ctx, t.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		errors := "not a team member"
+		return merch.GetAPITeamsTeam403JSONResponse{Errors: &errors}, nil
+	}
+
+	members, err := teamGetter.GetMembers(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseMembers := make([]merch.TeamMember, len(members))
+	for i, m := range members {
+		responseMembers[i] = teamMemberResponse(m)
+	}
+
+	return merch.GetAPITeamsTeam200JSONResponse{
+		Name:    &t.Name,
+		Balance: &t.Balance,
+		Members: &responseMembers,
+	}, nil
+}
+
+// PutAPITeamsTeamMembersUsername implements merch.StrictServerInterface.
+func (h *Handler) PutAPITeamsTeamMembersUsername(ctx context.Context, request merch.PutAPITeamsTeamMembersUsernameRequestObject) (merch.PutAPITeamsTeamMembersUsernameResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	role := team.Role(request.Body.Role)
+	if !role.Valid() {
+		errors := "invalid role body value"
+		return merch.PutAPITeamsTeamMembersUsername400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PutAPITeamsTeamMembersUsername403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	teamGetter := team.NewGetter(h.db)
+	t, err := teamGetter.GetTeamByName(ctx, request.Team)
+	if err != nil {
+		if errors.Is(err, team.ErrNotExist) {
+			errors := "team doesn't exist"
+			return merch.PutAPITeamsTeamMembersUsername404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	memberUpdater := team.NewMemberUpdater(h.db)
+	m, err := memberUpdater.SetMemberByUsername(ctx, t.ID, request.Username, role)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			errors := "user doesn't exist"
+			return merch.PutAPITeamsTeamMembersUsername404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, team.ErrNotExist) {
+			errors := "team doesn't exist"
+			return merch.PutAPITeamsTeamMembersUsername404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PutAPITeamsTeamMembersUsername200JSONResponse(teamMemberResponse(m)), nil
+}
+
+// DeleteAPITeamsTeamMembersUsername implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPITeamsTeamMembersUsername(ctx context.Context, request merch.DeleteAPITeamsTeamMembersUsernameRequestObject) (merch.DeleteAPITeamsTeamMembersUsernameResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.DeleteAPITeamsTeamMembersUsername403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	teamGetter := team.NewGetter(h.db)
+	t, err := teamGetter.GetTeamByName(ctx, request.Team)
+	if err != nil {
+		if errors.Is(err, team.ErrNotExist) {
+			errors := "team doesn't exist"
+			return merch.DeleteAPITeamsTeamMembersUsername404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	memberUpdater := team.NewMemberUpdater(h.db)
+	err = memberUpdater.RemoveMemberByUsername(ctx, t.ID, request.Username)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			errors := "user doesn't exist"
+			return merch.DeleteAPITeamsTeamMembersUsername404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, team.ErrNotMember) {
+			errors := "user is not a team member"
+			return merch.DeleteAPITeamsTeamMembersUsername404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPITeamsTeamMembersUsername200Response{}, nil
+}
+
+// PostAPITeamsTeamFund implements merch.StrictServerInterface.
+func (h *Handler) PostAPITeamsTeamFund(ctx context.Context, request merch.PostAPITeamsTeamFundRequestObject) (merch.PostAPITeamsTeamFundResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	amount := request.Body.Amount
+	if amount <= 0 {
+		errors := "non-positive amount body value"
+		return merch.PostAPITeamsTeamFund400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPITeamsTeamFund403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	teamGetter := team.NewGetter(h.db)
+	t, err := teamGetter.GetTeamByName(ctx, request.Team)
+	if err != nil {
+		if errors.Is(err, team.ErrNotExist) {
+			errors := "team doesn't exist"
+			return merch.PostAPITeamsTeamFund404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	funder := team.NewFunder(h.db)
+	_, err = funder.Fund(ctx, t.ID, userID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PostAPITeamsTeamFund200Response{}, nil
+}
+
+// PostAPITeamsTeamSendCoin implements merch.StrictServerInterface.
+func (h *Handler) PostAPITeamsTeamSendCoin(ctx context.Context, request merch.PostAPITeamsTeamSendCoinRequestObject) (merch.PostAPITeamsTeamSendCoinResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	toUsername := request.Body.ToUser
+	if toUsername == "" {
+		errors := "empty toUser body value"
+		return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+	}
+
+	amount := request.Body.Amount
+	if amount <= 0 {
+		errors := "non-positive amount body value"
+		return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+	}
+
+	teamGetter := team.NewGetter(h.db)
+	t, err := teamGetter.GetTeamByName(ctx, request.Team)
+	if err != nil {
+		if errors.Is(err, team.ErrNotExist) {
+			errors := "team doesn't exist"
+			return merch.PostAPITeamsTeamSendCoin404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	transferer := team.NewTransferer(h.db)
+	_, err = transferer.TransferByUsername(ctx, t.ID, toUsername, userID, amount)
+	if err != nil {
+		if errors.Is(err, team.ErrNotManager) {
+			errors := "not a team manager"
+			return merch.PostAPITeamsTeamSendCoin403JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, team.ErrDstUserNotFound) {
+			errors := "toUser doesn't exist"
+			return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, team.ErrDstUserDeactivated) {
+			errors := "toUser is deactivated"
+			return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, team.ErrDstUserNotMember) {
+			errors := "toUser is not a team member"
+			return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, team.ErrSrcUserAndDstUserEqual) {
+			errors := "fromUser and toUser are equal"
+			return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, coin.ErrNotEnough) {
+			errors := "not enough coin in team pool"
+			return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		var limitExceededErr *transfer.LimitExceededError
+		if errors.As(err, &limitExceededErr) {
+			errors := limitExceededMessage(limitExceededErr)
+			return merch.PostAPITeamsTeamSendCoin400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPITeamsTeamSendCoin200Response{}, nil
+}
+
+// GetAPITeamsTeamHistory implements merch.StrictServerInterface.
+func (h *Handler) GetAPITeamsTeamHistory(ctx context.Context, request merch.GetAPITeamsTeamHistoryRequestObject) (merch.GetAPITeamsTeamHistoryResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	teamGetter := team.NewGetter(h.db)
+	t, err := teamGetter.GetTeamByName(ctx, request.Team)
+	if err != nil {
+		if errors.Is(err, team.ErrNotExist) {
+			errors := "team doesn't exist"
+			return merch.GetAPITeamsTeamHistory404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	allowed, err := h.isTeamMemberOrAdmin(ctx, t.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		errors := "not a team member"
+		return merch.GetAPITeamsTeamHistory403JSONResponse{Errors: &errors}, nil
+	}
+
+	poolTransfers, err := teamGetter.GetPoolTransfers(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]merch.TeamHistoryItem, len(poolTransfers))
+	for i, pt := range poolTransfers {
+		kind := merch.TeamHistoryKind(pt.Kind)
+		history[i] = merch.TeamHistoryItem{
+			Kind:      &kind,
+			User:      &pt.Username,
+			ToUser:    pt.DstUsername,
+			Amount:    &pt.Amount,
+			CreatedAt: &pt.CreatedAt,
+		}
+	}
+
+	return merch.GetAPITeamsTeamHistory200JSONResponse{History: &history}, nil
+}
+
+func (h *Handler) isTeamMemberOrAdmin(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (bool, error) {
+	teamGetter := team.NewGetter(h.db)
+	_, err := teamGetter.GetMember(ctx, teamID, userID)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, team.ErrNotMember) {
+		return false, err
+	}
+	return h.isAdmin(ctx, userID)
+}
+
+func teamMemberResponse(m *team.Member) merch.TeamMember {
+	role := merch.TeamRole(m.Role)
+	return merch.TeamMember{
+		Username:    &m.Username,
+		DisplayName: nonEmptyStringOrNil(m.DisplayName),
+		Role:        &role,
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+// GetAPITransfersPending implements merch.StrictServerInterface.
+func (h *Handler) GetAPITransfersPending(ctx context.Context, request merch.GetAPITransfersPendingRequestObject) (merch.GetAPITransfersPendingResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	transferGetter := transfer.NewGetter(h.db)
+	transfers, err := transferGetter.GetPendingTransfersByApproverID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseTransfers := make([]merch.Transfer, len(transfers))
+	for i, t := range transfers {
+		responseTransfers[i] = transferResponse(t)
+	}
+
+	return merch.GetAPITransfersPending200JSONResponse{Transfers: &responseTransfers}, nil
+}
+
+// PostAPITransfersIDApprove implements merch.StrictServerInterface.
+func (h *Handler) PostAPITransfersIDApprove(ctx context.Context, request merch.PostAPITransfersIDApproveRequestObject) (merch.PostAPITransfersIDApproveResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	approver := transfer.NewApprover(h.db)
+	_, err := approver.Approve(ctx, request.ID, userID)
+	if err != nil {
+		if errors.Is(err, transfer.ErrNotExist) {
+			errors := "transfer doesn't exist"
+			return merch.PostAPITransfersIDApprove404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, transfer.ErrNotPending) {
+			errors := "transfer is not pending"
+			return merch.PostAPITransfersIDApprove400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, transfer.ErrNotApprover) {
+			errors := "not an approver of the transfer"
+			return merch.PostAPITransfersIDApprove403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	transferGetter := transfer.NewGetter(h.db)
+	t, err := transferGetter.GetTransfer(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PostAPITransfersIDApprove200JSONResponse(transferResponse(t)), nil
+}
+
+// PostAPITransfersIDReject implements merch.StrictServerInterface.
+func (h *Handler) PostAPITransfersIDReject(ctx context.Context, request merch.PostAPITransfersIDRejectRequestObject) (merch.PostAPITransfersIDRejectResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	approver := transfer.NewApprover(h.db)
+	_, err := approver.Reject(ctx, request.ID, userID)
+	if err != nil {
+		if errors.Is(err, transfer.ErrNotExist) {
+			errors := "transfer doesn't exist"
+			return merch.PostAPITransfersIDReject404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, transfer.ErrNotPending) {
+			errors := "transfer is not pending"
+			return merch.PostAPITransfersIDReject400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, transfer.ErrNotApprover) {
+			errors := "not an approver of the transfer"
+			return merch.PostAPITransfersIDReject403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	transferGetter := transfer.NewGetter(h.db)
+	t, err := transferGetter.GetTransfer(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return merch.PostAPITransfersIDReject200JSONResponse(transferResponse(t)), nil
+}
+
+func transferResponse(t *transfer.Transfer) merch.Transfer {
+	status := merch.TransferStatus(t.Status)
+	return merch.Transfer{
+		ID:        &t.ID,
+		FromUser:  &t.SrcUsername,
+		ToUser:    &t.DstUsername,
+		Amount:    &t.Amount,
+		Message:   nonEmptyStringOrNil(t.Message),
+		Status:    &status,
+		CreatedAt: &t.CreatedAt,
+		ExpiresAt: t.ExpiresAt,
+	}
+}
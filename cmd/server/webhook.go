@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/webhook"
+)
+
+const (
+	defaultWebhookDeadLetterLimit = 50
+	maxWebhookDeadLetterLimit     = 100
+)
+
+// GetAPIWebhooks implements merch.StrictServerInterface.
+func (h *Handler) GetAPIWebhooks(ctx context.Context, request merch.GetAPIWebhooksRequestObject) (merch.GetAPIWebhooksResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.GetAPIWebhooks403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	webhookGetter := webhook.NewGetter(h.db)
+	subscriptions, err := webhookGetter.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responseSubscriptions := make([]merch.WebhookSubscription, len(subscriptions))
+	for i, s := range subscriptions {
+		responseSubscriptions[i] = webhookSubscriptionResponse(s)
+	}
+
+	return merch.GetAPIWebhooks200JSONResponse{Subscriptions: &responseSubscriptions}, nil
+}
+
+// PostAPIWebhooks implements merch.StrictServerInterface.
+func (h *Handler) PostAPIWebhooks(ctx context.Context, request merch.PostAPIWebhooksRequestObject) (merch.PostAPIWebhooksResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	webhookURL, err := url.Parse(request.Body.URL)
+	if err != nil || !webhookURL.IsAbs() || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") {
+		errors := "url body value is not an absolute http or https URL"
+		return merch.PostAPIWebhooks400JSONResponse{Errors: &errors}, nil
+	}
+
+	if len(request.Body.EventKinds) == 0 {
+		errors := "empty eventKinds body value"
+		return merch.PostAPIWebhooks400JSONResponse{Errors: &errors}, nil
+	}
+	eventKinds := make([]app.EventKind, 0, len(request.Body.EventKinds))
+	for _, k := range request.Body.EventKinds {
+		kind := app.EventKind(k)
+		if !slices.Contains(webhook.Kinds, kind) {
+			errors := "invalid eventKinds body value"
+			return merch.PostAPIWebhooks400JSONResponse{Errors: &errors}, nil
+		}
+		if !slices.Contains(eventKinds, kind) {
+			eventKinds = append(eventKinds, kind)
+		}
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err = adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIWebhooks403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	// The host is resolved after the user is authorized, so others can't make the server resolve hosts.
+	err = webhook.CheckTargetHost(ctx, webhookURL.Hostname())
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.Is(err, webhook.ErrPrivateTarget) || errors.As(err, &dnsErr) {
+			errors := "url body value is not a public host"
+			return merch.PostAPIWebhooks400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	webhookCreator := webhook.NewCreator(h.db)
+	s, err := webhookCreator.CreateSubscription(ctx, &webhook.CreatorCreateSubscriptionParams{
+		URL:        webhookURL.String(),
+		EventKinds: eventKinds,
+		CreatedBy:  userID,
+		Secret:     valueOrZero(request.Body.Secret),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The secret is only returned once, admins keep it for checking signatures.
+	response := webhookSubscriptionResponse(s)
+	response.Secret = &s.Secret
+	return merch.PostAPIWebhooks200JSONResponse(response), nil
+}
+
+// DeleteAPIWebhooksID implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPIWebhooksID(ctx context.Context, request merch.DeleteAPIWebhooksIDRequestObject) (merch.DeleteAPIWebhooksIDResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.DeleteAPIWebhooksID403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	webhookDeleter := webhook.NewDeleter(h.db)
+	err = webhookDeleter.DeleteSubscription(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, webhook.ErrNotExist) {
+			errors := "webhook doesn't exist"
+			return merch.DeleteAPIWebhooksID404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPIWebhooksID200Response{}, nil
+}
+
+// GetAPIWebhooksDeadLetters implements merch.StrictServerInterface.
+func (h *Handler) GetAPIWebhooksDeadLetters(ctx context.Context, request merch.GetAPIWebhooksDeadLettersRequestObject) (merch.GetAPIWebhooksDeadLettersResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	limit := defaultWebhookDeadLetterLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	if limit <= 0 || limit > maxWebhookDeadLetterLimit {
+		errors := fmt.Sprintf("limit query value not between 1 and %d", maxWebhookDeadLetterLimit)
+		return merch.GetAPIWebhooksDeadLetters400JSONResponse{Errors: &errors}, nil
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.GetAPIWebhooksDeadLetters403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	webhookGetter := webhook.NewGetter(h.db)
+	deadLetters, err := webhookGetter.GetDeadLetters(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	responseDeadLetters := make([]merch.WebhookDeadLetter, len(deadLetters))
+	for i, d := range deadLetters {
+		eventKind := merch.WebhookEventKind(d.EventKind)
+		responseDeadLetters[i] = merch.WebhookDeadLetter{
+			Attempts:       &d.Attempts,
+			CreatedAt:      &d.CreatedAt,
+			EventID:        &d.EventID,
+			EventKind:      &eventKind,
+			ID:             &d.ID,
+			LastAttemptAt:  &d.LastAttemptAt,
+			LastError:      nonEmptyStringOrNil(d.LastError),
+			SubscriptionID: &d.SubscriptionID,
+			URL:            &d.URL,
+		}
+	}
+
+	return merch.GetAPIWebhooksDeadLetters200JSONResponse{DeadLetters: &responseDeadLetters}, nil
+}
+
+// PostAPIWebhooksDeliveriesIDRetry implements merch.StrictServerInterface.
+func (h *Handler) PostAPIWebhooksDeliveriesIDRetry(ctx context.Context, request merch.PostAPIWebhooksDeliveriesIDRetryRequestObject) (merch.PostAPIWebhooksDeliveriesIDRetryResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	adminAuthorizer := auth.NewAdminAuthorizer(h.db)
+	err := adminAuthorizer.AuthorizeAdmin(ctx, userID)
+	if err != nil {
+		if errors.Is(err, auth.ErrNotAdmin) {
+			errors := "not an admin"
+			return merch.PostAPIWebhooksDeliveriesIDRetry403JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	webhookRetrier := webhook.NewRetrier(h.db)
+	err = webhookRetrier.Retry(ctx, request.ID)
+	if err != nil {
+		if errors.Is(err, webhook.ErrNotExist) {
+			errors := "dead delivery doesn't exist"
+			return merch.PostAPIWebhooksDeliveriesIDRetry404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIWebhooksDeliveriesIDRetry200Response{}, nil
+}
+
+// webhookSubscriptionResponse returns the subscription without its secret.
+func webhookSubscriptionResponse(s *webhook.Subscription) merch.WebhookSubscription {
+	eventKinds := make([]merch.WebhookEventKind, len(s.EventKinds))
+	for i, k := range s.EventKinds {
+		eventKinds[i] = merch.WebhookEventKind(k)
+	}
+	return merch.WebhookSubscription{
+		CreatedAt:  &s.CreatedAt,
+		EventKinds: &eventKinds,
+		ID:         &s.ID,
+		URL:        &s.URL,
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/api/merch"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/wishlist"
+)
+
+// GetAPIWishlist implements merch.StrictServerInterface.
+func (h *Handler) GetAPIWishlist(ctx context.Context, request merch.GetAPIWishlistRequestObject) (merch.GetAPIWishlistResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	coinGetter := coin.NewGetter(h.db)
+	balance, err := coinGetter.GetBalance(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlistGetter := wishlist.NewGetter(h.db)
+	entries, err := wishlistGetter.GetEntriesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responseItems := make([]merch.WishlistItem, len(entries))
+	for i, e := range entries {
+		responseItems[i] = wishlistItemResponse(e, balance)
+	}
+
+	return merch.GetAPIWishlist200JSONResponse{Balance: &balance, Items: &responseItems}, nil
+}
+
+// PostAPIWishlist implements merch.StrictServerInterface.
+func (h *Handler) PostAPIWishlist(ctx context.Context, request merch.PostAPIWishlistRequestObject) (merch.PostAPIWishlistResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	itemName := request.Body.Item
+	if itemName == "" {
+		errors := "empty item body value"
+		return merch.PostAPIWishlist400JSONResponse{Errors: &errors}, nil
+	}
+
+	coinGetter := coin.NewGetter(h.db)
+	balance, err := coinGetter.GetBalance(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlistUpdater := wishlist.NewUpdater(h.db)
+	e, err := wishlistUpdater.AddItemByName(ctx, userID, itemName)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.PostAPIWishlist400JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, wishlist.ErrExist) {
+			errors := "item already in wishlist"
+			return merch.PostAPIWishlist400JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.PostAPIWishlist200JSONResponse(wishlistItemResponse(e, balance)), nil
+}
+
+// DeleteAPIWishlistItem implements merch.StrictServerInterface.
+func (h *Handler) DeleteAPIWishlistItem(ctx context.Context, request merch.DeleteAPIWishlistItemRequestObject) (merch.DeleteAPIWishlistItemResponseObject, error) {
+	userID, ok := ctx.Value(ContextValueUserID).(uuid.UUID)
+	if !ok {
+		panic(fmt.Errorf("can't get %s context value", ContextValueUserID))
+	}
+
+	wishlistUpdater := wishlist.NewUpdater(h.db)
+	err := wishlistUpdater.RemoveItemByName(ctx, userID, request.Item)
+	if err != nil {
+		if errors.Is(err, item.ErrNotExist) {
+			errors := "item does not exist"
+			return merch.DeleteAPIWishlistItem404JSONResponse{Errors: &errors}, nil
+		}
+		if errors.Is(err, wishlist.ErrNotExist) {
+			errors := "item not in wishlist"
+			return merch.DeleteAPIWishlistItem404JSONResponse{Errors: &errors}, nil
+		}
+		return nil, err
+	}
+
+	return merch.DeleteAPIWishlistItem200Response{}, nil
+}
+
+func wishlistItemResponse(e *wishlist.Entry, balance int) merch.WishlistItem {
+	missing := e.Missing(balance)
+	progress := e.Progress(balance)
+	return merch.WishlistItem{
+		Item:         &e.Item.Name,
+		Price:        &e.Price,
+		ListPrice:    &e.ListPrice,
+		InStock:      &e.InStock,
+		MissingCoins: &missing,
+		Progress:     &progress,
+		AddedAt:      &e.CreatedAt,
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/auction"
+	"github.com/k11v/merch/internal/outbox"
+	"github.com/k11v/merch/internal/paymentrequest"
+	"github.com/k11v/merch/internal/purchase"
+	"github.com/k11v/merch/internal/raffle"
+	"github.com/k11v/merch/internal/schedule"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/webhook"
+	"github.com/k11v/merch/internal/wishlist"
+)
+
+const (
+	transferExpirerInterval         = time.Minute
+	paymentRequestExpirerInterval   = time.Minute
+	scheduledTransferRunnerInterval = time.Minute
+	wishlistWatcherInterval         = time.Minute
+	reservationExpirerInterval      = time.Minute
+	auctionCloserInterval           = time.Minute
+	raffleDrawerInterval            = time.Minute
+	webhookDispatcherInterval       = 10 * time.Second
+	webhookDelivererInterval        = 10 * time.Second
+	outboxRelayInterval             = time.Second
+
+	webhookClientTimeout = 10 * time.Second
+)
+
+// startWorkers starts background workers that run until ctx is done.
+// Workers are safe to run on every server replica at once.
+// Committed outbox events are passed to events.
+func startWorkers(ctx context.Context, db *pgxpool.Pool, events app.EventHandler) {
+	go runPeriodically(ctx, "transfer expirer", transferExpirerInterval, func(ctx context.Context) error {
+		count, err := transfer.NewExpirer(db).ExpirePending(ctx)
+		if count > 0 {
+			slog.Info("expired pending transfers", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "payment request expirer", paymentRequestExpirerInterval, func(ctx context.Context) error {
+		count, err := paymentrequest.NewExpirer(db).ExpirePending(ctx)
+		if count > 0 {
+			slog.Info("expired payment requests", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "scheduled transfer runner", scheduledTransferRunnerInterval, func(ctx context.Context) error {
+		count, err := schedule.NewRunner(db).RunDue(ctx)
+		if count > 0 {
+			slog.Info("ran scheduled transfers", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "wishlist watcher", wishlistWatcherInterval, func(ctx context.Context) error {
+		count, err := wishlist.NewWatcher(db).Watch(ctx)
+		if count > 0 {
+			slog.Info("notified about wishlist items", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "reservation expirer", reservationExpirerInterval, func(ctx context.Context) error {
+		count, err := purchase.NewReservationExpirer(db).ExpireActive(ctx)
+		if count > 0 {
+			slog.Info("expired reservations", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "auction closer", auctionCloserInterval, func(ctx context.Context) error {
+		count, err := auction.NewCloser(db).CloseEnded(ctx)
+		if count > 0 {
+			slog.Info("closed auctions", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "raffle drawer", raffleDrawerInterval, func(ctx context.Context) error {
+		count, err := raffle.NewDrawer(db).DrawDue(ctx)
+		if count > 0 {
+			slog.Info("drew raffles", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "webhook dispatcher", webhookDispatcherInterval, func(ctx context.Context) error {
+		count, err := webhook.NewDispatcher(db).DispatchPending(ctx)
+		if count > 0 {
+			slog.Info("dispatched outbox events", "count", count)
+		}
+		return err
+	})
+	webhookClient := webhook.NewClient(webhookClientTimeout)
+	go runPeriodically(ctx, "webhook deliverer", webhookDelivererInterval, func(ctx context.Context) error {
+		count, err := webhook.NewDeliverer(db, webhookClient).DeliverDue(ctx)
+		if count > 0 {
+			slog.Info("attempted webhook deliveries", "count", count)
+		}
+		return err
+	})
+	go runPeriodically(ctx, "outbox relay", outboxRelayInterval, func(ctx context.Context) error {
+		_, err := outbox.NewRelay(db, events).RelayPending(ctx)
+		return err
+	})
+}
+
+// runPeriodically calls f every interval until ctx is done.
+// Errors are logged and don't stop the worker.
+func runPeriodically(ctx context.Context, name string, interval time.Duration, f func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := f(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("worker failed", "worker", name, "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/auth"
+)
+
+type SetupAdminParams struct {
+	PostgresURL string
+	Usernames   []string
+}
+
+// SetupAdmin grants admin rights to the users with the provided usernames.
+// The users must already exist.
+func SetupAdmin(params *SetupAdminParams) error {
+	ctx := context.Background()
+
+	db, err := app.NewPostgresPool(ctx, params.PostgresURL)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	adminAuthorizer := auth.NewAdminAuthorizer(db)
+	for _, username := range params.Usernames {
+		err = adminAuthorizer.GrantAdminByUsername(ctx, username)
+		if err != nil {
+			return fmt.Errorf("SetupAdmin: %s: %w", username, err)
+		}
+	}
+
+	return nil
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 var (
 	appFlag     = flag.Bool("app", false, "set up app, including postgres and jwt")
 	apptestFlag = flag.Bool("apptest", false, "set up apptest, including user and auth")
+	adminFlag   = flag.String("admin", "", "grant admin to comma-separated usernames")
 )
 
 func main() {
@@ -96,6 +98,17 @@ func main() {
 		}
 		doneSetups = append(doneSetups, "apptest")
 	}
+	if *adminFlag != "" {
+		err = SetupAdmin(&SetupAdminParams{
+			PostgresURL: postgresURL,
+			Usernames:   strings.Split(*adminFlag, ","),
+		})
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		doneSetups = append(doneSetups, "admin")
+	}
 	if len(doneSetups) == 0 {
 		_, _ = fmt.Fprint(os.Stderr, "error: no setups were specified\n")
 		os.Exit(1)
@@ -0,0 +1,72 @@
+package achievement
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Badge string
+
+const (
+	BadgeFirstTransfer Badge = "first_transfer" // sent coins for the first time
+	BadgeTenRecipients Badge = "ten_recipients" // sent coins to 10 different users
+	BadgeFirstGift     Badge = "first_gift"     // bought an item as a gift for the first time
+	BadgeAllItems      Badge = "all_items"      // bought every item for themselves
+)
+
+const EventBadgeAwarded app.EventKind = "achievement.badge_awarded" // the user was awarded a badge and its bonus
+
+// Award is a badge awarded to a user.
+type Award struct {
+	ID        uuid.UUID
+	AwardedAt time.Time
+	UserID    uuid.UUID
+	Badge     Badge
+	Bonus     int // coins granted with the badge
+}
+
+type Row struct {
+	ID        uuid.UUID `db:"id"`
+	AwardedAt time.Time `db:"awarded_at"`
+	UserID    uuid.UUID `db:"user_id"`
+	Badge     string    `db:"badge"`
+	Bonus     int       `db:"bonus"`
+}
+
+func RowToAward(collectable pgx.CollectableRow) (*Award, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Award{
+		ID:        collected.ID,
+		AwardedAt: collected.AwardedAt,
+		UserID:    collected.UserID,
+		Badge:     Badge(collected.Badge),
+		Bonus:     collected.Bonus,
+	}, nil
+}
+
+func awardEvents(a *Award) []*app.Event {
+	data := awardEventData{
+		ID:        a.ID,
+		AwardedAt: a.AwardedAt,
+		UserID:    a.UserID,
+		Badge:     a.Badge,
+		Bonus:     a.Bonus,
+	}
+	return []*app.Event{{Kind: EventBadgeAwarded, UserID: a.UserID, ID: a.ID, Data: data}}
+}
+
+type awardEventData struct {
+	ID        uuid.UUID `json:"id"`
+	AwardedAt time.Time `json:"awardedAt"`
+	UserID    uuid.UUID `json:"userId"`
+	Badge     Badge     `json:"badge"`
+	Bonus     int       `json:"bonus"`
+}
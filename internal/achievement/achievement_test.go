@@ -0,0 +1,111 @@
+package achievement
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/notification"
+	"github.com/k11v/merch/internal/outbox"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestAchievement(t *testing.T) {
+	t.Run("awards badges after transfers once with bonus", func(t *testing.T) {
+		var (
+			ctx   = context.Background()
+			db    = apptest.NewPostgresPool(t, ctx)
+			alice = usertest.CreateUser(t, ctx, db, "alice")
+			cg    = coin.NewGetter(db)
+			g     = NewGetter(db)
+			e     = NewEvaluator(db, DefaultRules())
+			r     = outbox.NewRelay(db, e)
+			tt    = transfer.NewTransferer(db)
+		)
+		for i := range 10 {
+			_ = usertest.CreateUser(t, ctx, db, fmt.Sprintf("user%d", i))
+		}
+
+		initialBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tt.TransferByUsername(ctx, "user0", alice.ID, 1)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = r.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		awards, err := g.GetAwardsByUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(awards), 1; got != want {
+			t.Fatalf("got %d awards, want %d", got, want)
+		}
+		if got, want := awards[0].Badge, BadgeFirstTransfer; got != want {
+			t.Errorf("got %s badge, want %s", got, want)
+		}
+
+		for i := range 10 {
+			_, err = tt.TransferByUsername(ctx, fmt.Sprintf("user%d", i), alice.ID, 1)
+			if err != nil {
+				t.Fatalf("got %v error", err)
+			}
+		}
+		_, err = r.RelayPending(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		awards, err = g.GetAwardsByUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(awards), 2; got != want {
+			t.Fatalf("got %d awards, want %d", got, want)
+		}
+		if got, want := awards[1].Badge, BadgeTenRecipients; got != want {
+			t.Errorf("got %s badge, want %s", got, want)
+		}
+
+		newAwards, err := e.Evaluate(ctx, alice.ID, transfer.EventSent)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(newAwards), 0; got != want {
+			t.Errorf("got %d new awards, want %d", got, want)
+		}
+
+		balance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance-11+DefaultRule(BadgeTenRecipients).Bonus; got != want {
+			t.Errorf("got %d balance, want %d", got, want)
+		}
+
+		notifications, err := notification.NewGetter(db).GetNotificationsByUserID(ctx, alice.ID, false)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(notifications), 2; got != want {
+			t.Errorf("got %d notifications, want %d", got, want)
+		}
+
+		var awardEventCount int
+		query := "SELECT count(*) FROM outbox_events WHERE kind = $1 AND user_id = $2"
+		err = db.QueryRow(ctx, query, string(EventBadgeAwarded), alice.ID).Scan(&awardEventCount)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := awardEventCount, 2; got != want {
+			t.Errorf("got %d award events, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package achievement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/notification"
+	"github.com/k11v/merch/internal/outbox"
+	"github.com/k11v/merch/internal/user"
+)
+
+var _ app.EventHandler = (*Evaluator)(nil)
+
+// Evaluator awards badges by rules when events happen.
+type Evaluator struct {
+	db    app.PgxExecutor
+	rules []*Rule
+}
+
+func NewEvaluator(db app.PgxExecutor, rules []*Rule) *Evaluator {
+	return &Evaluator{db: db, rules: rules}
+}
+
+// HandleEvent implements app.EventHandler.
+// The badges are awarded in tx, so they are committed with the event being marked handled.
+func (e *Evaluator) HandleEvent(ctx context.Context, tx app.PgxExecutor, event *app.Event) error {
+	_, err := NewEvaluator(tx, e.rules).Evaluate(ctx, event.UserID, event.Kind)
+	return err
+}
+
+// Evaluate awards the user the badges of the rules triggered by the event kind
+// whose conditions the user meets, and returns the new awards.
+// Badges are awarded at most once, so the same event can be evaluated again safely.
+func (e *Evaluator) Evaluate(ctx context.Context, userID uuid.UUID, kind app.EventKind) ([]*Award, error) {
+	awards, err := getAwardsByUserID(ctx, e.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("achievement.Evaluator: %w", err)
+	}
+	awarded := make(map[Badge]bool)
+	for _, a := range awards {
+		awarded[a.Badge] = true
+	}
+
+	newAwards := make([]*Award, 0)
+	for _, r := range e.rules {
+		if awarded[r.Badge] || !r.TriggeredBy(kind) {
+			continue
+		}
+
+		met, err := r.Met(ctx, e.db, userID)
+		if err != nil {
+			return nil, fmt.Errorf("achievement.Evaluator: %s rule: %w", r.Badge, err)
+		}
+		if !met {
+			continue
+		}
+
+		a, err := award(ctx, e.db, userID, r)
+		if err != nil {
+			return nil, fmt.Errorf("achievement.Evaluator: %s rule: %w", r.Badge, err)
+		}
+		if a != nil {
+			newAwards = append(newAwards, a)
+		}
+	}
+
+	return newAwards, nil
+}
+
+// award awards the badge of the rule to the user, grants the bonus, notifies the user
+// and writes the award event to the outbox.
+// It returns nil if the badge was awarded already, e.g. by a concurrent evaluation.
+func award(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, r *Rule) (*Award, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	// The user is locked before the award is created, the same way purchases lock the user first.
+	u, err := getUserForUpdate(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := createAward(ctx, tx, userID, r.Badge, r.Bonus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	message := fmt.Sprintf("you earned the %s badge", r.Title)
+	if r.Bonus > 0 {
+		err = updateUserBalance(ctx, tx, u.ID, u.Balance+r.Bonus)
+		if err != nil {
+			return nil, err
+		}
+		message = fmt.Sprintf("you earned the %s badge and %d bonus coins", r.Title, r.Bonus)
+	}
+
+	_, err = notification.NewCreator(tx).CreateNotification(ctx, &notification.CreatorCreateNotificationParams{
+		UserID:  userID,
+		Kind:    notification.KindBadgeAwarded,
+		Message: message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = outbox.NewWriter(tx).WriteEvents(ctx, awardEvents(a)...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// createAward returns pgx.ErrNoRows if the badge was awarded to the user already.
+func createAward(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, badge Badge, bonus int) (*Award, error) {
+	query := `
+		INSERT INTO user_badges (user_id, badge, bonus)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, badge) DO NOTHING
+		RETURNING id, awarded_at, user_id, badge, bonus
+	`
+	args := []any{userID, string(badge), bonus}
+
+	rows, _ := db.Query(ctx, query, args...)
+	return pgx.CollectExactlyOneRow(rows, RowToAward)
+}
+
+func getUserForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*user.User, error) {
+	query := `
+		SELECT id, username, password_hash, balance
+		FROM users
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	u, err := pgx.CollectExactlyOneRow(rows, user.RowToUser)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func updateUserBalance(ctx context.Context, db app.PgxExecutor, id uuid.UUID, balance int) error {
+	query := `
+		UPDATE users
+		SET balance = $2
+		WHERE id = $1
+	`
+	args := []any{id, balance}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package achievement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetAwardsByUserID returns the badges awarded to the user, oldest first.
+func (g *Getter) GetAwardsByUserID(ctx context.Context, userID uuid.UUID) ([]*Award, error) {
+	awards, err := getAwardsByUserID(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("achievement.Getter: %w", err)
+	}
+	return awards, nil
+}
+
+func getAwardsByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Award, error) {
+	query := `
+		SELECT id, awarded_at, user_id, badge, bonus
+		FROM user_badges
+		WHERE user_id = $1
+		ORDER BY awarded_at, id
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	awards, err := pgx.CollectRows(rows, RowToAward)
+	if err != nil {
+		return nil, err
+	}
+
+	return awards, nil
+}
@@ -0,0 +1,122 @@
+package achievement
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/purchase"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+// Rule awards a badge to users who meet its condition.
+// A rule is evaluated for the user of an event if the event is one of its triggers.
+type Rule struct {
+	Badge       Badge
+	Title       string
+	Description string
+	Bonus       int // coins granted with the badge, 0 for none
+	Triggers    []app.EventKind
+
+	// Met reports whether the user meets the condition of the rule.
+	Met func(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (bool, error)
+}
+
+func (r *Rule) TriggeredBy(kind app.EventKind) bool {
+	return slices.Contains(r.Triggers, kind)
+}
+
+// DefaultRules returns the rules the service awards badges by.
+func DefaultRules() []*Rule {
+	return []*Rule{
+		{
+			Badge:       BadgeFirstTransfer,
+			Title:       "First thanks",
+			Description: "Sent coins to a colleague for the first time.",
+			Triggers:    []app.EventKind{transfer.EventSent},
+			Met:         distinctRecipientsAtLeast(1),
+		},
+		{
+			Badge:       BadgeTenRecipients,
+			Title:       "Team player",
+			Description: "Sent coins to 10 different colleagues.",
+			Bonus:       50,
+			Triggers:    []app.EventKind{transfer.EventSent},
+			Met:         distinctRecipientsAtLeast(10),
+		},
+		{
+			Badge:       BadgeFirstGift,
+			Title:       "Gift giver",
+			Description: "Bought an item as a gift for a colleague for the first time.",
+			Triggers:    []app.EventKind{purchase.EventBought},
+			Met:         giftsSentAtLeast(1),
+		},
+		{
+			Badge:       BadgeAllItems,
+			Title:       "Collector",
+			Description: "Bought every item in the store.",
+			Bonus:       100,
+			Triggers:    []app.EventKind{purchase.EventBought},
+			Met:         boughtEveryItem,
+		},
+	}
+}
+
+// DefaultRule returns the default rule of the badge or nil if there is none.
+func DefaultRule(badge Badge) *Rule {
+	for _, r := range DefaultRules() {
+		if r.Badge == badge {
+			return r
+		}
+	}
+	return nil
+}
+
+func distinctRecipientsAtLeast(n int) func(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (bool, error) {
+	return func(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (bool, error) {
+		query := `
+			SELECT count(DISTINCT dst_user_id) >= $2
+			FROM transfers
+			WHERE src_user_id = $1 AND status = 'completed'
+		`
+		args := []any{userID, n}
+
+		rows, _ := db.Query(ctx, query, args...)
+		return pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	}
+}
+
+func giftsSentAtLeast(n int) func(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (bool, error) {
+	return func(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (bool, error) {
+		query := `
+			SELECT count(*) >= $2
+			FROM purchases
+			WHERE buyer_id = $1 AND user_id <> $1
+		`
+		args := []any{userID, n}
+
+		rows, _ := db.Query(ctx, query, args...)
+		return pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	}
+}
+
+func boughtEveryItem(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (bool, error) {
+	query := `
+		SELECT NOT EXISTS (
+			SELECT 1
+			FROM items i
+			WHERE NOT EXISTS (
+				SELECT 1
+				FROM purchases p
+				WHERE p.user_id = $1 AND p.buyer_id = $1 AND p.item_id = i.id
+			)
+		)
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	return pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+}
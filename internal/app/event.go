@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// EventKind names an event, e.g. transfer.received.
+// Kinds are declared by the packages that emit the events.
+type EventKind string
+
+// Event is a change that services write to the outbox in its transaction
+// and that is passed to event handlers once committed,
+// so other domains can react to it outside of its transaction.
+type Event struct {
+	Kind   EventKind
+	UserID uuid.UUID // user the event happened to
+	ID     uuid.UUID // ID of the changed entity, e.g. a transfer
+	Data   any       // JSON-encodable details of the change, nil if there are none
+}
+
+// EventHandler reacts to committed events.
+// tx is the transaction that marks the event handled, changes made with it are committed only if the event is handled,
+// so a handler that makes its changes with it doesn't repeat them when the event is handled again after a failure.
+type EventHandler interface {
+	HandleEvent(ctx context.Context, tx PgxExecutor, e *Event) error
+}
+
+// EventHandlers is an EventHandler that passes events to each of the handlers in order.
+type EventHandlers []EventHandler
+
+func (hs EventHandlers) HandleEvent(ctx context.Context, tx PgxExecutor, e *Event) error {
+	var errs []error
+	for _, h := range hs {
+		err := h.HandleEvent(ctx, tx, e)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
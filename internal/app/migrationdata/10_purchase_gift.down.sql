@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS purchases_buyer_id_idx;
+ALTER TABLE purchases DROP COLUMN IF EXISTS note;
+ALTER TABLE purchases DROP COLUMN IF EXISTS buyer_id;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- user_id is the owner of the purchased item, buyer_id is the user who paid for it.
+-- They differ when the item was bought as a gift.
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS buyer_id uuid REFERENCES users (id);
+UPDATE purchases SET buyer_id = user_id WHERE buyer_id IS NULL;
+ALTER TABLE purchases ALTER COLUMN buyer_id SET NOT NULL;
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS note text NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS purchases_buyer_id_idx ON purchases (buyer_id);
+
+COMMIT;
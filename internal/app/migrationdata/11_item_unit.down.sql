@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS item_unit_transfers_dst_user_id_idx;
+DROP INDEX IF EXISTS item_unit_transfers_src_user_id_idx;
+DROP INDEX IF EXISTS item_unit_transfers_item_unit_id_idx;
+DROP TABLE IF EXISTS item_unit_transfers;
+DROP INDEX IF EXISTS item_units_owner_id_item_id_idx;
+DROP INDEX IF EXISTS item_units_purchase_id_idx;
+DROP TABLE IF EXISTS item_units;
+
+COMMIT;
@@ -0,0 +1,38 @@
+BEGIN;
+
+-- item_units are owned items, one per purchase.
+-- Inventory is computed from them, so items can move between users after the purchase.
+CREATE TABLE IF NOT EXISTS item_units (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    item_id uuid NOT NULL,
+    owner_id uuid NOT NULL,
+    purchase_id uuid NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (item_id) REFERENCES items (id),
+    FOREIGN KEY (owner_id) REFERENCES users (id),
+    FOREIGN KEY (purchase_id) REFERENCES purchases (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS item_units_purchase_id_idx ON item_units (purchase_id);
+CREATE INDEX IF NOT EXISTS item_units_owner_id_item_id_idx ON item_units (owner_id, item_id);
+INSERT INTO item_units (created_at, item_id, owner_id, purchase_id)
+SELECT created_at, item_id, user_id, id
+FROM purchases
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS item_unit_transfers (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    item_unit_id uuid NOT NULL,
+    src_user_id uuid NOT NULL,
+    dst_user_id uuid NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (item_unit_id) REFERENCES item_units (id),
+    FOREIGN KEY (src_user_id) REFERENCES users (id),
+    FOREIGN KEY (dst_user_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS item_unit_transfers_item_unit_id_idx ON item_unit_transfers (item_unit_id);
+CREATE INDEX IF NOT EXISTS item_unit_transfers_src_user_id_idx ON item_unit_transfers (src_user_id);
+CREATE INDEX IF NOT EXISTS item_unit_transfers_dst_user_id_idx ON item_unit_transfers (dst_user_id);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE item_units DROP COLUMN IF EXISTS variant_id;
+ALTER TABLE purchases DROP COLUMN IF EXISTS variant_id;
+DROP INDEX IF EXISTS item_variants_item_id_size_color_idx;
+DROP TABLE IF EXISTS item_variants;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- item_variants are sizes, colors and such of an item.
+-- An item with variants can only be purchased by choosing one of them.
+CREATE TABLE IF NOT EXISTS item_variants (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    item_id uuid NOT NULL,
+    size text NOT NULL DEFAULT '',
+    color text NOT NULL DEFAULT '',
+    stock integer NOT NULL,
+    price integer, -- overrides the item price, null if the item price is used
+    PRIMARY KEY (id),
+    FOREIGN KEY (item_id) REFERENCES items (id),
+    CONSTRAINT item_variants_stock_ge_0 CHECK (stock >= 0),
+    CONSTRAINT item_variants_price_ge_0 CHECK (price >= 0)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS item_variants_item_id_size_color_idx ON item_variants (item_id, size, color);
+
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS variant_id uuid REFERENCES item_variants (id);
+ALTER TABLE item_units ADD COLUMN IF NOT EXISTS variant_id uuid REFERENCES item_variants (id);
+
+COMMIT;
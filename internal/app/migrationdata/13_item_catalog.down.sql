@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS items_category_idx;
+ALTER TABLE items DROP COLUMN IF EXISTS image_key;
+ALTER TABLE items DROP COLUMN IF EXISTS description;
+ALTER TABLE items DROP COLUMN IF EXISTS category;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE items ADD COLUMN IF NOT EXISTS category text NOT NULL DEFAULT '';
+ALTER TABLE items ADD COLUMN IF NOT EXISTS description text NOT NULL DEFAULT '';
+ALTER TABLE items ADD COLUMN IF NOT EXISTS image_key text NOT NULL DEFAULT ''; -- key in the image storage, empty if the item has no image
+CREATE INDEX IF NOT EXISTS items_category_idx ON items (category);
+
+UPDATE items SET category = 'clothing' WHERE name IN ('t-shirt', 'hoody', 'pink-hoody', 'socks') AND category = '';
+UPDATE items SET category = 'accessories' WHERE name IN ('cup', 'pen', 'powerbank', 'umbrella', 'wallet') AND category = '';
+UPDATE items SET category = 'books' WHERE name IN ('book') AND category = '';
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE purchases DROP COLUMN IF EXISTS campaign_id;
+ALTER TABLE purchases DROP COLUMN IF EXISTS list_price;
+DROP INDEX IF EXISTS campaigns_ends_at_idx;
+DROP TABLE IF EXISTS campaigns;
+
+COMMIT;
@@ -0,0 +1,29 @@
+BEGIN;
+
+-- campaigns are time-limited discounts on an item or on all items of a category.
+CREATE TABLE IF NOT EXISTS campaigns (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    name text NOT NULL,
+    kind text NOT NULL, -- percent or fixed
+    value integer NOT NULL, -- percent off or coins off
+    item_id uuid, -- null if the campaign is for a category
+    category text, -- null if the campaign is for an item
+    starts_at timestamp with time zone NOT NULL,
+    ends_at timestamp with time zone NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (item_id) REFERENCES items (id),
+    CONSTRAINT campaigns_kind_valid CHECK (kind IN ('percent', 'fixed')),
+    CONSTRAINT campaigns_value_valid CHECK (value > 0 AND (kind <> 'percent' OR value <= 100)),
+    CONSTRAINT campaigns_scope_valid CHECK ((item_id IS NULL) <> (category IS NULL)),
+    CONSTRAINT campaigns_ends_at_gt_starts_at CHECK (ends_at > starts_at)
+);
+CREATE INDEX IF NOT EXISTS campaigns_ends_at_idx ON campaigns (ends_at);
+
+-- list_price is the price before discounts, amount is the charged price.
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS list_price integer;
+UPDATE purchases SET list_price = amount WHERE list_price IS NULL;
+ALTER TABLE purchases ALTER COLUMN list_price SET NOT NULL;
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS campaign_id uuid REFERENCES campaigns (id);
+
+COMMIT;
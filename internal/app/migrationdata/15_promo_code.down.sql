@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE purchases DROP COLUMN IF EXISTS promo_code_id;
+DROP INDEX IF EXISTS promo_code_redemptions_user_id_idx;
+DROP INDEX IF EXISTS promo_code_redemptions_promo_code_id_user_id_idx;
+DROP TABLE IF EXISTS promo_code_redemptions;
+DROP INDEX IF EXISTS promo_codes_code_idx;
+DROP TABLE IF EXISTS promo_codes;
+ALTER TABLE users DROP COLUMN IF EXISTS created_at;
+
+COMMIT;
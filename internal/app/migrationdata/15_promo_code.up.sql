@@ -0,0 +1,45 @@
+BEGIN;
+
+-- created_at is unknown for users created before this migration and stays null for them.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS created_at timestamp with time zone;
+ALTER TABLE users ALTER COLUMN created_at SET DEFAULT now();
+
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    created_by uuid NOT NULL,
+    code text NOT NULL, -- upper case
+    kind text NOT NULL, -- coins, percent or fixed
+    value integer NOT NULL, -- coins credited, percent off or coins off
+    max_redemptions integer, -- null if unlimited
+    redemption_count integer NOT NULL DEFAULT 0,
+    new_user_days integer, -- if set, only users created within this many days can redeem
+    expires_at timestamp with time zone, -- null if the code doesn't expire
+    PRIMARY KEY (id),
+    FOREIGN KEY (created_by) REFERENCES users (id),
+    CONSTRAINT promo_codes_kind_valid CHECK (kind IN ('coins', 'percent', 'fixed')),
+    CONSTRAINT promo_codes_value_valid CHECK (value > 0 AND (kind <> 'percent' OR value <= 100)),
+    CONSTRAINT promo_codes_max_redemptions_gt_0 CHECK (max_redemptions > 0),
+    CONSTRAINT promo_codes_redemption_count_valid CHECK (redemption_count >= 0 AND redemption_count <= max_redemptions),
+    CONSTRAINT promo_codes_new_user_days_gt_0 CHECK (new_user_days > 0)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS promo_codes_code_idx ON promo_codes (code);
+
+-- promo_code_redemptions is the audit log of redeemed promo codes.
+CREATE TABLE IF NOT EXISTS promo_code_redemptions (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    promo_code_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    amount integer NOT NULL, -- coins credited or coins off the purchase
+    PRIMARY KEY (id),
+    FOREIGN KEY (promo_code_id) REFERENCES promo_codes (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT promo_code_redemptions_amount_ge_0 CHECK (amount >= 0)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS promo_code_redemptions_promo_code_id_user_id_idx ON promo_code_redemptions (promo_code_id, user_id);
+CREATE INDEX IF NOT EXISTS promo_code_redemptions_user_id_idx ON promo_code_redemptions (user_id);
+
+ALTER TABLE purchases ADD COLUMN IF NOT EXISTS promo_code_id uuid REFERENCES promo_codes (id);
+
+COMMIT;
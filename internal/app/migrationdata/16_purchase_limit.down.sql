@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS purchases_user_id_item_id_created_at_idx;
+DROP TABLE IF EXISTS purchase_limits;
+
+COMMIT;
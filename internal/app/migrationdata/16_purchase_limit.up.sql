@@ -0,0 +1,19 @@
+BEGIN;
+
+-- purchase_limits limits how many units of an item a user can get, bought by them or gifted to them.
+CREATE TABLE IF NOT EXISTS purchase_limits (
+    item_id uuid NOT NULL,
+    max_count integer NOT NULL,
+    period_days integer, -- null if the limit is for a lifetime
+    PRIMARY KEY (item_id),
+    FOREIGN KEY (item_id) REFERENCES items (id),
+    CONSTRAINT purchase_limits_max_count_gt_0 CHECK (max_count > 0),
+    CONSTRAINT purchase_limits_period_days_gt_0 CHECK (period_days > 0)
+);
+INSERT INTO purchase_limits (item_id, max_count)
+SELECT id, 1 FROM items WHERE name = 'pink-hoody'
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS purchases_user_id_item_id_created_at_idx ON purchases (user_id, item_id, created_at);
+
+COMMIT;
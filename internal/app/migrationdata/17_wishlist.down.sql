@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS wishlist_items_item_id_idx;
+DROP INDEX IF EXISTS wishlist_items_user_id_item_id_idx;
+DROP TABLE IF EXISTS wishlist_items;
+DROP INDEX IF EXISTS notifications_user_id_created_at_idx;
+DROP TABLE IF EXISTS notifications;
+
+COMMIT;
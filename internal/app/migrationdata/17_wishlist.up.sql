@@ -0,0 +1,34 @@
+BEGIN;
+
+-- notifications are in-app messages for a user.
+CREATE TABLE IF NOT EXISTS notifications (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    user_id uuid NOT NULL,
+    kind text NOT NULL, -- e.g. price_drop or back_in_stock
+    message text NOT NULL,
+    item_id uuid, -- null if the notification isn't about an item
+    read_at timestamp with time zone, -- null if unread
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (item_id) REFERENCES items (id)
+);
+CREATE INDEX IF NOT EXISTS notifications_user_id_created_at_idx ON notifications (user_id, created_at);
+
+-- wishlist_items remember the price and stock last seen for the user,
+-- so the user is notified once when the price drops or the item is back in stock.
+CREATE TABLE IF NOT EXISTS wishlist_items (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    user_id uuid NOT NULL,
+    item_id uuid NOT NULL,
+    last_price integer NOT NULL,
+    last_in_stock boolean NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (item_id) REFERENCES items (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS wishlist_items_user_id_item_id_idx ON wishlist_items (user_id, item_id);
+CREATE INDEX IF NOT EXISTS wishlist_items_item_id_idx ON wishlist_items (item_id);
+
+COMMIT;
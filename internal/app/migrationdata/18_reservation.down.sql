@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS reservations_active_expires_at_idx;
+DROP INDEX IF EXISTS reservations_user_id_idx;
+DROP TABLE IF EXISTS reservations;
+
+COMMIT;
@@ -0,0 +1,29 @@
+BEGIN;
+
+-- reservations hold a unit of stock and the coins for it until the user confirms the purchase.
+CREATE TABLE IF NOT EXISTS reservations (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    user_id uuid NOT NULL,
+    item_id uuid NOT NULL,
+    variant_id uuid, -- null for items without variants
+    list_price integer NOT NULL,
+    amount integer NOT NULL, -- held coins, charged when the reservation is purchased
+    campaign_id uuid,
+    status text NOT NULL DEFAULT 'active',
+    expires_at timestamp with time zone NOT NULL,
+    decided_at timestamp with time zone, -- null while active
+    purchase_id uuid, -- null unless purchased
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (item_id) REFERENCES items (id),
+    FOREIGN KEY (variant_id) REFERENCES item_variants (id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns (id),
+    FOREIGN KEY (purchase_id) REFERENCES purchases (id),
+    CONSTRAINT reservations_amount_ge_0 CHECK (amount >= 0),
+    CONSTRAINT reservations_status_valid CHECK (status IN ('active', 'purchased', 'cancelled', 'expired'))
+);
+CREATE INDEX IF NOT EXISTS reservations_user_id_idx ON reservations (user_id);
+CREATE INDEX IF NOT EXISTS reservations_active_expires_at_idx ON reservations (expires_at) WHERE status = 'active';
+
+COMMIT;
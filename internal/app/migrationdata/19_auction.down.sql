@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS auction_bids_held_auction_id_idx;
+DROP INDEX IF EXISTS auction_bids_auction_id_amount_idx;
+DROP TABLE IF EXISTS auction_bids;
+DROP INDEX IF EXISTS auctions_open_ends_at_idx;
+DROP TABLE IF EXISTS auctions;
+
+COMMIT;
@@ -0,0 +1,43 @@
+BEGIN;
+
+-- auctions sell a one-off item to the highest bidder.
+CREATE TABLE IF NOT EXISTS auctions (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    item_id uuid NOT NULL,
+    starting_price integer NOT NULL,
+    min_increment integer NOT NULL,
+    ends_at timestamp with time zone NOT NULL,
+    status text NOT NULL DEFAULT 'open',
+    created_by uuid NOT NULL,
+    closed_at timestamp with time zone, -- null while open
+    purchase_id uuid, -- null unless closed with a winner
+    PRIMARY KEY (id),
+    FOREIGN KEY (item_id) REFERENCES items (id),
+    FOREIGN KEY (created_by) REFERENCES users (id),
+    FOREIGN KEY (purchase_id) REFERENCES purchases (id),
+    CONSTRAINT auctions_starting_price_ge_0 CHECK (starting_price >= 0),
+    CONSTRAINT auctions_min_increment_gt_0 CHECK (min_increment > 0),
+    CONSTRAINT auctions_status_valid CHECK (status IN ('open', 'closed', 'cancelled'))
+);
+CREATE INDEX IF NOT EXISTS auctions_open_ends_at_idx ON auctions (ends_at) WHERE status = 'open';
+
+-- auction_bids hold the bid amount from the bidder's balance until the bid is outbid or wins.
+CREATE TABLE IF NOT EXISTS auction_bids (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    auction_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    amount integer NOT NULL,
+    status text NOT NULL DEFAULT 'held',
+    PRIMARY KEY (id),
+    FOREIGN KEY (auction_id) REFERENCES auctions (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT auction_bids_amount_gt_0 CHECK (amount > 0),
+    CONSTRAINT auction_bids_status_valid CHECK (status IN ('held', 'refunded', 'won'))
+);
+CREATE INDEX IF NOT EXISTS auction_bids_auction_id_amount_idx ON auction_bids (auction_id, amount);
+-- Only the highest bid of an auction holds coins.
+CREATE UNIQUE INDEX IF NOT EXISTS auction_bids_held_auction_id_idx ON auction_bids (auction_id) WHERE status = 'held';
+
+COMMIT;
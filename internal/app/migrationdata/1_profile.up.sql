@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS profiles (
+    user_id uuid NOT NULL,
+    display_name text NOT NULL DEFAULT '',
+    department text NOT NULL DEFAULT '',
+    title text NOT NULL DEFAULT '',
+    avatar_url text NOT NULL DEFAULT '',
+    start_date date,
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+COMMIT;
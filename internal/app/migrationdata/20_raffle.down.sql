@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS raffle_tickets_raffle_id_user_id_idx;
+DROP INDEX IF EXISTS raffle_tickets_raffle_id_number_idx;
+DROP TABLE IF EXISTS raffle_tickets;
+DROP INDEX IF EXISTS raffles_open_draws_at_idx;
+DROP TABLE IF EXISTS raffles;
+
+COMMIT;
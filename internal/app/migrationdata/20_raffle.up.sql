@@ -0,0 +1,49 @@
+BEGIN;
+
+-- raffles give away an item to the holders of randomly drawn tickets.
+-- seed_hash is published when the raffle is created and seed is revealed after the draw,
+-- so anyone can check that the winners follow from a seed chosen in advance.
+CREATE TABLE IF NOT EXISTS raffles (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    name text NOT NULL,
+    item_id uuid NOT NULL,
+    ticket_price integer NOT NULL,
+    winner_count integer NOT NULL,
+    max_tickets_per_user integer, -- null if unlimited
+    draws_at timestamp with time zone NOT NULL,
+    status text NOT NULL DEFAULT 'open',
+    seed text NOT NULL, -- hex, secret until drawn
+    seed_hash text NOT NULL, -- hex sha256 of the seed bytes
+    ticket_count integer NOT NULL DEFAULT 0,
+    drawn_at timestamp with time zone, -- null while open
+    created_by uuid NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (item_id) REFERENCES items (id),
+    FOREIGN KEY (created_by) REFERENCES users (id),
+    CONSTRAINT raffles_ticket_price_gt_0 CHECK (ticket_price > 0),
+    CONSTRAINT raffles_winner_count_gt_0 CHECK (winner_count > 0),
+    CONSTRAINT raffles_max_tickets_per_user_gt_0 CHECK (max_tickets_per_user > 0),
+    CONSTRAINT raffles_status_valid CHECK (status IN ('open', 'drawn'))
+);
+CREATE INDEX IF NOT EXISTS raffles_open_draws_at_idx ON raffles (draws_at) WHERE status = 'open';
+
+-- raffle_tickets are numbered from 0 in the order they were bought.
+-- purchase_id is set for winning tickets and refers to the prize.
+CREATE TABLE IF NOT EXISTS raffle_tickets (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    raffle_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    number integer NOT NULL,
+    amount integer NOT NULL,
+    purchase_id uuid, -- null unless won
+    PRIMARY KEY (id),
+    FOREIGN KEY (raffle_id) REFERENCES raffles (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (purchase_id) REFERENCES purchases (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS raffle_tickets_raffle_id_number_idx ON raffle_tickets (raffle_id, number);
+CREATE INDEX IF NOT EXISTS raffle_tickets_raffle_id_user_id_idx ON raffle_tickets (raffle_id, user_id);
+
+COMMIT;
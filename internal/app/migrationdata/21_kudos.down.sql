@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS kudos_settings;
+DROP INDEX IF EXISTS transfers_created_at_id_idx;
+CREATE INDEX IF NOT EXISTS transfers_created_at_id_idx ON transfers (created_at);
+ALTER TABLE transfers DROP COLUMN IF EXISTS message;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS message text NOT NULL DEFAULT '';
+
+-- transfers_created_at_id_idx was created on created_at only, add id so the kudos feed can be paginated by it.
+DROP INDEX IF EXISTS transfers_created_at_id_idx;
+CREATE INDEX IF NOT EXISTS transfers_created_at_id_idx ON transfers (created_at, id);
+
+-- kudos_settings holds users' consent to show their transfers in the public kudos feed.
+-- Users without settings are not shown.
+CREATE TABLE IF NOT EXISTS kudos_settings (
+    user_id uuid NOT NULL,
+    public boolean NOT NULL DEFAULT false, -- true if transfers with messages can be shown in the feed
+    show_amounts boolean NOT NULL DEFAULT false, -- true if transfer amounts can be shown in the feed
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+COMMIT;
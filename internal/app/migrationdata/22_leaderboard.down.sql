@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS purchases_created_at_idx;
+DROP INDEX IF EXISTS transfers_completed_created_at_idx;
+
+COMMIT;
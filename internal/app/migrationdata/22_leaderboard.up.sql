@@ -0,0 +1,10 @@
+BEGIN;
+
+-- Leaderboards aggregate completed transfers and purchases by period.
+-- The indexes include the aggregated columns so the aggregation can be an index-only scan.
+CREATE INDEX IF NOT EXISTS transfers_completed_created_at_idx ON transfers (created_at)
+INCLUDE (src_user_id, dst_user_id, amount)
+WHERE status = 'completed';
+CREATE INDEX IF NOT EXISTS purchases_created_at_idx ON purchases (created_at) INCLUDE (buyer_id, amount);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS user_badges_user_id_badge_idx;
+DROP TABLE IF EXISTS user_badges;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- user_badges are achievements awarded to users by the rules of the achievement package.
+-- Each badge is awarded to a user at most once.
+CREATE TABLE IF NOT EXISTS user_badges (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    awarded_at timestamp with time zone NOT NULL DEFAULT now(),
+    user_id uuid NOT NULL,
+    badge text NOT NULL,
+    bonus integer NOT NULL DEFAULT 0, -- coins granted with the badge
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT user_badges_bonus_ge_0 CHECK (bonus >= 0)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS user_badges_user_id_badge_idx ON user_badges (user_id, badge);
+
+COMMIT;
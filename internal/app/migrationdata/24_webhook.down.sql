@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP VIEW IF EXISTS webhook_dead_letters;
+DROP INDEX IF EXISTS webhook_deliveries_pending_next_attempt_at_idx;
+DROP INDEX IF EXISTS webhook_deliveries_subscription_id_event_id_idx;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
+DROP INDEX IF EXISTS outbox_events_undispatched_created_at_idx;
+DROP TABLE IF EXISTS outbox_events;
+
+COMMIT;
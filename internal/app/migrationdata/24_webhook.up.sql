@@ -0,0 +1,61 @@
+BEGIN;
+
+-- outbox_events are events written in the same transaction as the change they describe,
+-- so they exist for every committed change and only for committed changes.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    kind text NOT NULL, -- e.g. transfer.received
+    user_id uuid NOT NULL, -- user the event happened to
+    entity_id uuid NOT NULL, -- e.g. the transfer
+    data jsonb NOT NULL DEFAULT '{}',
+    dispatched_at timestamp with time zone, -- null until webhook deliveries are created for the event
+    PRIMARY KEY (id),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS outbox_events_undispatched_created_at_idx ON outbox_events (created_at) WHERE dispatched_at IS NULL;
+
+-- webhook_subscriptions are URLs that events of the kinds are delivered to.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    url text NOT NULL,
+    secret text NOT NULL, -- key of the HMAC-SHA256 payload signature
+    event_kinds text[] NOT NULL,
+    created_by uuid NOT NULL,
+    deleted_at timestamp with time zone, -- null while active
+    PRIMARY KEY (id),
+    FOREIGN KEY (created_by) REFERENCES users (id),
+    CONSTRAINT webhook_subscriptions_event_kinds_not_empty CHECK (cardinality(event_kinds) > 0)
+);
+
+-- webhook_deliveries are attempts to deliver an event to a subscription.
+-- Deliveries that failed too many times are dead and wait for an admin to retry them.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    subscription_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_error text NOT NULL DEFAULT '',
+    delivered_at timestamp with time zone, -- null unless delivered
+    PRIMARY KEY (id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions (id),
+    FOREIGN KEY (event_id) REFERENCES outbox_events (id),
+    CONSTRAINT webhook_deliveries_status_valid CHECK (status IN ('pending', 'delivered', 'dead'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS webhook_deliveries_subscription_id_event_id_idx ON webhook_deliveries (subscription_id, event_id);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_next_attempt_at_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- webhook_dead_letters are dead deliveries with their subscriptions and events.
+CREATE OR REPLACE VIEW webhook_dead_letters AS
+SELECT d.id, d.created_at, d.subscription_id, s.url, d.event_id, e.kind AS event_kind,
+       d.attempts, d.last_error, d.next_attempt_at AS last_attempt_at
+FROM webhook_deliveries d
+JOIN webhook_subscriptions s ON d.subscription_id = s.id
+JOIN outbox_events e ON d.event_id = e.id
+WHERE d.status = 'dead';
+
+COMMIT;
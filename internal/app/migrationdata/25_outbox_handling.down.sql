@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS outbox_events_unhandled_next_handle_at_idx;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS next_handle_at;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS handle_attempts;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS handled_at;
+
+COMMIT;
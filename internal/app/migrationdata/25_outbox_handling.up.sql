@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Outbox events are also passed to the event handlers of the service, e.g. achievements and the event stream.
+-- Events that were written before were handled by the requests that made them.
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS handled_at timestamp with time zone; -- null until handled or given up on
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS handle_attempts integer NOT NULL DEFAULT 0;
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS next_handle_at timestamp with time zone NOT NULL DEFAULT now();
+UPDATE outbox_events SET handled_at = created_at WHERE handled_at IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_events_unhandled_next_handle_at_idx ON outbox_events (next_handle_at) WHERE handled_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS team_id;
+
+COMMIT;
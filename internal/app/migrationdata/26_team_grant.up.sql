@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Coins sent from team coin pools are transfers too, so they show in coin history and count towards limits.
+-- The sender is the manager who sent them, their balance isn't debited.
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS team_id uuid REFERENCES teams (id); -- null unless sent from the team pool
+
+COMMIT;
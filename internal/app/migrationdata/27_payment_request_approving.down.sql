@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS payment_requests_approving_transfer_id_idx;
+UPDATE payment_requests SET status = 'accepted' WHERE status = 'approving';
+ALTER TABLE payment_requests
+    DROP CONSTRAINT IF EXISTS payment_requests_status_valid,
+    ADD CONSTRAINT payment_requests_status_valid CHECK (status IN ('pending', 'accepted', 'declined', 'expired'));
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE payment_requests
+    DROP CONSTRAINT IF EXISTS payment_requests_status_valid,
+    ADD CONSTRAINT payment_requests_status_valid CHECK (status IN ('pending', 'approving', 'accepted', 'declined', 'expired'));
+CREATE INDEX IF NOT EXISTS payment_requests_approving_transfer_id_idx ON payment_requests (transfer_id) WHERE status = 'approving';
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+UPDATE raffles SET status = 'drawn', drawn_at = now() WHERE status = 'cancelled';
+ALTER TABLE raffles
+    DROP CONSTRAINT IF EXISTS raffles_status_valid,
+    ADD CONSTRAINT raffles_status_valid CHECK (status IN ('open', 'drawn'));
+
+COMMIT;
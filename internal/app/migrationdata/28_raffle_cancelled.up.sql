@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE raffles
+    DROP CONSTRAINT IF EXISTS raffles_status_valid,
+    ADD CONSTRAINT raffles_status_valid CHECK (status IN ('open', 'drawn', 'cancelled'));
+
+COMMIT;
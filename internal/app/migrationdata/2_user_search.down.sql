@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS profiles_display_name_trgm_idx;
+DROP INDEX IF EXISTS users_username_trgm_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS deactivated_at;
+DROP EXTENSION IF EXISTS pg_trgm;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deactivated_at timestamp with time zone;
+
+CREATE INDEX IF NOT EXISTS users_username_trgm_idx ON users USING gin (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS profiles_display_name_trgm_idx ON profiles USING gin (display_name gin_trgm_ops);
+
+COMMIT;
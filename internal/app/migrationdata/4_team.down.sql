@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS team_transfers_team_id_created_at_idx;
+DROP TABLE IF EXISTS team_transfers;
+DROP INDEX IF EXISTS team_members_user_id_idx;
+DROP TABLE IF EXISTS team_members;
+DROP INDEX IF EXISTS teams_name_idx;
+DROP TABLE IF EXISTS teams;
+
+COMMIT;
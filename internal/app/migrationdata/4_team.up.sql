@@ -0,0 +1,41 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS teams (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    name text NOT NULL,
+    balance integer NOT NULL DEFAULT 0,
+    PRIMARY KEY (id),
+    CONSTRAINT teams_balance_ge_0 CHECK (balance >= 0)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS teams_name_idx ON teams (name);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    team_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    role text NOT NULL,
+    PRIMARY KEY (team_id, user_id),
+    FOREIGN KEY (team_id) REFERENCES teams (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT team_members_role_valid CHECK (role IN ('member', 'manager'))
+);
+CREATE INDEX IF NOT EXISTS team_members_user_id_idx ON team_members (user_id);
+
+CREATE TABLE IF NOT EXISTS team_transfers (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    team_id uuid NOT NULL,
+    kind text NOT NULL,
+    user_id uuid NOT NULL, -- admin who funded the pool or manager who sent coins from it
+    dst_user_id uuid, -- user who received coins from the pool, null for funding
+    amount integer NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (team_id) REFERENCES teams (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (dst_user_id) REFERENCES users (id),
+    CONSTRAINT team_transfers_kind_valid CHECK (kind IN ('fund', 'send')),
+    CONSTRAINT team_transfers_amount_ge_0 CHECK (amount >= 0)
+);
+CREATE INDEX IF NOT EXISTS team_transfers_team_id_created_at_idx ON team_transfers (team_id, created_at);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS from_budget;
+
+DROP TABLE IF EXISTS giving_budgets;
+
+COMMIT;
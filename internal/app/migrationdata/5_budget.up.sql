@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS giving_budgets (
+    user_id uuid NOT NULL,
+    allowance integer NOT NULL, -- in coins per period
+    period text NOT NULL,
+    period_start timestamp with time zone NOT NULL,
+    remaining integer NOT NULL, -- in coins, valid until the period ends
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT giving_budgets_allowance_ge_0 CHECK (allowance >= 0),
+    CONSTRAINT giving_budgets_period_valid CHECK (period IN ('week', 'month', 'quarter')),
+    CONSTRAINT giving_budgets_remaining_ge_0 CHECK (remaining >= 0)
+);
+
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS from_budget boolean NOT NULL DEFAULT false;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS transfers_src_user_id_created_at_idx;
+DROP TABLE IF EXISTS transfer_limits;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+-- transfer_limits has a single row with the transfer policy, 0 means no limit.
+CREATE TABLE IF NOT EXISTS transfer_limits (
+    id boolean NOT NULL DEFAULT true,
+    max_per_transfer integer NOT NULL DEFAULT 0, -- in coins
+    max_per_day integer NOT NULL DEFAULT 0, -- in coins sent during the last 24 hours
+    max_per_recipient integer NOT NULL DEFAULT 0, -- in coins sent to one recipient during the recipient period
+    recipient_period_days integer NOT NULL DEFAULT 30,
+    PRIMARY KEY (id),
+    CONSTRAINT transfer_limits_id_true CHECK (id),
+    CONSTRAINT transfer_limits_max_per_transfer_ge_0 CHECK (max_per_transfer >= 0),
+    CONSTRAINT transfer_limits_max_per_day_ge_0 CHECK (max_per_day >= 0),
+    CONSTRAINT transfer_limits_max_per_recipient_ge_0 CHECK (max_per_recipient >= 0),
+    CONSTRAINT transfer_limits_recipient_period_days_ge_1 CHECK (recipient_period_days >= 1)
+);
+INSERT INTO transfer_limits (id)
+VALUES (true)
+ON CONFLICT DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS transfers_src_user_id_created_at_idx ON transfers (src_user_id, created_at);
+
+COMMIT;
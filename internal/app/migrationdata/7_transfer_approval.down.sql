@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE transfer_limits
+    DROP COLUMN IF EXISTS approval_timeout_hours,
+    DROP COLUMN IF EXISTS approval_threshold;
+
+DROP INDEX IF EXISTS transfers_pending_expires_at_idx;
+ALTER TABLE transfers
+    DROP COLUMN IF EXISTS decided_by,
+    DROP COLUMN IF EXISTS decided_at,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS status;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE transfers
+    ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'completed',
+    ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone, -- when a pending transfer expires
+    ADD COLUMN IF NOT EXISTS decided_at timestamp with time zone,
+    ADD COLUMN IF NOT EXISTS decided_by uuid, -- approver who approved or rejected a pending transfer
+    ADD CONSTRAINT transfers_status_valid CHECK (status IN ('completed', 'pending', 'rejected', 'expired')),
+    ADD FOREIGN KEY (decided_by) REFERENCES users (id);
+CREATE INDEX IF NOT EXISTS transfers_pending_expires_at_idx ON transfers (expires_at) WHERE status = 'pending';
+
+ALTER TABLE transfer_limits
+    ADD COLUMN IF NOT EXISTS approval_threshold integer NOT NULL DEFAULT 0, -- in coins, transfers above it need approval
+    ADD COLUMN IF NOT EXISTS approval_timeout_hours integer NOT NULL DEFAULT 72,
+    ADD CONSTRAINT transfer_limits_approval_threshold_ge_0 CHECK (approval_threshold >= 0),
+    ADD CONSTRAINT transfer_limits_approval_timeout_hours_ge_1 CHECK (approval_timeout_hours >= 1);
+
+COMMIT;
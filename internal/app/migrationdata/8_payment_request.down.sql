@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS payment_requests_pending_expires_at_idx;
+DROP INDEX IF EXISTS payment_requests_requester_id_created_at_idx;
+DROP INDEX IF EXISTS payment_requests_payer_id_created_at_idx;
+DROP TABLE IF EXISTS payment_requests;
+
+COMMIT;
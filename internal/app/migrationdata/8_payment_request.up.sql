@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS payment_requests (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    requester_id uuid NOT NULL, -- user who asks for coins and receives them
+    payer_id uuid NOT NULL, -- user who is asked to pay
+    amount integer NOT NULL,
+    note text NOT NULL DEFAULT '',
+    status text NOT NULL DEFAULT 'pending',
+    expires_at timestamp with time zone NOT NULL,
+    decided_at timestamp with time zone,
+    transfer_id uuid, -- transfer made when the request was accepted
+    PRIMARY KEY (id),
+    FOREIGN KEY (requester_id) REFERENCES users (id),
+    FOREIGN KEY (payer_id) REFERENCES users (id),
+    FOREIGN KEY (transfer_id) REFERENCES transfers (id),
+    CONSTRAINT payment_requests_amount_gt_0 CHECK (amount > 0),
+    CONSTRAINT payment_requests_status_valid CHECK (status IN ('pending', 'accepted', 'declined', 'expired'))
+);
+CREATE INDEX IF NOT EXISTS payment_requests_payer_id_created_at_idx ON payment_requests (payer_id, created_at);
+CREATE INDEX IF NOT EXISTS payment_requests_requester_id_created_at_idx ON payment_requests (requester_id, created_at);
+CREATE INDEX IF NOT EXISTS payment_requests_pending_expires_at_idx ON payment_requests (expires_at) WHERE status = 'pending';
+
+COMMIT;
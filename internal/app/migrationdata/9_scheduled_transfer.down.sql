@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS scheduled_transfer_runs_scheduled_transfer_id_idx;
+DROP TABLE IF EXISTS scheduled_transfer_runs;
+DROP INDEX IF EXISTS scheduled_transfers_active_next_run_at_idx;
+DROP INDEX IF EXISTS scheduled_transfers_src_user_id_idx;
+DROP TABLE IF EXISTS scheduled_transfers;
+
+COMMIT;
@@ -0,0 +1,35 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS scheduled_transfers (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    src_user_id uuid NOT NULL,
+    dst_user_id uuid NOT NULL,
+    amount integer NOT NULL,
+    cron text, -- recurrence, null for one-shot transfers
+    status text NOT NULL DEFAULT 'active',
+    next_run_at timestamp with time zone, -- null unless active
+    last_run_at timestamp with time zone,
+    last_error text NOT NULL DEFAULT '',
+    PRIMARY KEY (id),
+    FOREIGN KEY (src_user_id) REFERENCES users (id),
+    FOREIGN KEY (dst_user_id) REFERENCES users (id),
+    CONSTRAINT scheduled_transfers_amount_gt_0 CHECK (amount > 0),
+    CONSTRAINT scheduled_transfers_status_valid CHECK (status IN ('active', 'completed', 'failed', 'cancelled'))
+);
+CREATE INDEX IF NOT EXISTS scheduled_transfers_src_user_id_idx ON scheduled_transfers (src_user_id);
+CREATE INDEX IF NOT EXISTS scheduled_transfers_active_next_run_at_idx ON scheduled_transfers (next_run_at) WHERE status = 'active';
+
+CREATE TABLE IF NOT EXISTS scheduled_transfer_runs (
+    id uuid NOT NULL DEFAULT uuid_generate_v4(),
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    scheduled_transfer_id uuid NOT NULL,
+    transfer_id uuid, -- null if the run failed
+    error text NOT NULL DEFAULT '',
+    PRIMARY KEY (id),
+    FOREIGN KEY (scheduled_transfer_id) REFERENCES scheduled_transfers (id),
+    FOREIGN KEY (transfer_id) REFERENCES transfers (id)
+);
+CREATE INDEX IF NOT EXISTS scheduled_transfer_runs_scheduled_transfer_id_idx ON scheduled_transfer_runs (scheduled_transfer_id);
+
+COMMIT;
@@ -0,0 +1,187 @@
+package auction
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrNotExist        = errors.New("does not exist")
+	ErrInvalidValue    = errors.New("invalid value")
+	ErrItemHasVariants = errors.New("item has variants")
+	ErrClosed          = errors.New("auction closed")
+	ErrBidTooLow       = errors.New("bid too low")
+)
+
+type Status string
+
+const (
+	StatusOpen      Status = "open"      // accepts bids until it ends
+	StatusClosed    Status = "closed"    // ended, the highest bid won if there was one
+	StatusCancelled Status = "cancelled" // cancelled by an admin or the item couldn't be sold, the bid was refunded
+)
+
+// Auction sells a one-off item to the highest bidder.
+type Auction struct {
+	ID            uuid.UUID
+	CreatedAt     time.Time
+	ItemID        uuid.UUID
+	StartingPrice int
+	MinIncrement  int
+	EndsAt        time.Time
+	Status        Status
+	CreatedBy     uuid.UUID
+	ClosedAt      *time.Time
+	PurchaseID    *uuid.UUID // nil unless closed with a winner
+
+	ItemName              string
+	HighestBid            int        // 0 if there are no bids
+	HighestBidderID       *uuid.UUID // nil if there are no bids
+	HighestBidderUsername string
+	BidCount              int
+}
+
+// MinBid returns the smallest amount the next bid can have.
+func (a *Auction) MinBid() int {
+	if a.HighestBidderID == nil {
+		return max(a.StartingPrice, 1)
+	}
+	return a.HighestBid + a.MinIncrement
+}
+
+type Row struct {
+	ID            uuid.UUID  `db:"id"`
+	CreatedAt     time.Time  `db:"created_at"`
+	ItemID        uuid.UUID  `db:"item_id"`
+	StartingPrice int        `db:"starting_price"`
+	MinIncrement  int        `db:"min_increment"`
+	EndsAt        time.Time  `db:"ends_at"`
+	Status        string     `db:"status"`
+	CreatedBy     uuid.UUID  `db:"created_by"`
+	ClosedAt      *time.Time `db:"closed_at"`
+	PurchaseID    *uuid.UUID `db:"purchase_id"`
+}
+
+func RowToAuction(collectable pgx.CollectableRow) (*Auction, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Auction{
+		ID:            collected.ID,
+		CreatedAt:     collected.CreatedAt,
+		ItemID:        collected.ItemID,
+		StartingPrice: collected.StartingPrice,
+		MinIncrement:  collected.MinIncrement,
+		EndsAt:        collected.EndsAt,
+		Status:        Status(collected.Status),
+		CreatedBy:     collected.CreatedBy,
+		ClosedAt:      collected.ClosedAt,
+		PurchaseID:    collected.PurchaseID,
+	}, nil
+}
+
+type RowWithHighestBid struct {
+	Row
+	ItemName              string     `db:"item_name"`
+	HighestBid            int        `db:"highest_bid"`
+	HighestBidderID       *uuid.UUID `db:"highest_bidder_id"`
+	HighestBidderUsername string     `db:"highest_bidder_username"`
+	BidCount              int        `db:"bid_count"`
+}
+
+func RowToAuctionWithHighestBid(collectable pgx.CollectableRow) (*Auction, error) {
+	collected, err := pgx.RowToStructByName[RowWithHighestBid](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Auction{
+		ID:                    collected.ID,
+		CreatedAt:             collected.CreatedAt,
+		ItemID:                collected.ItemID,
+		StartingPrice:         collected.StartingPrice,
+		MinIncrement:          collected.MinIncrement,
+		EndsAt:                collected.EndsAt,
+		Status:                Status(collected.Status),
+		CreatedBy:             collected.CreatedBy,
+		ClosedAt:              collected.ClosedAt,
+		PurchaseID:            collected.PurchaseID,
+		ItemName:              collected.ItemName,
+		HighestBid:            collected.HighestBid,
+		HighestBidderID:       collected.HighestBidderID,
+		HighestBidderUsername: collected.HighestBidderUsername,
+		BidCount:              collected.BidCount,
+	}, nil
+}
+
+type BidStatus string
+
+const (
+	BidStatusHeld     BidStatus = "held"     // the highest bid, its amount is held from the bidder's balance
+	BidStatusRefunded BidStatus = "refunded" // outbid or cancelled, its amount was returned
+	BidStatusWon      BidStatus = "won"      // won the auction, its amount was charged
+)
+
+// Bid is an offer to buy the auctioned item for the amount.
+type Bid struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	AuctionID uuid.UUID
+	UserID    uuid.UUID
+	Amount    int
+	Status    BidStatus
+
+	Username string
+}
+
+type BidRow struct {
+	ID        uuid.UUID `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	AuctionID uuid.UUID `db:"auction_id"`
+	UserID    uuid.UUID `db:"user_id"`
+	Amount    int       `db:"amount"`
+	Status    string    `db:"status"`
+}
+
+func RowToBid(collectable pgx.CollectableRow) (*Bid, error) {
+	collected, err := pgx.RowToStructByName[BidRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bid{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		AuctionID: collected.AuctionID,
+		UserID:    collected.UserID,
+		Amount:    collected.Amount,
+		Status:    BidStatus(collected.Status),
+	}, nil
+}
+
+type BidRowWithUsername struct {
+	BidRow
+	Username string `db:"username"`
+}
+
+func RowToBidWithUsername(collectable pgx.CollectableRow) (*Bid, error) {
+	collected, err := pgx.RowToStructByName[BidRowWithUsername](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bid{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		AuctionID: collected.AuctionID,
+		UserID:    collected.UserID,
+		Amount:    collected.Amount,
+		Status:    BidStatus(collected.Status),
+		Username:  collected.Username,
+	}, nil
+}
@@ -0,0 +1,297 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/inventory"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestAuction(t *testing.T) {
+	t.Run("computes min bids", func(t *testing.T) {
+		a := &Auction{StartingPrice: 0, MinIncrement: 5}
+
+		if got, want := a.MinBid(), 1; got != want {
+			t.Errorf("got %d min bid without bids, want %d", got, want)
+		}
+		bidderID := uuid.New()
+		a.HighestBid = 40
+		a.HighestBidderID = &bidderID
+		if got, want := a.MinBid(), 45; got != want {
+			t.Errorf("got %d min bid, want %d", got, want)
+		}
+	})
+	t.Run("refunds outbid users and closes with a purchase", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			ac  = NewCreator(db)
+			ab  = NewBidder(db)
+			acl = NewCloser(db)
+			ag  = NewGetter(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		initialBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		a, err := ac.CreateAuction(ctx, &CreatorCreateAuctionParams{
+			ItemName:      "book",
+			StartingPrice: 100,
+			MinIncrement:  10,
+			EndsAt:        time.Now().Add(time.Hour),
+			CreatedBy:     admin.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = ab.PlaceBid(ctx, a.ID, alice.ID, 90)
+		if got, want := err, ErrBidTooLow; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = ab.PlaceBid(ctx, a.ID, alice.ID, 100)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ab.PlaceBid(ctx, a.ID, bob.ID, 105)
+		if got, want := err, ErrBidTooLow; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = ab.PlaceBid(ctx, a.ID, bob.ID, 150)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ab.PlaceBid(ctx, a.ID, bob.ID, 200)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := aliceBalance, initialBalance; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+		if got, want := bobBalance, initialBalance-200; got != want {
+			t.Errorf("got %d bob balance, want %d", got, want)
+		}
+
+		_, err = db.Exec(ctx, "UPDATE auctions SET ends_at = now() WHERE id = $1", a.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ab.PlaceBid(ctx, a.ID, alice.ID, 300)
+		if got, want := err, ErrClosed; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		count, err := acl.CloseEnded(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d closed auctions, want %d", got, want)
+		}
+
+		closed, err := ag.GetAuction(ctx, a.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := closed.Status, StatusClosed; got != want {
+			t.Errorf("got %s status, want %s", got, want)
+		}
+		if got, want := closed.HighestBidderUsername, "bob"; got != want {
+			t.Errorf("got %s winner, want %s", got, want)
+		}
+		if closed.PurchaseID == nil {
+			t.Errorf("got nil purchase id")
+		}
+		itemCounts, err := inventory.NewGetter(db).GetItemCountsByOwnerID(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(itemCounts), 1; got != want {
+			t.Fatalf("got %d bob item counts, want %d", got, want)
+		}
+		if got, want := itemCounts[0].ItemName, "book"; got != want {
+			t.Errorf("got %s bob item, want %s", got, want)
+		}
+		bobBalance, err = cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := bobBalance, initialBalance-200; got != want {
+			t.Errorf("got %d bob balance after closing, want %d", got, want)
+		}
+	})
+	t.Run("refunds the highest bid when cancelled", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			ac  = NewCreator(db)
+			ab  = NewBidder(db)
+			acl = NewCloser(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+
+		initialBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		a, err := ac.CreateAuction(ctx, &CreatorCreateAuctionParams{
+			ItemName:      "book",
+			StartingPrice: 50,
+			MinIncrement:  1,
+			EndsAt:        time.Now().Add(time.Hour),
+			CreatedBy:     admin.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ab.PlaceBid(ctx, a.ID, alice.ID, initialBalance+1)
+		if got, want := err, coin.ErrNotEnough; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = ab.PlaceBid(ctx, a.ID, alice.ID, 50)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		err = acl.Cancel(ctx, a.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = acl.Cancel(ctx, a.ID)
+		if got, want := err, ErrClosed; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		balance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance; got != want {
+			t.Errorf("got %d balance, want %d", got, want)
+		}
+	})
+	t.Run("cancels ended auctions whose item got variants and closes later ones", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			ac  = NewCreator(db)
+			ab  = NewBidder(db)
+			acl = NewCloser(db)
+			ag  = NewGetter(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+
+		initialBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		unsellable, err := ac.CreateAuction(ctx, &CreatorCreateAuctionParams{
+			ItemName:      "book",
+			StartingPrice: 50,
+			MinIncrement:  1,
+			EndsAt:        time.Now().Add(time.Hour),
+			CreatedBy:     admin.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		sellable, err := ac.CreateAuction(ctx, &CreatorCreateAuctionParams{
+			ItemName:      "pen",
+			StartingPrice: 10,
+			MinIncrement:  1,
+			EndsAt:        time.Now().Add(2 * time.Hour),
+			CreatedBy:     admin.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ab.PlaceBid(ctx, unsellable.ID, alice.ID, 50)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ab.PlaceBid(ctx, sellable.ID, alice.ID, 10)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = item.NewVariantSetter(db).CreateVariant(ctx, &item.VariantSetterCreateVariantParams{
+			ItemID:   unsellable.ItemID,
+			Selector: item.VariantSelector{Size: "M"},
+			Stock:    1,
+		})
+		if got, want := err, item.ErrOnAuctionOrRaffle; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		// The variant is added as if it had been created before the check could see the auction.
+		_, err = db.Exec(
+			ctx,
+			"INSERT INTO item_variants (item_id, size, color, stock) VALUES ($1, 'M', '', 1)",
+			unsellable.ItemID,
+		)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = db.Exec(ctx, "UPDATE auctions SET ends_at = now() WHERE id IN ($1, $2)", unsellable.ID, sellable.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		count, err := acl.CloseEnded(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 2; got != want {
+			t.Errorf("got %d closed auctions, want %d", got, want)
+		}
+
+		cancelled, err := ag.GetAuction(ctx, unsellable.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := cancelled.Status, StatusCancelled; got != want {
+			t.Errorf("got %s unsellable auction status, want %s", got, want)
+		}
+		closed, err := ag.GetAuction(ctx, sellable.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := closed.Status, StatusClosed; got != want {
+			t.Errorf("got %s sellable auction status, want %s", got, want)
+		}
+		balance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance-10; got != want {
+			t.Errorf("got %d balance, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,251 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/notification"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Bidder struct {
+	db app.PgxExecutor
+}
+
+func NewBidder(db app.PgxExecutor) *Bidder {
+	return &Bidder{db: db}
+}
+
+// PlaceBid places a bid on the open auction and holds the amount from the user's balance.
+// The previous highest bid is refunded and its bidder is notified.
+// A user raising their own bid gets the previous amount back before the new one is held.
+func (b *Bidder) PlaceBid(ctx context.Context, auctionID uuid.UUID, userID uuid.UUID, amount int) (*Bid, error) {
+	tx, err := b.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Bidder: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	a, err := getAuctionForUpdate(ctx, tx, auctionID)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Bidder: %w", err)
+	}
+	if a.Status != StatusOpen || !time.Now().Before(a.EndsAt) {
+		return nil, fmt.Errorf("auction.Bidder: %w", ErrClosed)
+	}
+
+	held, err := getHeldBid(ctx, tx, a.ID)
+	if err != nil && !errors.Is(err, ErrNotExist) {
+		return nil, fmt.Errorf("auction.Bidder: %w", err)
+	}
+	if held != nil {
+		a.HighestBid = held.Amount
+		a.HighestBidderID = &held.UserID
+	}
+	if amount < a.MinBid() {
+		return nil, fmt.Errorf("auction.Bidder: %w", ErrBidTooLow)
+	}
+
+	userIDs := []uuid.UUID{userID}
+	if held != nil && held.UserID != userID {
+		userIDs = append(userIDs, held.UserID)
+	}
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, userIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Bidder: %w", err)
+	}
+
+	balance := usersMap[userID].Balance
+	if held != nil {
+		err = refundBid(ctx, tx, held, usersMap[held.UserID].Balance)
+		if err != nil {
+			return nil, fmt.Errorf("auction.Bidder: %w", err)
+		}
+		if held.UserID == userID {
+			balance += held.Amount
+		} else {
+			_, err = notification.NewCreator(tx).CreateNotification(ctx, &notification.CreatorCreateNotificationParams{
+				UserID:  held.UserID,
+				Kind:    notification.KindOutbid,
+				Message: fmt.Sprintf("your bid of %d coins was outbid, the coins were returned", held.Amount),
+				ItemID:  &a.ItemID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("auction.Bidder: %w", err)
+			}
+		}
+	}
+
+	balance -= amount
+	if balance < 0 {
+		return nil, fmt.Errorf("auction.Bidder: %w", coin.ErrNotEnough)
+	}
+
+	bid, err := createBid(ctx, tx, a.ID, userID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Bidder: %w", err)
+	}
+
+	_, err = updateUserBalance(ctx, tx, userID, balance)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Bidder: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Bidder: %w", err)
+	}
+	bid.Username = usersMap[userID].Username
+
+	return bid, nil
+}
+
+// refundBid returns the held amount to the bidder, whose balance is given after they are locked.
+func refundBid(ctx context.Context, db app.PgxExecutor, bid *Bid, balance int) error {
+	_, err := updateUserBalance(ctx, db, bid.UserID, balance+bid.Amount)
+	if err != nil {
+		return err
+	}
+	return updateBidStatus(ctx, db, bid.ID, BidStatusRefunded)
+}
+
+func getAuctionForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Auction, error) {
+	query := `
+		SELECT id, created_at, item_id, starting_price, min_increment, ends_at, status, created_by, closed_at, purchase_id
+		FROM auctions
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	a, err := pgx.CollectExactlyOneRow(rows, RowToAuction)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// getHeldBid returns the highest bid of the open auction.
+// It should be called after the auction is locked.
+func getHeldBid(ctx context.Context, db app.PgxExecutor, auctionID uuid.UUID) (*Bid, error) {
+	query := `
+		SELECT id, created_at, auction_id, user_id, amount, status
+		FROM auction_bids
+		WHERE auction_id = $1 AND status = 'held'
+	`
+	args := []any{auctionID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	bid, err := pgx.CollectExactlyOneRow(rows, RowToBid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return bid, nil
+}
+
+func createBid(ctx context.Context, db app.PgxExecutor, auctionID uuid.UUID, userID uuid.UUID, amount int) (*Bid, error) {
+	query := `
+		INSERT INTO auction_bids (auction_id, user_id, amount)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, auction_id, user_id, amount, status
+	`
+	args := []any{auctionID, userID, amount}
+
+	rows, _ := db.Query(ctx, query, args...)
+	bid, err := pgx.CollectExactlyOneRow(rows, RowToBid)
+	if err != nil {
+		return nil, err
+	}
+
+	return bid, nil
+}
+
+func updateBidStatus(ctx context.Context, db app.PgxExecutor, id uuid.UUID, status BidStatus) error {
+	query := `
+		UPDATE auction_bids
+		SET status = $2
+		WHERE id = $1
+	`
+	args := []any{id, string(status)}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func getUsersByIDsForUpdate(ctx context.Context, db app.PgxExecutor, ids ...uuid.UUID) (map[uuid.UUID]*user.User, error) {
+	query := `
+		SELECT id, username, password_hash, balance
+		FROM users
+		WHERE id = ANY($1)
+		ORDER BY id
+		FOR UPDATE
+	`
+	args := []any{ids}
+
+	rows, _ := db.Query(ctx, query, args...)
+	users, err := pgx.CollectRows(rows, user.RowToUser)
+	if err != nil {
+		return nil, err
+	}
+
+	usersMap := make(map[uuid.UUID]*user.User)
+	for _, u := range users {
+		usersMap[u.ID] = u
+	}
+
+	for _, id := range ids {
+		_, ok := usersMap[id]
+		if !ok {
+			return nil, user.ErrNotExist
+		}
+	}
+
+	return usersMap, nil
+}
+
+func updateUserBalance(ctx context.Context, db app.PgxExecutor, id uuid.UUID, balance int) (*user.User, error) {
+	query := `
+		UPDATE users
+		SET balance = $2
+		WHERE id = $1
+		RETURNING id, username, password_hash, balance
+	`
+	args := []any{id, balance}
+
+	rows, _ := db.Query(ctx, query, args...)
+	u, err := pgx.CollectExactlyOneRow(rows, user.RowToUser)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
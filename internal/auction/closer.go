@@ -0,0 +1,221 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/notification"
+	"github.com/k11v/merch/internal/purchase"
+)
+
+// Closer closes ended auctions and cancels open ones.
+type Closer struct {
+	db app.PgxExecutor
+}
+
+func NewCloser(db app.PgxExecutor) *Closer {
+	return &Closer{db: db}
+}
+
+// CloseEnded closes all ended open auctions.
+// The winner's held bid is charged: a purchase is recorded and the item is put into their inventory.
+// Purchase limits don't apply to auctions.
+// Auctions whose item can't be sold anymore, e.g. it got variants, are cancelled and their bids are refunded.
+// It returns the number of closed auctions.
+func (c *Closer) CloseEnded(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		closed, err := c.closeOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("auction.Closer: %w", err)
+		}
+		if !closed {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (c *Closer) closeOne(ctx context.Context) (bool, error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	a, err := getEndedOpenAuctionForUpdate(ctx, tx)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	held, err := getHeldBid(ctx, tx, a.ID)
+	if err != nil && !errors.Is(err, ErrNotExist) {
+		return false, err
+	}
+
+	var purchaseID *uuid.UUID
+	if held != nil {
+		p, err := purchase.NewRecorder(tx).Record(ctx, &purchase.RecorderRecordParams{
+			ItemID: a.ItemID,
+			UserID: held.UserID,
+			Amount: held.Amount,
+		})
+		if errors.Is(err, purchase.ErrVariantRequired) || errors.Is(err, purchase.ErrOutOfStock) {
+			slog.Warn("cancelled auction whose item can't be sold", "auction_id", a.ID, "err", err)
+			err = cancelAuction(ctx, tx, a, held)
+			if err != nil {
+				return false, err
+			}
+			err = tx.Commit(ctx)
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		purchaseID = &p.ID
+		err = updateBidStatus(ctx, tx, held.ID, BidStatusWon)
+		if err != nil {
+			return false, err
+		}
+		_, err = notification.NewCreator(tx).CreateNotification(ctx, &notification.CreatorCreateNotificationParams{
+			UserID:  held.UserID,
+			Kind:    notification.KindAuctionWon,
+			Message: fmt.Sprintf("you won an auction for %d coins, the item is in your inventory", held.Amount),
+			ItemID:  &a.ItemID,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = updateAuctionClosed(ctx, tx, a.ID, StatusClosed, purchaseID)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Cancel cancels the open auction and refunds its highest bid.
+func (c *Closer) Cancel(ctx context.Context, id uuid.UUID) error {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("auction.Closer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	a, err := getAuctionForUpdate(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("auction.Closer: %w", err)
+	}
+	if a.Status != StatusOpen {
+		return fmt.Errorf("auction.Closer: %w", ErrClosed)
+	}
+
+	held, err := getHeldBid(ctx, tx, a.ID)
+	if err != nil && !errors.Is(err, ErrNotExist) {
+		return fmt.Errorf("auction.Closer: %w", err)
+	}
+
+	err = cancelAuction(ctx, tx, a, held)
+	if err != nil {
+		return fmt.Errorf("auction.Closer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("auction.Closer: %w", err)
+	}
+
+	return nil
+}
+
+// cancelAuction cancels the locked auction and refunds its held bid, if any.
+func cancelAuction(ctx context.Context, db app.PgxExecutor, a *Auction, held *Bid) error {
+	if held != nil {
+		usersMap, err := getUsersByIDsForUpdate(ctx, db, held.UserID)
+		if err != nil {
+			return err
+		}
+		err = refundBid(ctx, db, held, usersMap[held.UserID].Balance)
+		if err != nil {
+			return err
+		}
+		_, err = notification.NewCreator(db).CreateNotification(ctx, &notification.CreatorCreateNotificationParams{
+			UserID:  held.UserID,
+			Kind:    notification.KindAuctionCancelled,
+			Message: fmt.Sprintf("an auction was cancelled, your bid of %d coins was returned", held.Amount),
+			ItemID:  &a.ItemID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateAuctionClosed(ctx, db, a.ID, StatusCancelled, nil)
+}
+
+func getEndedOpenAuctionForUpdate(ctx context.Context, db app.PgxExecutor) (*Auction, error) {
+	query := `
+		SELECT id, created_at, item_id, starting_price, min_increment, ends_at, status, created_by, closed_at, purchase_id
+		FROM auctions
+		WHERE status = 'open' AND ends_at <= now()
+		ORDER BY ends_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	a, err := pgx.CollectExactlyOneRow(rows, RowToAuction)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func updateAuctionClosed(ctx context.Context, db app.PgxExecutor, id uuid.UUID, status Status, purchaseID *uuid.UUID) error {
+	query := `
+		UPDATE auctions
+		SET status = $2, closed_at = now(), purchase_id = $3
+		WHERE id = $1
+	`
+	args := []any{id, string(status), purchaseID}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
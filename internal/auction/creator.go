@@ -0,0 +1,82 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+)
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreateAuctionParams struct {
+	ItemName      string
+	StartingPrice int
+	MinIncrement  int
+	EndsAt        time.Time
+	CreatedBy     uuid.UUID
+}
+
+// CreateAuction opens an auction on the item until EndsAt.
+// Only items without variants can be auctioned because there is no stock to take a unit from.
+func (c *Creator) CreateAuction(ctx context.Context, params *CreatorCreateAuctionParams) (*Auction, error) {
+	if params.StartingPrice < 0 || params.MinIncrement <= 0 || !params.EndsAt.After(time.Now()) {
+		return nil, fmt.Errorf("auction.Creator: %w", ErrInvalidValue)
+	}
+
+	i, err := item.NewGetter(c.db).GetItemByName(ctx, params.ItemName)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Creator: %w", err)
+	}
+	variants, err := item.NewGetter(c.db).GetVariantsByItemID(ctx, i.ID)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Creator: %w", err)
+	}
+	if len(variants) > 0 {
+		return nil, fmt.Errorf("auction.Creator: %w", ErrItemHasVariants)
+	}
+
+	a, err := createAuction(ctx, c.db, i.ID, params.StartingPrice, params.MinIncrement, params.EndsAt, params.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Creator: %w", err)
+	}
+	a.ItemName = i.Name
+
+	return a, nil
+}
+
+func createAuction(
+	ctx context.Context,
+	db app.PgxExecutor,
+	itemID uuid.UUID,
+	startingPrice int,
+	minIncrement int,
+	endsAt time.Time,
+	createdBy uuid.UUID,
+) (*Auction, error) {
+	query := `
+		INSERT INTO auctions (item_id, starting_price, min_increment, ends_at, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, item_id, starting_price, min_increment, ends_at, status, created_by, closed_at, purchase_id
+	`
+	args := []any{itemID, startingPrice, minIncrement, endsAt, createdBy}
+
+	rows, _ := db.Query(ctx, query, args...)
+	a, err := pgx.CollectExactlyOneRow(rows, RowToAuction)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
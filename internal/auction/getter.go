@@ -0,0 +1,123 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetOpenAuctions returns open auctions, ending soonest first.
+func (g *Getter) GetOpenAuctions(ctx context.Context) ([]*Auction, error) {
+	auctions, err := getOpenAuctions(ctx, g.db)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Getter: %w", err)
+	}
+	return auctions, nil
+}
+
+// GetAuction returns the auction with its highest bid,
+// which is the held bid while the auction is open and the won bid after it is closed.
+func (g *Getter) GetAuction(ctx context.Context, id uuid.UUID) (*Auction, error) {
+	a, err := getAuction(ctx, g.db, id)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Getter: %w", err)
+	}
+	return a, nil
+}
+
+// GetBidsByAuctionID returns the auction's bids, highest first.
+func (g *Getter) GetBidsByAuctionID(ctx context.Context, auctionID uuid.UUID) ([]*Bid, error) {
+	bids, err := getBidsByAuctionID(ctx, g.db, auctionID)
+	if err != nil {
+		return nil, fmt.Errorf("auction.Getter: %w", err)
+	}
+	return bids, nil
+}
+
+func getOpenAuctions(ctx context.Context, db app.PgxExecutor) ([]*Auction, error) {
+	query := `
+		SELECT a.id, a.created_at, a.item_id, a.starting_price, a.min_increment, a.ends_at, a.status, a.created_by,
+			   a.closed_at, a.purchase_id,
+			   i.name as item_name,
+			   coalesce(b.amount, 0) as highest_bid,
+			   b.user_id as highest_bidder_id,
+			   coalesce(u.username, '') as highest_bidder_username,
+			   (SELECT count(*) FROM auction_bids c WHERE c.auction_id = a.id) as bid_count
+		FROM auctions a
+		JOIN items i ON a.item_id = i.id
+		LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.status IN ('held', 'won')
+		LEFT JOIN users u ON b.user_id = u.id
+		WHERE a.status = 'open'
+		ORDER BY a.ends_at, a.id
+	`
+
+	rows, _ := db.Query(ctx, query)
+	auctions, err := pgx.CollectRows(rows, RowToAuctionWithHighestBid)
+	if err != nil {
+		return nil, err
+	}
+
+	return auctions, nil
+}
+
+func getAuction(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Auction, error) {
+	query := `
+		SELECT a.id, a.created_at, a.item_id, a.starting_price, a.min_increment, a.ends_at, a.status, a.created_by,
+			   a.closed_at, a.purchase_id,
+			   i.name as item_name,
+			   coalesce(b.amount, 0) as highest_bid,
+			   b.user_id as highest_bidder_id,
+			   coalesce(u.username, '') as highest_bidder_username,
+			   (SELECT count(*) FROM auction_bids c WHERE c.auction_id = a.id) as bid_count
+		FROM auctions a
+		JOIN items i ON a.item_id = i.id
+		LEFT JOIN auction_bids b ON b.auction_id = a.id AND b.status IN ('held', 'won')
+		LEFT JOIN users u ON b.user_id = u.id
+		WHERE a.id = $1
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	a, err := pgx.CollectExactlyOneRow(rows, RowToAuctionWithHighestBid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func getBidsByAuctionID(ctx context.Context, db app.PgxExecutor, auctionID uuid.UUID) ([]*Bid, error) {
+	query := `
+		SELECT b.id, b.created_at, b.auction_id, b.user_id, b.amount, b.status,
+			   u.username
+		FROM auction_bids b
+		JOIN users u ON b.user_id = u.id
+		WHERE b.auction_id = $1
+		ORDER BY b.amount DESC, b.created_at
+	`
+	args := []any{auctionID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	bids, err := pgx.CollectRows(rows, RowToBidWithUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	return bids, nil
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+var ErrDeactivated = errors.New("user is deactivated")
+
+// ActiveAuthorizer rejects deactivated users, whose tokens stay valid until they expire.
+type ActiveAuthorizer struct {
+	db app.PgxExecutor
+}
+
+func NewActiveAuthorizer(db app.PgxExecutor) *ActiveAuthorizer {
+	return &ActiveAuthorizer{db: db}
+}
+
+// AuthorizeActive returns [ErrDeactivated] if the user is deactivated or no longer exists.
+func (aa *ActiveAuthorizer) AuthorizeActive(ctx context.Context, userID uuid.UUID) error {
+	_, err := user.NewGetter(aa.db).GetActiveUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, user.ErrDeactivated) || errors.Is(err, user.ErrNotExist) {
+			return fmt.Errorf("auth.ActiveAuthorizer: %w", ErrDeactivated)
+		}
+		return fmt.Errorf("auth.ActiveAuthorizer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/user"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestActiveAuthorizer(t *testing.T) {
+	var (
+		ctx = context.Background()
+		db  = apptest.NewPostgresPool(t, ctx)
+	)
+
+	t.Run("authorizes active user", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			aa    = NewActiveAuthorizer(tx)
+		)
+
+		err := aa.AuthorizeActive(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+	})
+
+	t.Run("doesn't authorize deactivated user", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			aa    = NewActiveAuthorizer(tx)
+		)
+
+		err := user.NewDeactivator(tx).Deactivate(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		err = aa.AuthorizeActive(ctx, alice.ID)
+		if got, want := err, ErrDeactivated; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+}
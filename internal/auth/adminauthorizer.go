@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+var ErrNotAdmin = errors.New("not an admin")
+
+type AdminAuthorizer struct {
+	db app.PgxExecutor
+}
+
+func NewAdminAuthorizer(db app.PgxExecutor) *AdminAuthorizer {
+	return &AdminAuthorizer{db: db}
+}
+
+// AuthorizeAdmin returns [ErrNotAdmin] if the user is not an admin.
+func (aa *AdminAuthorizer) AuthorizeAdmin(ctx context.Context, userID uuid.UUID) error {
+	isAdmin, err := isAdmin(ctx, aa.db, userID)
+	if err != nil {
+		return fmt.Errorf("auth.AdminAuthorizer: %w", err)
+	}
+	if !isAdmin {
+		return fmt.Errorf("auth.AdminAuthorizer: %w", ErrNotAdmin)
+	}
+	return nil
+}
+
+// GrantAdminByUsername makes the user an admin.
+// It is idempotent.
+func (aa *AdminAuthorizer) GrantAdminByUsername(ctx context.Context, username string) error {
+	u, err := user.NewGetter(aa.db).GetUserByUsername(ctx, username)
+	if err != nil {
+		return fmt.Errorf("auth.AdminAuthorizer: %w", err)
+	}
+	err = createAdmin(ctx, aa.db, u.ID)
+	if err != nil {
+		return fmt.Errorf("auth.AdminAuthorizer: %w", err)
+	}
+	return nil
+}
+
+func isAdmin(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM admins WHERE user_id = $1)
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	exists, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func createAdmin(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) error {
+	query := `
+		INSERT INTO admins (user_id)
+		VALUES ($1)
+		ON CONFLICT DO NOTHING
+	`
+	args := []any{userID}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
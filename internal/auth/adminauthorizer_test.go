@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestAdminAuthorizer(t *testing.T) {
+	var (
+		ctx = context.Background()
+		db  = apptest.NewPostgresPool(t, ctx)
+	)
+
+	t.Run("doesn't authorize regular user", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			aa    = NewAdminAuthorizer(tx)
+		)
+
+		err := aa.AuthorizeAdmin(ctx, alice.ID)
+		if got, want := err, ErrNotAdmin; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+
+	t.Run("authorizes granted admin", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			aa    = NewAdminAuthorizer(tx)
+		)
+
+		err := aa.GrantAdminByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = aa.GrantAdminByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		err = aa.AuthorizeAdmin(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+	})
+}
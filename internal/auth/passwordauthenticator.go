@@ -34,6 +34,9 @@ func NewPasswordAuthenticator(db app.PgxExecutor, passwordHasher *user.PasswordH
 	return &PasswordAuthenticator{db: db, ph: passwordHasher}
 }
 
+// AuthenticatePassword authenticates the user, creating them if the username is new.
+// It returns [ErrDeactivated] if the password matches but the user is deactivated,
+// the username isn't reused for a new user then.
 func (pa *PasswordAuthenticator) AuthenticatePassword(ctx context.Context, username, password string) (*Data, error) {
 	u, err := user.NewGetter(pa.db).GetUserByUsername(ctx, username)
 	switch {
@@ -45,6 +48,10 @@ func (pa *PasswordAuthenticator) AuthenticatePassword(ctx context.Context, usern
 			}
 			return nil, fmt.Errorf("auth.PasswordAuthenticator: %w", err)
 		}
+		err = NewActiveAuthorizer(pa.db).AuthorizeActive(ctx, u.ID)
+		if err != nil {
+			return nil, fmt.Errorf("auth.PasswordAuthenticator: %w", err)
+		}
 	case errors.Is(err, user.ErrNotExist):
 		u, err = user.NewCreator(pa.db, pa.ph).CreateUser(ctx, username, password)
 		if errors.Is(err, user.ErrExist) {
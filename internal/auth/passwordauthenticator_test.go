@@ -78,4 +78,29 @@ func TestPasswordAuthenticator(t *testing.T) {
 			t.Fatalf("got %v error, want %v", got, want)
 		}
 	})
+	t.Run("doesn't get deactivated user", func(t *testing.T) {
+		var (
+			tx = apptest.BeginPostgresTx(t, ctx, db)
+			ph = user.NewPasswordHasher(user.DefaultArgon2IDParams())
+			pa = NewPasswordAuthenticator(tx, ph)
+		)
+
+		_, err := pa.AuthenticatePassword(ctx, "alice", "alice123")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = user.NewDeactivator(tx).Deactivate(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = pa.AuthenticatePassword(ctx, "alice", "bob123")
+		if got, want := err, ErrInvalidUsernameOrPassword; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = pa.AuthenticatePassword(ctx, "alice", "alice123")
+		if got, want := err, ErrDeactivated; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
 }
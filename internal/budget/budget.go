@@ -0,0 +1,106 @@
+package budget
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrNotExist = errors.New("does not exist")
+
+type Period string
+
+const (
+	PeriodWeek    Period = "week"
+	PeriodMonth   Period = "month"
+	PeriodQuarter Period = "quarter"
+)
+
+func (p Period) Valid() bool {
+	return p == PeriodWeek || p == PeriodMonth || p == PeriodQuarter
+}
+
+// Start returns the start of the period that contains t.
+// Periods are aligned to UTC calendar boundaries, weeks start on Monday.
+func (p Period) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch p {
+	case PeriodWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case PeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case PeriodQuarter:
+		quarterMonth := time.Month((int(t.Month())-1)/3*3 + 1)
+		return time.Date(t.Year(), quarterMonth, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		panic("unknown period " + string(p))
+	}
+}
+
+// End returns the end of the period that contains t, which is the start of the next one.
+func (p Period) End(t time.Time) time.Time {
+	start := p.Start(t)
+	switch p {
+	case PeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case PeriodMonth:
+		return start.AddDate(0, 1, 0)
+	case PeriodQuarter:
+		return start.AddDate(0, 3, 0)
+	default:
+		panic("unknown period " + string(p))
+	}
+}
+
+// Budget is a giving budget of a user, usually a manager.
+// It can only be sent to other users and can't be spent on purchases.
+// Unspent coins don't carry over: the budget resets to its allowance each period.
+type Budget struct {
+	UserID      uuid.UUID
+	Allowance   int
+	Period      Period
+	PeriodStart time.Time
+	Remaining   int
+}
+
+// At returns the budget as it is at t.
+// If t is in a later period than the stored one, the remaining coins are reset to the allowance.
+func (b *Budget) At(t time.Time) *Budget {
+	periodStart := b.Period.Start(t)
+	if !periodStart.After(b.PeriodStart) {
+		return b
+	}
+	return &Budget{
+		UserID:      b.UserID,
+		Allowance:   b.Allowance,
+		Period:      b.Period,
+		PeriodStart: periodStart,
+		Remaining:   b.Allowance,
+	}
+}
+
+type Row struct {
+	UserID      uuid.UUID `db:"user_id"`
+	Allowance   int       `db:"allowance"`
+	Period      string    `db:"period"`
+	PeriodStart time.Time `db:"period_start"`
+	Remaining   int       `db:"remaining"`
+}
+
+func RowToBudget(collectable pgx.CollectableRow) (*Budget, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Budget{
+		UserID:      collected.UserID,
+		Allowance:   collected.Allowance,
+		Period:      Period(collected.Period),
+		PeriodStart: collected.PeriodStart,
+		Remaining:   collected.Remaining,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		period    Period
+		t         time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "week starts on monday",
+			period:    PeriodWeek,
+			t:         time.Date(2024, 5, 19, 23, 59, 0, 0, time.UTC), // Sunday
+			wantStart: time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "month",
+			period:    PeriodMonth,
+			t:         time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "quarter",
+			period:    PeriodQuarter,
+			t:         time.Date(2024, 8, 15, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "uses UTC",
+			period:    PeriodMonth,
+			t:         time.Date(2024, 3, 1, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)),
+			wantStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, want := tt.period.Start(tt.t), tt.wantStart; !got.Equal(want) {
+				t.Errorf("got %v start, want %v", got, want)
+			}
+			if got, want := tt.period.End(tt.t), tt.wantEnd; !got.Equal(want) {
+				t.Errorf("got %v end, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBudget(t *testing.T) {
+	t.Run("resets in a later period", func(t *testing.T) {
+		b := &Budget{
+			Allowance:   100,
+			Period:      PeriodMonth,
+			PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Remaining:   10,
+		}
+
+		if got, want := b.At(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)).Remaining, 10; got != want {
+			t.Errorf("got %d remaining in the same period, want %d", got, want)
+		}
+		if got, want := b.At(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)).Remaining, 100; got != want {
+			t.Errorf("got %d remaining in a later period, want %d", got, want)
+		}
+	})
+
+	t.Run("sets and gets budget", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			tx  = apptest.BeginPostgresTx(t, ctx, db)
+			bg  = NewGetter(tx)
+			bs  = NewSetter(tx)
+		)
+		alice := usertest.CreateUser(t, ctx, tx, "alice")
+		usertest.CreateUser(t, ctx, tx, "bob")
+
+		_, err := bg.GetBudget(ctx, alice.ID)
+		if got, want := err, ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		_, err = bs.SetBudgetByUsername(ctx, "alice", 100, PeriodMonth)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		b, err := bg.GetBudget(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := b.Allowance, 100; got != want {
+			t.Errorf("got %d allowance, want %d", got, want)
+		}
+		if got, want := b.Remaining, 100; got != want {
+			t.Errorf("got %d remaining, want %d", got, want)
+		}
+		if got, want := b.Period, PeriodMonth; got != want {
+			t.Errorf("got %s period, want %s", got, want)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetBudget returns the user's giving budget for the current period.
+// It returns [ErrNotExist] if the user has no giving budget.
+func (g *Getter) GetBudget(ctx context.Context, userID uuid.UUID) (*Budget, error) {
+	b, err := getBudget(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("budget.Getter: %w", err)
+	}
+	return b.At(time.Now()), nil
+}
+
+func getBudget(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (*Budget, error) {
+	query := `
+		SELECT user_id, allowance, period, period_start, remaining
+		FROM giving_budgets
+		WHERE user_id = $1
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	b, err := pgx.CollectExactlyOneRow(rows, RowToBudget)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
@@ -0,0 +1,124 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+// Setter assigns giving budgets to users.
+// It should only be used on behalf of admins.
+type Setter struct {
+	db app.PgxExecutor
+}
+
+func NewSetter(db app.PgxExecutor) *Setter {
+	return &Setter{db: db}
+}
+
+// SetBudgetByUsername sets the allowance and the period of the user's giving budget.
+// Coins already given in the current period are subtracted from the new allowance.
+func (s *Setter) SetBudgetByUsername(ctx context.Context, username string, allowance int, period Period) (*Budget, error) {
+	u, err := user.NewGetter(s.db).GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("budget.Setter: %w", err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("budget.Setter: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	now := time.Now()
+	periodStart := period.Start(now)
+
+	given := 0
+	oldBudget, err := getBudgetForUpdate(ctx, tx, u.ID)
+	if err != nil && !errors.Is(err, ErrNotExist) {
+		return nil, fmt.Errorf("budget.Setter: %w", err)
+	}
+	if err == nil {
+		oldBudget = oldBudget.At(now)
+		if oldBudget.PeriodStart.Equal(periodStart) {
+			given = oldBudget.Allowance - oldBudget.Remaining
+		}
+	}
+	remaining := max(allowance-given, 0)
+
+	b, err := upsertBudget(ctx, tx, u.ID, allowance, period, periodStart, remaining)
+	if err != nil {
+		return nil, fmt.Errorf("budget.Setter: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("budget.Setter: %w", err)
+	}
+
+	return b, nil
+}
+
+func getBudgetForUpdate(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (*Budget, error) {
+	query := `
+		SELECT user_id, allowance, period, period_start, remaining
+		FROM giving_budgets
+		WHERE user_id = $1
+		FOR UPDATE
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	b, err := pgx.CollectExactlyOneRow(rows, RowToBudget)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func upsertBudget(
+	ctx context.Context,
+	db app.PgxExecutor,
+	userID uuid.UUID,
+	allowance int,
+	period Period,
+	periodStart time.Time,
+	remaining int,
+) (*Budget, error) {
+	query := `
+		INSERT INTO giving_budgets (user_id, allowance, period, period_start, remaining)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET allowance = excluded.allowance,
+			period = excluded.period,
+			period_start = excluded.period_start,
+			remaining = excluded.remaining
+		RETURNING user_id, allowance, period, period_start, remaining
+	`
+	args := []any{userID, allowance, string(period), periodStart, remaining}
+
+	rows, _ := db.Query(ctx, query, args...)
+	b, err := pgx.CollectExactlyOneRow(rows, RowToBudget)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
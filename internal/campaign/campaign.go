@@ -0,0 +1,145 @@
+package campaign
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrNotExist     = errors.New("does not exist")
+	ErrInvalidValue = errors.New("invalid value")
+	ErrEnded        = errors.New("already ended")
+)
+
+type Kind string
+
+const (
+	KindPercent Kind = "percent" // value is percent off the list price
+	KindFixed   Kind = "fixed"   // value is coins off the list price
+)
+
+func (k Kind) Valid() bool {
+	switch k {
+	case KindPercent, KindFixed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Campaign is a time-limited discount on an item or on all items of a category.
+type Campaign struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	Name      string
+	Kind      Kind
+	Value     int
+	ItemID    *uuid.UUID // nil if the campaign is for a category
+	Category  *string    // nil if the campaign is for an item
+	StartsAt  time.Time
+	EndsAt    time.Time
+
+	ItemName string
+}
+
+// Price returns the discounted listPrice.
+// Percent discounts are rounded down, so the price is rounded up.
+func (c *Campaign) Price(listPrice int) int {
+	var price int
+	switch c.Kind {
+	case KindPercent:
+		price = listPrice - listPrice*c.Value/100
+	case KindFixed:
+		price = listPrice - c.Value
+	default:
+		price = listPrice
+	}
+	return max(price, 0)
+}
+
+// ActiveAt reports whether the campaign is active at t.
+func (c *Campaign) ActiveAt(t time.Time) bool {
+	return !t.Before(c.StartsAt) && t.Before(c.EndsAt)
+}
+
+// AppliesTo reports whether the campaign applies to the item.
+func (c *Campaign) AppliesTo(itemID uuid.UUID, category string) bool {
+	if c.ItemID != nil {
+		return *c.ItemID == itemID
+	}
+	return c.Category != nil && *c.Category == category
+}
+
+// BestPrice returns the lowest price of listPrice among the campaigns and the campaign giving it.
+// Campaigns don't stack. The campaign is nil if no campaign lowers the price.
+func BestPrice(campaigns []*Campaign, listPrice int) (int, *Campaign) {
+	price := listPrice
+	var best *Campaign
+	for _, c := range campaigns {
+		p := c.Price(listPrice)
+		if p < price {
+			price = p
+			best = c
+		}
+	}
+	return price, best
+}
+
+type Row struct {
+	ID        uuid.UUID  `db:"id"`
+	CreatedAt time.Time  `db:"created_at"`
+	Name      string     `db:"name"`
+	Kind      string     `db:"kind"`
+	Value     int        `db:"value"`
+	ItemID    *uuid.UUID `db:"item_id"`
+	Category  *string    `db:"category"`
+	StartsAt  time.Time  `db:"starts_at"`
+	EndsAt    time.Time  `db:"ends_at"`
+}
+
+func RowToCampaign(collectable pgx.CollectableRow) (*Campaign, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Campaign{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		Name:      collected.Name,
+		Kind:      Kind(collected.Kind),
+		Value:     collected.Value,
+		ItemID:    collected.ItemID,
+		Category:  collected.Category,
+		StartsAt:  collected.StartsAt,
+		EndsAt:    collected.EndsAt,
+	}, nil
+}
+
+type RowWithItemName struct {
+	Row
+	ItemName string `db:"item_name"`
+}
+
+func RowToCampaignWithItemName(collectable pgx.CollectableRow) (*Campaign, error) {
+	collected, err := pgx.RowToStructByName[RowWithItemName](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Campaign{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		Name:      collected.Name,
+		Kind:      Kind(collected.Kind),
+		Value:     collected.Value,
+		ItemID:    collected.ItemID,
+		Category:  collected.Category,
+		StartsAt:  collected.StartsAt,
+		EndsAt:    collected.EndsAt,
+		ItemName:  collected.ItemName,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+)
+
+func TestCampaign(t *testing.T) {
+	t.Run("computes prices", func(t *testing.T) {
+		percent := &Campaign{Kind: KindPercent, Value: 15}
+		fixed := &Campaign{Kind: KindFixed, Value: 30}
+
+		if got, want := percent.Price(50), 43; got != want {
+			t.Errorf("got %d percent price, want %d", got, want)
+		}
+		if got, want := fixed.Price(20), 0; got != want {
+			t.Errorf("got %d fixed price, want %d", got, want)
+		}
+
+		price, best := BestPrice([]*Campaign{percent, fixed}, 100)
+		if got, want := price, 70; got != want {
+			t.Errorf("got %d best price, want %d", got, want)
+		}
+		if got, want := best, fixed; got != want {
+			t.Errorf("got %v best campaign, want %v", got, want)
+		}
+
+		price, best = BestPrice(nil, 100)
+		if got, want := price, 100; got != want {
+			t.Errorf("got %d price without campaigns, want %d", got, want)
+		}
+		if best != nil {
+			t.Errorf("got %v campaign, want nil", best)
+		}
+	})
+
+	t.Run("creates and ends campaigns", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cc  = NewCreator(db)
+			ce  = NewEnder(db)
+			cg  = NewGetter(db)
+		)
+
+		active, err := cc.CreateCampaign(ctx, &CreatorCreateCampaignParams{
+			Name:     "cup sale",
+			Kind:     KindFixed,
+			Value:    5,
+			ItemName: "cup",
+			StartsAt: time.Now().Add(-time.Hour),
+			EndsAt:   time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		upcoming, err := cc.CreateCampaign(ctx, &CreatorCreateCampaignParams{
+			Name:     "books sale",
+			Kind:     KindPercent,
+			Value:    20,
+			Category: "books",
+			StartsAt: time.Now().Add(time.Hour),
+			EndsAt:   time.Now().Add(2 * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = cc.CreateCampaign(ctx, &CreatorCreateCampaignParams{
+			Name:     "invalid sale",
+			Kind:     KindPercent,
+			Value:    120,
+			Category: "books",
+			StartsAt: time.Now(),
+			EndsAt:   time.Now().Add(time.Hour),
+		})
+		if got, want := err, ErrInvalidValue; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		campaigns, err := cg.GetCampaignsEndingAfter(ctx, time.Now())
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(campaigns), 2; got != want {
+			t.Fatalf("got %d campaigns, want %d", got, want)
+		}
+		if got, want := campaigns[0].ItemName, "cup"; got != want {
+			t.Errorf("got %s campaign item, want %s", got, want)
+		}
+
+		err = ce.EndCampaign(ctx, active.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = ce.EndCampaign(ctx, active.ID)
+		if got, want := err, ErrEnded; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		err = ce.EndCampaign(ctx, upcoming.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = ce.EndCampaign(ctx, upcoming.ID)
+		if got, want := err, ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		campaigns, err = cg.GetCampaignsEndingAfter(ctx, time.Now())
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(campaigns), 0; got != want {
+			t.Errorf("got %d campaigns, want %d", got, want)
+		}
+	})
+}
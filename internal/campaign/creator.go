@@ -0,0 +1,100 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+)
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreateCampaignParams struct {
+	Name  string
+	Kind  Kind
+	Value int
+
+	// Either ItemName or Category is set.
+	ItemName string
+	Category string
+
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+func (c *Creator) CreateCampaign(ctx context.Context, params *CreatorCreateCampaignParams) (*Campaign, error) {
+	if !params.Kind.Valid() || params.Value <= 0 || (params.Kind == KindPercent && params.Value > 100) {
+		return nil, fmt.Errorf("campaign.Creator: %w", ErrInvalidValue)
+	}
+	if (params.ItemName == "") == (params.Category == "") || !params.EndsAt.After(params.StartsAt) {
+		return nil, fmt.Errorf("campaign.Creator: %w", ErrInvalidValue)
+	}
+
+	var itemID *uuid.UUID
+	var category *string
+	if params.ItemName != "" {
+		i, err := item.NewGetter(c.db).GetItemByName(ctx, params.ItemName)
+		if err != nil {
+			return nil, fmt.Errorf("campaign.Creator: %w", err)
+		}
+		itemID = &i.ID
+	} else {
+		category = &params.Category
+	}
+
+	campaign, err := createCampaign(
+		ctx,
+		c.db,
+		params.Name,
+		params.Kind,
+		params.Value,
+		itemID,
+		category,
+		params.StartsAt,
+		params.EndsAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("campaign.Creator: %w", err)
+	}
+	campaign.ItemName = params.ItemName
+
+	return campaign, nil
+}
+
+func createCampaign(
+	ctx context.Context,
+	db app.PgxExecutor,
+	name string,
+	kind Kind,
+	value int,
+	itemID *uuid.UUID,
+	category *string,
+	startsAt time.Time,
+	endsAt time.Time,
+) (*Campaign, error) {
+	query := `
+		INSERT INTO campaigns (name, kind, value, item_id, category, starts_at, ends_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, name, kind, value, item_id, category, starts_at, ends_at
+	`
+	args := []any{name, string(kind), value, itemID, category, startsAt, endsAt}
+
+	rows, _ := db.Query(ctx, query, args...)
+	campaign, err := pgx.CollectExactlyOneRow(rows, RowToCampaign)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaign, nil
+}
@@ -0,0 +1,114 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Ender struct {
+	db app.PgxExecutor
+}
+
+func NewEnder(db app.PgxExecutor) *Ender {
+	return &Ender{db: db}
+}
+
+// EndCampaign ends the active campaign now.
+// An upcoming campaign is deleted because no purchases could refer to it.
+func (e *Ender) EndCampaign(ctx context.Context, id uuid.UUID) error {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("campaign.Ender: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	c, err := getCampaignForUpdate(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("campaign.Ender: %w", err)
+	}
+
+	now := time.Now()
+	switch {
+	case !now.Before(c.EndsAt):
+		return fmt.Errorf("campaign.Ender: %w", ErrEnded)
+	case now.Before(c.StartsAt):
+		err = deleteCampaign(ctx, tx, id)
+	default:
+		err = updateCampaignEndsAt(ctx, tx, id, now)
+	}
+	if err != nil {
+		return fmt.Errorf("campaign.Ender: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("campaign.Ender: %w", err)
+	}
+
+	return nil
+}
+
+func getCampaignForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Campaign, error) {
+	query := `
+		SELECT id, created_at, name, kind, value, item_id, category, starts_at, ends_at
+		FROM campaigns
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	c, err := pgx.CollectExactlyOneRow(rows, RowToCampaign)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func deleteCampaign(ctx context.Context, db app.PgxExecutor, id uuid.UUID) error {
+	query := `
+		DELETE FROM campaigns
+		WHERE id = $1
+	`
+	args := []any{id}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func updateCampaignEndsAt(ctx context.Context, db app.PgxExecutor, id uuid.UUID, endsAt time.Time) error {
+	query := `
+		UPDATE campaigns
+		SET ends_at = $2
+		WHERE id = $1
+	`
+	args := []any{id, endsAt}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package campaign
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetCampaignsEndingAfter returns campaigns that are active or upcoming at t, ordered by start.
+func (g *Getter) GetCampaignsEndingAfter(ctx context.Context, t time.Time) ([]*Campaign, error) {
+	campaigns, err := getCampaignsEndingAfter(ctx, g.db, t)
+	if err != nil {
+		return nil, fmt.Errorf("campaign.Getter: %w", err)
+	}
+	return campaigns, nil
+}
+
+// GetActiveCampaignsForItem returns campaigns active at t that apply to the item.
+func (g *Getter) GetActiveCampaignsForItem(ctx context.Context, itemID uuid.UUID, category string, t time.Time) ([]*Campaign, error) {
+	campaigns, err := getActiveCampaignsForItem(ctx, g.db, itemID, category, t)
+	if err != nil {
+		return nil, fmt.Errorf("campaign.Getter: %w", err)
+	}
+	return campaigns, nil
+}
+
+func getCampaignsEndingAfter(ctx context.Context, db app.PgxExecutor, t time.Time) ([]*Campaign, error) {
+	query := `
+		SELECT c.id, c.created_at, c.name, c.kind, c.value, c.item_id, c.category, c.starts_at, c.ends_at,
+			   coalesce(i.name, '') as item_name
+		FROM campaigns c
+		LEFT JOIN items i ON c.item_id = i.id
+		WHERE c.ends_at > $1
+		ORDER BY c.starts_at, c.id
+	`
+	args := []any{t}
+
+	rows, _ := db.Query(ctx, query, args...)
+	campaigns, err := pgx.CollectRows(rows, RowToCampaignWithItemName)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaigns, nil
+}
+
+func getActiveCampaignsForItem(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, category string, t time.Time) ([]*Campaign, error) {
+	query := `
+		SELECT id, created_at, name, kind, value, item_id, category, starts_at, ends_at
+		FROM campaigns
+		WHERE starts_at <= $3 AND ends_at > $3 AND (item_id = $1 OR category = $2)
+		ORDER BY starts_at, id
+	`
+	args := []any{itemID, category, t}
+
+	rows, _ := db.Query(ctx, query, args...)
+	campaigns, err := pgx.CollectRows(rows, RowToCampaign)
+	if err != nil {
+		return nil, err
+	}
+
+	return campaigns, nil
+}
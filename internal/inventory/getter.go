@@ -0,0 +1,88 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetItemCountsByOwnerID returns the number of units the user currently owns per item and variant.
+func (g *Getter) GetItemCountsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*ItemCount, error) {
+	itemCounts, err := getItemCountsByOwnerID(ctx, g.db, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("inventory.Getter: %w", err)
+	}
+	return itemCounts, nil
+}
+
+// GetTransfersByUserID returns the unit transfers sent or received by the user, newest first.
+func (g *Getter) GetTransfersByUserID(ctx context.Context, userID uuid.UUID) ([]*Transfer, error) {
+	transfers, err := getTransfersByUserID(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("inventory.Getter: %w", err)
+	}
+	return transfers, nil
+}
+
+func getItemCountsByOwnerID(ctx context.Context, db app.PgxExecutor, ownerID uuid.UUID) ([]*ItemCount, error) {
+	query := `
+		SELECT u.owner_id, u.item_id, u.variant_id, count(*) AS count, i.name AS item_name,
+			   coalesce(v.size, '') AS variant_size,
+			   coalesce(v.color, '') AS variant_color
+		FROM item_units u
+		JOIN items i ON u.item_id = i.id
+		LEFT JOIN item_variants v ON u.variant_id = v.id
+		WHERE u.owner_id = $1
+		GROUP BY u.owner_id, u.item_id, u.variant_id, item_name, variant_size, variant_color
+		ORDER BY item_name, item_id, variant_size, variant_color
+	`
+	args := []any{ownerID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	itemCounts, err := pgx.CollectRows(rows, RowToItemCount)
+	if err != nil {
+		return nil, err
+	}
+
+	return itemCounts, nil
+}
+
+func getTransfersByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Transfer, error) {
+	query := `
+		SELECT t.id, t.created_at, t.item_unit_id, t.src_user_id, t.dst_user_id,
+			   i.name as item_name,
+			   coalesce(v.size, '') as variant_size,
+			   coalesce(v.color, '') as variant_color,
+			   src_u.username as src_username,
+			   dst_u.username as dst_username
+		FROM item_unit_transfers t
+		JOIN item_units u ON t.item_unit_id = u.id
+		JOIN items i ON u.item_id = i.id
+		LEFT JOIN item_variants v ON u.variant_id = v.id
+		JOIN users src_u ON t.src_user_id = src_u.id
+		JOIN users dst_u ON t.dst_user_id = dst_u.id
+		WHERE t.src_user_id = $1 OR t.dst_user_id = $1
+		ORDER BY t.created_at DESC, t.id
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	transfers, err := pgx.CollectRows(rows, RowToTransferWithUsernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
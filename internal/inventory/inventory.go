@@ -0,0 +1,158 @@
+package inventory
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrNotEnough              = errors.New("not enough items")
+	ErrDstUserNotFound        = errors.New("dst user not found")
+	ErrDstUserDeactivated     = errors.New("dst user is deactivated")
+	ErrSrcUserAndDstUserEqual = errors.New("src user and dst user are equal")
+)
+
+// Unit is a single owned item.
+// A unit is created by a purchase and can later be transferred to other users.
+type Unit struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	ItemID     uuid.UUID
+	VariantID  *uuid.UUID
+	OwnerID    uuid.UUID
+	PurchaseID uuid.UUID
+}
+
+type UnitRow struct {
+	ID         uuid.UUID  `db:"id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ItemID     uuid.UUID  `db:"item_id"`
+	VariantID  *uuid.UUID `db:"variant_id"`
+	OwnerID    uuid.UUID  `db:"owner_id"`
+	PurchaseID uuid.UUID  `db:"purchase_id"`
+}
+
+func RowToUnit(collectable pgx.CollectableRow) (*Unit, error) {
+	collected, err := pgx.RowToStructByName[UnitRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Unit{
+		ID:         collected.ID,
+		CreatedAt:  collected.CreatedAt,
+		ItemID:     collected.ItemID,
+		VariantID:  collected.VariantID,
+		OwnerID:    collected.OwnerID,
+		PurchaseID: collected.PurchaseID,
+	}, nil
+}
+
+type ItemCount struct {
+	OwnerID   uuid.UUID
+	ItemID    uuid.UUID
+	VariantID *uuid.UUID
+	Count     int
+
+	ItemName     string
+	VariantSize  string
+	VariantColor string
+}
+
+type ItemCountRow struct {
+	OwnerID   uuid.UUID  `db:"owner_id"`
+	ItemID    uuid.UUID  `db:"item_id"`
+	VariantID *uuid.UUID `db:"variant_id"`
+	Count     int        `db:"count"`
+
+	ItemName     string `db:"item_name"`
+	VariantSize  string `db:"variant_size"`
+	VariantColor string `db:"variant_color"`
+}
+
+func RowToItemCount(collectable pgx.CollectableRow) (*ItemCount, error) {
+	collected, err := pgx.RowToStructByName[ItemCountRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ItemCount{
+		OwnerID:      collected.OwnerID,
+		ItemID:       collected.ItemID,
+		VariantID:    collected.VariantID,
+		Count:        collected.Count,
+		ItemName:     collected.ItemName,
+		VariantSize:  collected.VariantSize,
+		VariantColor: collected.VariantColor,
+	}, nil
+}
+
+// Transfer records a unit changing its owner.
+type Transfer struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	ItemUnitID uuid.UUID
+	SrcUserID  uuid.UUID
+	DstUserID  uuid.UUID
+
+	ItemName     string
+	VariantSize  string
+	VariantColor string
+	SrcUsername  string
+	DstUsername  string
+}
+
+type TransferRow struct {
+	ID         uuid.UUID `db:"id"`
+	CreatedAt  time.Time `db:"created_at"`
+	ItemUnitID uuid.UUID `db:"item_unit_id"`
+	SrcUserID  uuid.UUID `db:"src_user_id"`
+	DstUserID  uuid.UUID `db:"dst_user_id"`
+}
+
+func RowToTransfer(collectable pgx.CollectableRow) (*Transfer, error) {
+	collected, err := pgx.RowToStructByName[TransferRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transfer{
+		ID:         collected.ID,
+		CreatedAt:  collected.CreatedAt,
+		ItemUnitID: collected.ItemUnitID,
+		SrcUserID:  collected.SrcUserID,
+		DstUserID:  collected.DstUserID,
+	}, nil
+}
+
+type TransferRowWithUsernames struct {
+	TransferRow
+	ItemName     string `db:"item_name"`
+	VariantSize  string `db:"variant_size"`
+	VariantColor string `db:"variant_color"`
+	SrcUsername  string `db:"src_username"`
+	DstUsername  string `db:"dst_username"`
+}
+
+func RowToTransferWithUsernames(collectable pgx.CollectableRow) (*Transfer, error) {
+	collected, err := pgx.RowToStructByName[TransferRowWithUsernames](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transfer{
+		ID:           collected.ID,
+		CreatedAt:    collected.CreatedAt,
+		ItemUnitID:   collected.ItemUnitID,
+		SrcUserID:    collected.SrcUserID,
+		DstUserID:    collected.DstUserID,
+		ItemName:     collected.ItemName,
+		VariantSize:  collected.VariantSize,
+		VariantColor: collected.VariantColor,
+		SrcUsername:  collected.SrcUsername,
+		DstUsername:  collected.DstUsername,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/purchase"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestInventory(t *testing.T) {
+	t.Run("transfers owned items", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			ig  = NewGetter(db)
+			it  = NewTransferer(db)
+			pp  = purchase.NewPurchaser(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		for range 2 {
+			_, err := pp.PurchaseByName(ctx, "cup", alice.ID)
+			if err != nil {
+				t.Fatalf("got %v error", err)
+			}
+		}
+
+		_, err := it.TransferByName(ctx, "cup", nil, alice.ID, "bob", 3)
+		if got, want := err, ErrNotEnough; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = it.TransferByName(ctx, "cup", nil, alice.ID, "alice", 1)
+		if got, want := err, ErrSrcUserAndDstUserEqual; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		transfers, err := it.TransferByName(ctx, "cup", nil, alice.ID, "bob", 1)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(transfers), 1; got != want {
+			t.Fatalf("got %d transfers, want %d", got, want)
+		}
+
+		aliceItemCounts, err := ig.GetItemCountsByOwnerID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobItemCounts, err := ig.GetItemCountsByOwnerID(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobTransfers, err := ig.GetTransfersByUserID(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := len(aliceItemCounts), 1; got != want {
+			t.Fatalf("got %d alice item counts, want %d", got, want)
+		}
+		if got, want := aliceItemCounts[0].Count, 1; got != want {
+			t.Errorf("got %d alice cups, want %d", got, want)
+		}
+		if got, want := len(bobItemCounts), 1; got != want {
+			t.Fatalf("got %d bob item counts, want %d", got, want)
+		}
+		if got, want := bobItemCounts[0].Count, 1; got != want {
+			t.Errorf("got %d bob cups, want %d", got, want)
+		}
+		if got, want := len(bobTransfers), 1; got != want {
+			t.Fatalf("got %d bob transfers, want %d", got, want)
+		}
+		if got, want := bobTransfers[0].SrcUsername, "alice"; got != want {
+			t.Errorf("got %s transfer src username, want %s", got, want)
+		}
+		if got, want := bobTransfers[0].ItemName, "cup"; got != want {
+			t.Errorf("got %s transfer item name, want %s", got, want)
+		}
+	})
+}
@@ -0,0 +1,189 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Transferer struct {
+	db app.PgxExecutor
+}
+
+func NewTransferer(db app.PgxExecutor) *Transferer {
+	return &Transferer{db: db}
+}
+
+// TransferByName hands quantity units of the item from the src user to the dst user.
+// If variant is nil, units of any variant are handed.
+// The oldest units are handed first.
+func (t *Transferer) TransferByName(
+	ctx context.Context,
+	itemName string,
+	variant *item.VariantSelector,
+	srcUserID uuid.UUID,
+	dstUsername string,
+	quantity int,
+) ([]*Transfer, error) {
+	i, err := item.NewGetter(t.db).GetItemByName(ctx, itemName)
+	if err != nil {
+		return nil, fmt.Errorf("inventory.Transferer: %w", err)
+	}
+
+	var variantID *uuid.UUID
+	if variant != nil {
+		v, err := item.NewGetter(t.db).GetVariant(ctx, i.ID, variant)
+		if err != nil {
+			return nil, fmt.Errorf("inventory.Transferer: %w", err)
+		}
+		variantID = &v.ID
+	}
+
+	dstUser, err := user.NewGetter(t.db).GetActiveUserByUsername(ctx, dstUsername)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, fmt.Errorf("inventory.Transferer: %w", ErrDstUserNotFound)
+		}
+		if errors.Is(err, user.ErrDeactivated) {
+			return nil, fmt.Errorf("inventory.Transferer: %w", ErrDstUserDeactivated)
+		}
+		return nil, fmt.Errorf("inventory.Transferer: %w", err)
+	}
+	if dstUser.ID == srcUserID {
+		return nil, fmt.Errorf("inventory.Transferer: %w", ErrSrcUserAndDstUserEqual)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("inventory.Transferer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	// The src user is locked so that concurrent transfers of their units are serialized.
+	srcUser, err := getUserForUpdate(ctx, tx, srcUserID)
+	if err != nil {
+		return nil, fmt.Errorf("inventory.Transferer: %w", err)
+	}
+
+	units, err := getUnitsForUpdate(ctx, tx, srcUserID, i.ID, variantID, quantity)
+	if err != nil {
+		return nil, fmt.Errorf("inventory.Transferer: %w", err)
+	}
+	if len(units) < quantity {
+		return nil, fmt.Errorf("inventory.Transferer: %w", ErrNotEnough)
+	}
+
+	transfers := make([]*Transfer, len(units))
+	for j, u := range units {
+		err = updateUnitOwner(ctx, tx, u.ID, dstUser.ID)
+		if err != nil {
+			return nil, fmt.Errorf("inventory.Transferer: %w", err)
+		}
+		transfer, err := createTransfer(ctx, tx, u.ID, srcUserID, dstUser.ID)
+		if err != nil {
+			return nil, fmt.Errorf("inventory.Transferer: %w", err)
+		}
+		transfer.ItemName = i.Name
+		if variant != nil {
+			transfer.VariantSize = variant.Size
+			transfer.VariantColor = variant.Color
+		}
+		transfer.SrcUsername = srcUser.Username
+		transfer.DstUsername = dstUser.Username
+		transfers[j] = transfer
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("inventory.Transferer: %w", err)
+	}
+
+	return transfers, nil
+}
+
+func getUserForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*user.User, error) {
+	query := `
+		SELECT id, username, password_hash, balance
+		FROM users
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	u, err := pgx.CollectExactlyOneRow(rows, user.RowToUser)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func getUnitsForUpdate(ctx context.Context, db app.PgxExecutor, ownerID uuid.UUID, itemID uuid.UUID, variantID *uuid.UUID, limit int) ([]*Unit, error) {
+	query := `
+		SELECT id, created_at, item_id, variant_id, owner_id, purchase_id
+		FROM item_units
+		WHERE owner_id = $1 AND item_id = $2 AND ($3::uuid IS NULL OR variant_id = $3)
+		ORDER BY created_at, id
+		LIMIT $4
+		FOR UPDATE
+	`
+	args := []any{ownerID, itemID, variantID, limit}
+
+	rows, _ := db.Query(ctx, query, args...)
+	units, err := pgx.CollectRows(rows, RowToUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
+
+func updateUnitOwner(ctx context.Context, db app.PgxExecutor, id uuid.UUID, ownerID uuid.UUID) error {
+	query := `
+		UPDATE item_units
+		SET owner_id = $2
+		WHERE id = $1
+	`
+	args := []any{id, ownerID}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createTransfer(ctx context.Context, db app.PgxExecutor, itemUnitID uuid.UUID, srcUserID uuid.UUID, dstUserID uuid.UUID) (*Transfer, error) {
+	query := `
+		INSERT INTO item_unit_transfers (item_unit_id, src_user_id, dst_user_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, item_unit_id, src_user_id, dst_user_id
+	`
+	args := []any{itemUnitID, srcUserID, dstUserID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/k11v/merch/internal/app"
@@ -26,9 +27,45 @@ func (g *Getter) GetItemByName(ctx context.Context, name string) (*Item, error)
 	return i, nil
 }
 
+func (g *Getter) GetItemByID(ctx context.Context, id uuid.UUID) (*Item, error) {
+	i, err := getItemByID(ctx, g.db, id)
+	if err != nil {
+		return nil, fmt.Errorf("item.Getter: %w", err)
+	}
+	return i, nil
+}
+
+// GetItems returns the catalog ordered by category and name.
+// If category is not empty, only items of the category are returned.
+func (g *Getter) GetItems(ctx context.Context, category string) ([]*Item, error) {
+	items, err := getItems(ctx, g.db, category)
+	if err != nil {
+		return nil, fmt.Errorf("item.Getter: %w", err)
+	}
+	return items, nil
+}
+
+// GetVariantsByItemID returns the variants of the item ordered by size and color.
+func (g *Getter) GetVariantsByItemID(ctx context.Context, itemID uuid.UUID) ([]*Variant, error) {
+	variants, err := getVariantsByItemID(ctx, g.db, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("item.Getter: %w", err)
+	}
+	return variants, nil
+}
+
+// GetVariant returns the variant of the item chosen by the selector.
+func (g *Getter) GetVariant(ctx context.Context, itemID uuid.UUID, selector *VariantSelector) (*Variant, error) {
+	v, err := getVariant(ctx, g.db, itemID, selector)
+	if err != nil {
+		return nil, fmt.Errorf("item.Getter: %w", err)
+	}
+	return v, nil
+}
+
 func getItemByName(ctx context.Context, db app.PgxExecutor, name string) (*Item, error) {
 	query := `
-		SELECT id, name, price
+		SELECT id, name, price, category, description, image_key
 		FROM items
 		WHERE name = $1
 	`
@@ -45,3 +82,79 @@ func getItemByName(ctx context.Context, db app.PgxExecutor, name string) (*Item,
 
 	return item, nil
 }
+
+func getItemByID(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Item, error) {
+	query := `
+		SELECT id, name, price, category, description, image_key
+		FROM items
+		WHERE id = $1
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	item, err := pgx.CollectExactlyOneRow(rows, RowToItem)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func getItems(ctx context.Context, db app.PgxExecutor, category string) ([]*Item, error) {
+	query := `
+		SELECT id, name, price, category, description, image_key
+		FROM items
+		WHERE $1 = '' OR category = $1
+		ORDER BY category, name
+	`
+	args := []any{category}
+
+	rows, _ := db.Query(ctx, query, args...)
+	items, err := pgx.CollectRows(rows, RowToItem)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func getVariantsByItemID(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID) ([]*Variant, error) {
+	query := `
+		SELECT id, created_at, item_id, size, color, stock, price
+		FROM item_variants
+		WHERE item_id = $1
+		ORDER BY size, color, id
+	`
+	args := []any{itemID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	variants, err := pgx.CollectRows(rows, RowToVariant)
+	if err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
+
+func getVariant(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, selector *VariantSelector) (*Variant, error) {
+	query := `
+		SELECT id, created_at, item_id, size, color, stock, price
+		FROM item_variants
+		WHERE item_id = $1 AND size = $2 AND color = $3
+	`
+	args := []any{itemID, selector.Size, selector.Color}
+
+	rows, _ := db.Query(ctx, query, args...)
+	v, err := pgx.CollectExactlyOneRow(rows, RowToVariant)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVariantNotExist
+		}
+		return nil, err
+	}
+
+	return v, nil
+}
@@ -3,9 +3,11 @@ package item
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/storage"
 )
 
 func TestGetter(t *testing.T) {
@@ -41,4 +43,84 @@ func TestGetter(t *testing.T) {
 			t.Fatalf("got %v error, want %v", got, want)
 		}
 	})
+	t.Run("creates and gets item variants", func(t *testing.T) {
+		var (
+			tx = apptest.BeginPostgresTx(t, ctx, db)
+			g  = NewGetter(tx)
+			vs = NewVariantSetter(tx)
+		)
+
+		i, err := g.GetItemByName(ctx, "t-shirt")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		price := 100
+		_, err = vs.CreateVariant(ctx, &VariantSetterCreateVariantParams{
+			ItemID:   i.ID,
+			Selector: VariantSelector{Size: "M"},
+			Stock:    5,
+			Price:    &price,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = vs.CreateVariant(ctx, &VariantSetterCreateVariantParams{
+			ItemID:   i.ID,
+			Selector: VariantSelector{Size: "M"},
+			Stock:    1,
+		})
+		if got, want := err, ErrVariantExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		v, err := g.GetVariant(ctx, i.ID, &VariantSelector{Size: "M"})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := v.Stock, 5; got != want {
+			t.Errorf("got %d stock, want %d", got, want)
+		}
+		if got, want := v.PriceOr(i.Price), price; got != want {
+			t.Errorf("got %d price, want %d", got, want)
+		}
+
+		_, err = g.GetVariant(ctx, i.ID, &VariantSelector{Size: "XL"})
+		if got, want := err, ErrVariantNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+	t.Run("updates item details and image", func(t *testing.T) {
+		var (
+			tx = apptest.BeginPostgresTx(t, ctx, db)
+			g  = NewGetter(tx)
+			u  = NewUpdater(tx, storage.NewFileStorage(t.TempDir()))
+		)
+
+		_, err := u.UpdateDetailsByName(ctx, "cup", "kitchen", "A cup for coffee.")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = u.UpdateImageByName(ctx, "cup", strings.NewReader("not an image"))
+		if got, want := err, ErrImageUnsupported; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = u.UpdateImageByName(ctx, "cup", strings.NewReader("\x89PNG\r\n\x1a\n"))
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		items, err := g.GetItems(ctx, "kitchen")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(items), 1; got != want {
+			t.Fatalf("got %d items, want %d", got, want)
+		}
+		if got, want := items[0].Description, "A cup for coffee."; got != want {
+			t.Errorf("got %q description, want %q", got, want)
+		}
+		if got, want := ImageContentType(items[0].ImageKey), "image/png"; got != want {
+			t.Errorf("got %s image content type, want %s", got, want)
+		}
+	})
 }
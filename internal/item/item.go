@@ -10,16 +10,22 @@ import (
 var ErrNotExist = errors.New("does not exist")
 
 type Item struct {
-	ID    uuid.UUID
-	Name  string
-	Price int
+	ID          uuid.UUID
+	Name        string
+	Price       int
+	Category    string
+	Description string
+	ImageKey    string // key in the image storage, empty if the item has no image
 }
 
 func RowToItem(collectable pgx.CollectableRow) (*Item, error) {
 	type row struct {
-		ID    uuid.UUID `db:"id"`
-		Name  string    `db:"name"`
-		Price int       `db:"price"`
+		ID          uuid.UUID `db:"id"`
+		Name        string    `db:"name"`
+		Price       int       `db:"price"`
+		Category    string    `db:"category"`
+		Description string    `db:"description"`
+		ImageKey    string    `db:"image_key"`
 	}
 
 	collected, err := pgx.RowToStructByName[row](collectable)
@@ -28,8 +34,11 @@ func RowToItem(collectable pgx.CollectableRow) (*Item, error) {
 	}
 
 	return &Item{
-		ID:    collected.ID,
-		Name:  collected.Name,
-		Price: collected.Price,
+		ID:          collected.ID,
+		Name:        collected.Name,
+		Price:       collected.Price,
+		Category:    collected.Category,
+		Description: collected.Description,
+		ImageKey:    collected.ImageKey,
 	}, nil
 }
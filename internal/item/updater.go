@@ -0,0 +1,143 @@
+package item
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/storage"
+)
+
+// MaxImageSize is the maximum size of an item image in bytes.
+const MaxImageSize = 5 << 20
+
+var (
+	ErrImageTooLarge    = errors.New("image too large")
+	ErrImageUnsupported = errors.New("image format unsupported")
+)
+
+// imageExtensions maps supported image content types to their key extensions.
+var imageExtensions = map[string]string{
+	"image/gif":  ".gif",
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// ImageContentType returns the content type of the image with the key.
+func ImageContentType(key string) string {
+	ext := path.Ext(key)
+	for contentType, e := range imageExtensions {
+		if e == ext {
+			return contentType
+		}
+	}
+	return "application/octet-stream"
+}
+
+// Updater updates item details shown in the catalog.
+type Updater struct {
+	db      app.PgxExecutor
+	storage storage.Storage
+}
+
+func NewUpdater(db app.PgxExecutor, storage storage.Storage) *Updater {
+	return &Updater{db: db, storage: storage}
+}
+
+func (u *Updater) UpdateDetailsByName(ctx context.Context, name string, category string, description string) (*Item, error) {
+	i, err := updateItemDetails(ctx, u.db, name, category, description)
+	if err != nil {
+		return nil, fmt.Errorf("item.Updater: %w", err)
+	}
+	return i, nil
+}
+
+// UpdateImageByName stores the image read from r and sets it as the item image.
+// Image keys are derived from the image content, so an image never changes under its key
+// and can be cached indefinitely.
+func (u *Updater) UpdateImageByName(ctx context.Context, name string, r io.Reader) (*Item, error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("item.Updater: %w", err)
+	}
+	if len(data) > MaxImageSize {
+		return nil, fmt.Errorf("item.Updater: %w", ErrImageTooLarge)
+	}
+
+	ext, ok := imageExtensions[http.DetectContentType(data)]
+	if !ok {
+		return nil, fmt.Errorf("item.Updater: %w", ErrImageUnsupported)
+	}
+	sum := sha256.Sum256(data)
+	key := hex.EncodeToString(sum[:]) + ext
+
+	// The item is checked before the image is stored to not leave images of nonexistent items.
+	_, err = getItemByName(ctx, u.db, name)
+	if err != nil {
+		return nil, fmt.Errorf("item.Updater: %w", err)
+	}
+
+	err = u.storage.Put(ctx, key, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("item.Updater: %w", err)
+	}
+
+	i, err := updateItemImageKey(ctx, u.db, name, key)
+	if err != nil {
+		return nil, fmt.Errorf("item.Updater: %w", err)
+	}
+
+	return i, nil
+}
+
+func updateItemDetails(ctx context.Context, db app.PgxExecutor, name string, category string, description string) (*Item, error) {
+	query := `
+		UPDATE items
+		SET category = $2, description = $3
+		WHERE name = $1
+		RETURNING id, name, price, category, description, image_key
+	`
+	args := []any{name, category, description}
+
+	rows, _ := db.Query(ctx, query, args...)
+	i, err := pgx.CollectExactlyOneRow(rows, RowToItem)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return i, nil
+}
+
+func updateItemImageKey(ctx context.Context, db app.PgxExecutor, name string, imageKey string) (*Item, error) {
+	query := `
+		UPDATE items
+		SET image_key = $2
+		WHERE name = $1
+		RETURNING id, name, price, category, description, image_key
+	`
+	args := []any{name, imageKey}
+
+	rows, _ := db.Query(ctx, query, args...)
+	i, err := pgx.CollectExactlyOneRow(rows, RowToItem)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return i, nil
+}
@@ -0,0 +1,68 @@
+package item
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrVariantNotExist   = errors.New("variant does not exist")
+	ErrVariantExist      = errors.New("variant already exists")
+	ErrOnAuctionOrRaffle = errors.New("item is on an open auction or raffle")
+)
+
+// Variant is a size, a color or both of an item with its own stock.
+type Variant struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	ItemID    uuid.UUID
+	Size      string
+	Color     string
+	Stock     int
+	Price     *int // overrides the item price, nil if the item price is used
+}
+
+// PriceOr returns the variant price or itemPrice if the variant doesn't override it.
+func (v *Variant) PriceOr(itemPrice int) int {
+	if v.Price == nil {
+		return itemPrice
+	}
+	return *v.Price
+}
+
+// VariantSelector selects a variant of an item by its size and color.
+// Empty values select variants without a size or a color.
+type VariantSelector struct {
+	Size  string
+	Color string
+}
+
+type VariantRow struct {
+	ID        uuid.UUID `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	ItemID    uuid.UUID `db:"item_id"`
+	Size      string    `db:"size"`
+	Color     string    `db:"color"`
+	Stock     int       `db:"stock"`
+	Price     *int      `db:"price"`
+}
+
+func RowToVariant(collectable pgx.CollectableRow) (*Variant, error) {
+	collected, err := pgx.RowToStructByName[VariantRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Variant{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		ItemID:    collected.ItemID,
+		Size:      collected.Size,
+		Color:     collected.Color,
+		Stock:     collected.Stock,
+		Price:     collected.Price,
+	}, nil
+}
@@ -0,0 +1,127 @@
+package item
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// VariantSetter creates and updates item variants.
+type VariantSetter struct {
+	db app.PgxExecutor
+}
+
+func NewVariantSetter(db app.PgxExecutor) *VariantSetter {
+	return &VariantSetter{db: db}
+}
+
+type VariantSetterCreateVariantParams struct {
+	ItemID   uuid.UUID
+	Selector VariantSelector
+	Stock    int
+	Price    *int
+}
+
+// CreateVariant adds the variant to the item.
+// Items on open auctions and raffles can't get variants because they are given away without one.
+func (vs *VariantSetter) CreateVariant(ctx context.Context, params *VariantSetterCreateVariantParams) (*Variant, error) {
+	onAuctionOrRaffle, err := isOnOpenAuctionOrRaffle(ctx, vs.db, params.ItemID)
+	if err != nil {
+		return nil, fmt.Errorf("item.VariantSetter: %w", err)
+	}
+	if onAuctionOrRaffle {
+		return nil, fmt.Errorf("item.VariantSetter: %w", ErrOnAuctionOrRaffle)
+	}
+
+	v, err := createVariant(
+		ctx,
+		vs.db,
+		params.ItemID,
+		params.Selector.Size,
+		params.Selector.Color,
+		params.Stock,
+		params.Price,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("item.VariantSetter: %w", err)
+	}
+	return v, nil
+}
+
+// UpdateVariant sets the stock and the price override of the item variant.
+func (vs *VariantSetter) UpdateVariant(ctx context.Context, itemID uuid.UUID, id uuid.UUID, stock int, price *int) (*Variant, error) {
+	v, err := updateVariant(ctx, vs.db, itemID, id, stock, price)
+	if err != nil {
+		return nil, fmt.Errorf("item.VariantSetter: %w", err)
+	}
+	return v, nil
+}
+
+func createVariant(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, size string, color string, stock int, price *int) (*Variant, error) {
+	query := `
+		INSERT INTO item_variants (item_id, size, color, stock, price)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, item_id, size, color, stock, price
+	`
+	args := []any{itemID, size, color, stock, price}
+
+	rows, _ := db.Query(ctx, query, args...)
+	v, err := pgx.CollectExactlyOneRow(rows, RowToVariant)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "item_variants_item_id_size_color_idx") {
+			return nil, ErrVariantExist
+		}
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func updateVariant(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, id uuid.UUID, stock int, price *int) (*Variant, error) {
+	query := `
+		UPDATE item_variants
+		SET stock = $3, price = $4
+		WHERE id = $2 AND item_id = $1
+		RETURNING id, created_at, item_id, size, color, stock, price
+	`
+	args := []any{itemID, id, stock, price}
+
+	rows, _ := db.Query(ctx, query, args...)
+	v, err := pgx.CollectExactlyOneRow(rows, RowToVariant)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrVariantNotExist
+		}
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func isOnOpenAuctionOrRaffle(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM auctions WHERE item_id = $1 AND status = 'open')
+			OR EXISTS (SELECT 1 FROM raffles WHERE item_id = $1 AND status = 'open')
+	`
+	args := []any{itemID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	onAuctionOrRaffle, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	if err != nil {
+		return false, err
+	}
+
+	return onAuctionOrRaffle, nil
+}
+
+func isConstraintPgError(e *pgconn.PgError, constraint string) bool {
+	return pgerrcode.IsIntegrityConstraintViolation(e.Code) && e.ConstraintName == constraint
+}
@@ -0,0 +1,106 @@
+package kudos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+func (g *Getter) GetSettings(ctx context.Context, userID uuid.UUID) (*Settings, error) {
+	s, err := getSettings(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("kudos.Getter: %w", err)
+	}
+	return s, nil
+}
+
+type GetterGetFeedParams struct {
+	// Before is the ID of the last entry of the previous page.
+	// It is nil for the first page.
+	Before *uuid.UUID
+	Limit  int
+}
+
+// GetFeed returns the most recent entries of the kudos feed, newest first.
+// Pages are keyed by (created_at, id), so entries added between requests
+// don't shift the following pages.
+func (g *Getter) GetFeed(ctx context.Context, params *GetterGetFeedParams) ([]*Entry, error) {
+	entries, err := getFeed(ctx, g.db, params)
+	if err != nil {
+		return nil, fmt.Errorf("kudos.Getter: %w", err)
+	}
+	return entries, nil
+}
+
+func getSettings(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (*Settings, error) {
+	query := `
+		SELECT u.id AS user_id,
+			   coalesce(s.public, false) AS public,
+			   coalesce(s.show_amounts, false) AS show_amounts
+		FROM users u
+		LEFT JOIN kudos_settings s ON u.id = s.user_id
+		WHERE u.id = $1
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	s, err := pgx.CollectExactlyOneRow(rows, RowToSettings)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func getFeed(ctx context.Context, db app.PgxExecutor, params *GetterGetFeedParams) ([]*Entry, error) {
+	// The before transfer is looked up by ID, so the feed is paginated by transfers_created_at_id_idx.
+	// An unknown before ID gives an empty page.
+	query := `
+		SELECT t.id, t.created_at,
+			   src_u.username AS src_username,
+			   dst_u.username AS dst_username,
+			   coalesce(src_p.display_name, '') AS src_display_name,
+			   coalesce(dst_p.display_name, '') AS dst_display_name,
+			   t.message,
+			   CASE WHEN src_s.show_amounts AND dst_s.show_amounts THEN t.amount END AS amount
+		FROM transfers t
+		JOIN kudos_settings src_s ON t.src_user_id = src_s.user_id AND src_s.public
+		JOIN kudos_settings dst_s ON t.dst_user_id = dst_s.user_id AND dst_s.public
+		JOIN users src_u ON t.src_user_id = src_u.id
+		JOIN users dst_u ON t.dst_user_id = dst_u.id
+		LEFT JOIN profiles src_p ON t.src_user_id = src_p.user_id
+		LEFT JOIN profiles dst_p ON t.dst_user_id = dst_p.user_id
+		WHERE t.status = 'completed'
+		  AND t.message <> ''
+		  AND ($1::uuid IS NULL
+			   OR (t.created_at, t.id) < (SELECT b.created_at, b.id FROM transfers b WHERE b.id = $1))
+		ORDER BY t.created_at DESC, t.id DESC
+		LIMIT $2
+	`
+	args := []any{params.Before, params.Limit}
+
+	rows, _ := db.Query(ctx, query, args...)
+	entries, err := pgx.CollectRows(rows, RowToEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
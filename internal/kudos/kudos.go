@@ -0,0 +1,77 @@
+package kudos
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Settings represent the consent of a user to appear in the public kudos feed.
+// Users without saved settings have settings with false fields.
+type Settings struct {
+	UserID      uuid.UUID
+	Public      bool // true if transfers with messages can be shown in the feed
+	ShowAmounts bool // true if transfer amounts can be shown in the feed
+}
+
+type SettingsRow struct {
+	UserID      uuid.UUID `db:"user_id"`
+	Public      bool      `db:"public"`
+	ShowAmounts bool      `db:"show_amounts"`
+}
+
+func RowToSettings(collectable pgx.CollectableRow) (*Settings, error) {
+	collected, err := pgx.RowToStructByName[SettingsRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Settings{
+		UserID:      collected.UserID,
+		Public:      collected.Public,
+		ShowAmounts: collected.ShowAmounts,
+	}, nil
+}
+
+// Entry is a completed transfer with a message shown in the kudos feed.
+// A transfer is shown only if both of its users made their kudos public.
+type Entry struct {
+	ID             uuid.UUID // transfer ID
+	CreatedAt      time.Time
+	SrcUsername    string
+	DstUsername    string
+	SrcDisplayName string
+	DstDisplayName string
+	Message        string
+	Amount         *int // nil unless both users agreed to show amounts
+}
+
+type EntryRow struct {
+	ID             uuid.UUID `db:"id"`
+	CreatedAt      time.Time `db:"created_at"`
+	SrcUsername    string    `db:"src_username"`
+	DstUsername    string    `db:"dst_username"`
+	SrcDisplayName string    `db:"src_display_name"`
+	DstDisplayName string    `db:"dst_display_name"`
+	Message        string    `db:"message"`
+	Amount         *int      `db:"amount"`
+}
+
+func RowToEntry(collectable pgx.CollectableRow) (*Entry, error) {
+	collected, err := pgx.RowToStructByName[EntryRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		ID:             collected.ID,
+		CreatedAt:      collected.CreatedAt,
+		SrcUsername:    collected.SrcUsername,
+		DstUsername:    collected.DstUsername,
+		SrcDisplayName: collected.SrcDisplayName,
+		DstDisplayName: collected.DstDisplayName,
+		Message:        collected.Message,
+		Amount:         collected.Amount,
+	}, nil
+}
@@ -0,0 +1,127 @@
+package kudos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestKudos(t *testing.T) {
+	t.Run("gets feed of public transfers with messages", func(t *testing.T) {
+		var (
+			ctx   = context.Background()
+			db    = apptest.NewPostgresPool(t, ctx)
+			alice = usertest.CreateUser(t, ctx, db, "alice")
+			bob   = usertest.CreateUser(t, ctx, db, "bob")
+			_     = usertest.CreateUser(t, ctx, db, "carol")
+			g     = NewGetter(db)
+			u     = NewUpdater(db)
+			tt    = transfer.NewTransferer(db)
+		)
+
+		_, err := u.UpdateSettings(ctx, alice.ID, &UpdaterUpdateSettingsParams{Public: true, ShowAmounts: true})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = u.UpdateSettings(ctx, bob.ID, &UpdaterUpdateSettingsParams{Public: true})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		transfers := []*transfer.TransfererTransferParams{
+			{DstUsername: "bob", SrcUserID: alice.ID, Amount: 10, Message: "thanks for the review"},
+			{DstUsername: "bob", SrcUserID: alice.ID, Amount: 20},
+			{DstUsername: "carol", SrcUserID: alice.ID, Amount: 30, Message: "carol didn't opt in"},
+			{DstUsername: "alice", SrcUserID: bob.ID, Amount: 40, Message: "thanks for the help"},
+		}
+		for _, params := range transfers {
+			_, err = tt.Transfer(ctx, params)
+			if err != nil {
+				t.Fatalf("got %v error", err)
+			}
+		}
+
+		entries, err := g.GetFeed(ctx, &GetterGetFeedParams{Limit: 1})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(entries), 1; got != want {
+			t.Fatalf("got %d entries, want %d", got, want)
+		}
+		if got, want := entries[0].Message, "thanks for the help"; got != want {
+			t.Errorf("got %q message, want %q", got, want)
+		}
+		if entries[0].Amount != nil {
+			t.Errorf("got %d amount, want nil", *entries[0].Amount)
+		}
+
+		entries, err = g.GetFeed(ctx, &GetterGetFeedParams{Before: &entries[0].ID, Limit: 10})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(entries), 1; got != want {
+			t.Fatalf("got %d entries, want %d", got, want)
+		}
+		if got, want := entries[0].Message, "thanks for the review"; got != want {
+			t.Errorf("got %q message, want %q", got, want)
+		}
+		if got, want := entries[0].SrcUsername, "alice"; got != want {
+			t.Errorf("got %s src username, want %s", got, want)
+		}
+	})
+	t.Run("shows amounts only if both users agree", func(t *testing.T) {
+		var (
+			ctx   = context.Background()
+			db    = apptest.NewPostgresPool(t, ctx)
+			alice = usertest.CreateUser(t, ctx, db, "alice")
+			bob   = usertest.CreateUser(t, ctx, db, "bob")
+			g     = NewGetter(db)
+			u     = NewUpdater(db)
+			tt    = transfer.NewTransferer(db)
+		)
+
+		for _, id := range []uuid.UUID{alice.ID, bob.ID} {
+			_, err := u.UpdateSettings(ctx, id, &UpdaterUpdateSettingsParams{Public: true, ShowAmounts: true})
+			if err != nil {
+				t.Fatalf("got %v error", err)
+			}
+		}
+
+		_, err := tt.Transfer(ctx, &transfer.TransfererTransferParams{
+			DstUsername: "bob",
+			SrcUserID:   alice.ID,
+			Amount:      10,
+			Message:     "thanks",
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		entries, err := g.GetFeed(ctx, &GetterGetFeedParams{Limit: 10})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(entries), 1; got != want {
+			t.Fatalf("got %d entries, want %d", got, want)
+		}
+		if entries[0].Amount == nil {
+			t.Fatalf("got nil amount, want 10")
+		}
+		if got, want := *entries[0].Amount, 10; got != want {
+			t.Errorf("got %d amount, want %d", got, want)
+		}
+
+		s, err := g.GetSettings(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if !s.Public || !s.ShowAmounts {
+			t.Errorf("got %+v settings, want public with amounts", s)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package kudos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Updater struct {
+	db app.PgxExecutor
+}
+
+func NewUpdater(db app.PgxExecutor) *Updater {
+	return &Updater{db: db}
+}
+
+type UpdaterUpdateSettingsParams struct {
+	Public      bool
+	ShowAmounts bool
+}
+
+// UpdateSettings replaces the kudos settings of the user with the provided values.
+func (u *Updater) UpdateSettings(ctx context.Context, userID uuid.UUID, params *UpdaterUpdateSettingsParams) (*Settings, error) {
+	err := upsertSettings(ctx, u.db, userID, params)
+	if err != nil {
+		return nil, fmt.Errorf("kudos.Updater: %w", err)
+	}
+	s, err := getSettings(ctx, u.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("kudos.Updater: %w", err)
+	}
+	return s, nil
+}
+
+func upsertSettings(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, params *UpdaterUpdateSettingsParams) error {
+	query := `
+		INSERT INTO kudos_settings (user_id, public, show_amounts)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET public = excluded.public,
+			show_amounts = excluded.show_amounts
+	`
+	args := []any{userID, params.Public, params.ShowAmounts}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "kudos_settings_user_id_fkey") {
+			return user.ErrNotExist
+		}
+		return err
+	}
+
+	return nil
+}
+
+func isConstraintPgError(e *pgconn.PgError, constraint string) bool {
+	return pgerrcode.IsIntegrityConstraintViolation(e.Code) && e.ConstraintName == constraint
+}
@@ -0,0 +1,112 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// sources are the per-kind queries of user totals in the period between $1 and $2.
+// Unbounded periods are coalesced to infinities to keep the range usable by the indexes.
+var sources = map[Kind]string{
+	KindSenders: `
+		SELECT src_user_id AS user_id, sum(amount) AS value
+		FROM transfers
+		WHERE status = 'completed'
+		  AND src_user_id IS NOT NULL
+		  AND created_at >= coalesce($1::timestamptz, '-infinity')
+		  AND created_at < coalesce($2::timestamptz, 'infinity')
+		GROUP BY src_user_id
+	`,
+	KindReceivers: `
+		SELECT dst_user_id AS user_id, sum(amount) AS value
+		FROM transfers
+		WHERE status = 'completed'
+		  AND dst_user_id IS NOT NULL
+		  AND created_at >= coalesce($1::timestamptz, '-infinity')
+		  AND created_at < coalesce($2::timestamptz, 'infinity')
+		GROUP BY dst_user_id
+	`,
+	KindRecipients: `
+		SELECT src_user_id AS user_id, count(DISTINCT dst_user_id) AS value
+		FROM transfers
+		WHERE status = 'completed'
+		  AND src_user_id IS NOT NULL
+		  AND dst_user_id IS NOT NULL
+		  AND created_at >= coalesce($1::timestamptz, '-infinity')
+		  AND created_at < coalesce($2::timestamptz, 'infinity')
+		GROUP BY src_user_id
+	`,
+	KindSpenders: `
+		SELECT buyer_id AS user_id, sum(amount) AS value
+		FROM purchases
+		WHERE created_at >= coalesce($1::timestamptz, '-infinity')
+		  AND created_at < coalesce($2::timestamptz, 'infinity')
+		GROUP BY buyer_id
+		HAVING sum(amount) > 0
+	`,
+}
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+type GetterGetLeaderboardParams struct {
+	Kind  Kind
+	From  *time.Time // inclusive, nil if unbounded
+	To    *time.Time // exclusive, nil if unbounded
+	Limit int
+}
+
+// GetLeaderboard returns the top users of the leaderboard over the period.
+// Users without activity in the period are not ranked.
+func (g *Getter) GetLeaderboard(ctx context.Context, params *GetterGetLeaderboardParams) ([]*Entry, error) {
+	source, ok := sources[params.Kind]
+	if !ok {
+		return nil, fmt.Errorf("leaderboard.Getter: %w", ErrInvalidValue)
+	}
+
+	entries, err := getEntries(ctx, g.db, source, params)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboard.Getter: %w", err)
+	}
+	return entries, nil
+}
+
+// getEntries ranks the top totals of the source.
+// Totals are aggregated before users and profiles are joined, so only the top rows are joined.
+// Ties are broken by user ID, so the same users make the cut on every call.
+func getEntries(ctx context.Context, db app.PgxExecutor, source string, params *GetterGetLeaderboardParams) ([]*Entry, error) {
+	query := fmt.Sprintf(`
+		WITH totals AS (
+			%s
+			ORDER BY value DESC, user_id
+			LIMIT $3
+		)
+		SELECT rank() OVER (ORDER BY t.value DESC) AS rank,
+			   u.id AS user_id, u.username,
+			   coalesce(p.display_name, '') AS display_name,
+			   t.value
+		FROM totals t
+		JOIN users u ON t.user_id = u.id
+		LEFT JOIN profiles p ON u.id = p.user_id
+		ORDER BY t.value DESC, u.username
+	`, source)
+	args := []any{params.From, params.To, params.Limit}
+
+	rows, _ := db.Query(ctx, query, args...)
+	entries, err := pgx.CollectRows(rows, RowToEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,102 @@
+package leaderboard
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrInvalidValue = errors.New("invalid value")
+
+type Kind string
+
+const (
+	KindSenders    Kind = "senders"    // by coins sent in completed transfers
+	KindReceivers  Kind = "receivers"  // by coins received in completed transfers
+	KindRecipients Kind = "recipients" // by distinct users sent coins to in completed transfers
+	KindSpenders   Kind = "spenders"   // by coins spent on purchases, including gifts
+)
+
+func (k Kind) Valid() bool {
+	switch k {
+	case KindSenders, KindReceivers, KindRecipients, KindSpenders:
+		return true
+	default:
+		return false
+	}
+}
+
+type Period string
+
+const (
+	PeriodWeek    Period = "week"
+	PeriodMonth   Period = "month"
+	PeriodQuarter Period = "quarter"
+	PeriodYear    Period = "year"
+	PeriodAll     Period = "all"
+)
+
+func (p Period) Valid() bool {
+	switch p {
+	case PeriodWeek, PeriodMonth, PeriodQuarter, PeriodYear, PeriodAll:
+		return true
+	default:
+		return false
+	}
+}
+
+// Start returns the start of the period that contains t, or nil for [PeriodAll].
+// Periods are aligned to UTC calendar boundaries, weeks start on Monday.
+func (p Period) Start(t time.Time) *time.Time {
+	t = t.UTC()
+	var start time.Time
+	switch p {
+	case PeriodWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		start = time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case PeriodMonth:
+		start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case PeriodQuarter:
+		start = time.Date(t.Year(), t.Month()-(t.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	case PeriodYear:
+		start = time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return nil
+	}
+	return &start
+}
+
+// Entry is a place of a user on a leaderboard.
+// Users with equal values share a rank.
+type Entry struct {
+	Rank        int
+	UserID      uuid.UUID
+	Username    string
+	DisplayName string
+	Value       int
+}
+
+type EntryRow struct {
+	Rank        int       `db:"rank"`
+	UserID      uuid.UUID `db:"user_id"`
+	Username    string    `db:"username"`
+	DisplayName string    `db:"display_name"`
+	Value       int       `db:"value"`
+}
+
+func RowToEntry(collectable pgx.CollectableRow) (*Entry, error) {
+	collected, err := pgx.RowToStructByName[EntryRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		Rank:        collected.Rank,
+		UserID:      collected.UserID,
+		Username:    collected.Username,
+		DisplayName: collected.DisplayName,
+		Value:       collected.Value,
+	}, nil
+}
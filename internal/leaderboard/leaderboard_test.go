@@ -0,0 +1,97 @@
+package leaderboard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestLeaderboard(t *testing.T) {
+	t.Run("starts periods at calendar boundaries", func(t *testing.T) {
+		now := time.Date(2024, 8, 15, 13, 30, 0, 0, time.UTC) // Thursday
+		tests := []struct {
+			period Period
+			want   time.Time
+		}{
+			{PeriodWeek, time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC)},
+			{PeriodMonth, time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)},
+			{PeriodQuarter, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+			{PeriodYear, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		}
+		for _, tt := range tests {
+			got := tt.period.Start(now)
+			if !got.Equal(tt.want) {
+				t.Errorf("got %v %s start, want %v", got, tt.period, tt.want)
+			}
+		}
+
+		if got := PeriodAll.Start(now); got != nil {
+			t.Errorf("got %v all start, want nil", got)
+		}
+	})
+	t.Run("ranks senders, receivers and recipients", func(t *testing.T) {
+		var (
+			ctx   = context.Background()
+			db    = apptest.NewPostgresPool(t, ctx)
+			alice = usertest.CreateUser(t, ctx, db, "alice")
+			bob   = usertest.CreateUser(t, ctx, db, "bob")
+			_     = usertest.CreateUser(t, ctx, db, "carol")
+			g     = NewGetter(db)
+			tt    = transfer.NewTransferer(db)
+		)
+
+		for _, params := range []*transfer.TransfererTransferParams{
+			{DstUsername: "bob", SrcUserID: alice.ID, Amount: 10},
+			{DstUsername: "carol", SrcUserID: alice.ID, Amount: 10},
+			{DstUsername: "carol", SrcUserID: bob.ID, Amount: 30},
+		} {
+			_, err := tt.Transfer(ctx, params)
+			if err != nil {
+				t.Fatalf("got %v error", err)
+			}
+		}
+
+		tests := []struct {
+			kind          Kind
+			wantUsernames []string
+			wantValues    []int
+		}{
+			{KindSenders, []string{"bob", "alice"}, []int{30, 20}},
+			{KindReceivers, []string{"carol", "bob"}, []int{40, 10}},
+			{KindRecipients, []string{"alice", "bob"}, []int{2, 1}},
+		}
+		for _, test := range tests {
+			entries, err := g.GetLeaderboard(ctx, &GetterGetLeaderboardParams{Kind: test.kind, Limit: 10})
+			if err != nil {
+				t.Fatalf("got %v error", err)
+			}
+			if got, want := len(entries), len(test.wantUsernames); got != want {
+				t.Fatalf("got %d %s entries, want %d", got, test.kind, want)
+			}
+			for i, e := range entries {
+				if got, want := e.Username, test.wantUsernames[i]; got != want {
+					t.Errorf("got %s %s at %d, want %s", got, test.kind, i, want)
+				}
+				if got, want := e.Value, test.wantValues[i]; got != want {
+					t.Errorf("got %d %s value at %d, want %d", got, test.kind, i, want)
+				}
+				if got, want := e.Rank, i+1; got != want {
+					t.Errorf("got %d %s rank at %d, want %d", got, test.kind, i, want)
+				}
+			}
+		}
+
+		future := time.Now().Add(time.Hour)
+		entries, err := g.GetLeaderboard(ctx, &GetterGetLeaderboardParams{Kind: KindSenders, From: &future, Limit: 10})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(entries), 0; got != want {
+			t.Errorf("got %d entries in the future, want %d", got, want)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreateNotificationParams struct {
+	UserID  uuid.UUID
+	Kind    Kind
+	Message string
+	ItemID  *uuid.UUID
+}
+
+func (c *Creator) CreateNotification(ctx context.Context, params *CreatorCreateNotificationParams) (*Notification, error) {
+	n, err := createNotification(ctx, c.db, params.UserID, params.Kind, params.Message, params.ItemID)
+	if err != nil {
+		return nil, fmt.Errorf("notification.Creator: %w", err)
+	}
+	return n, nil
+}
+
+func createNotification(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, kind Kind, message string, itemID *uuid.UUID) (*Notification, error) {
+	query := `
+		INSERT INTO notifications (user_id, kind, message, item_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, user_id, kind, message, item_id, read_at
+	`
+	args := []any{userID, string(kind), message, itemID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	n, err := pgx.CollectExactlyOneRow(rows, RowToNotification)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// MaxNotifications is the maximum number of notifications returned at once.
+const MaxNotifications = 100
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetNotificationsByUserID returns up to [MaxNotifications] newest notifications of the user.
+func (g *Getter) GetNotificationsByUserID(ctx context.Context, userID uuid.UUID, unreadOnly bool) ([]*Notification, error) {
+	notifications, err := getNotificationsByUserID(ctx, g.db, userID, unreadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("notification.Getter: %w", err)
+	}
+	return notifications, nil
+}
+
+func getNotificationsByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, unreadOnly bool) ([]*Notification, error) {
+	query := `
+		SELECT n.id, n.created_at, n.user_id, n.kind, n.message, n.item_id, n.read_at,
+			   coalesce(i.name, '') as item_name
+		FROM notifications n
+		LEFT JOIN items i ON n.item_id = i.id
+		WHERE n.user_id = $1 AND (NOT $2 OR n.read_at IS NULL)
+		ORDER BY n.created_at DESC, n.id
+		LIMIT $3
+	`
+	args := []any{userID, unreadOnly, MaxNotifications}
+
+	rows, _ := db.Query(ctx, query, args...)
+	notifications, err := pgx.CollectRows(rows, RowToNotificationWithItemName)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrNotExist = errors.New("does not exist")
+
+type Kind string
+
+const (
+	KindPriceDrop        Kind = "price_drop"        // a wishlisted item got cheaper
+	KindBackInStock      Kind = "back_in_stock"     // a wishlisted item can be bought again
+	KindOutbid           Kind = "outbid"            // a higher bid was placed on an auction, the bid was refunded
+	KindAuctionWon       Kind = "auction_won"       // the highest bid won an auction
+	KindAuctionCancelled Kind = "auction_cancelled" // an auction was cancelled, the bid was refunded
+	KindRaffleWon        Kind = "raffle_won"        // a raffle ticket won a prize
+	KindRaffleCancelled  Kind = "raffle_cancelled"  // a raffle was cancelled, the tickets were refunded
+	KindBadgeAwarded     Kind = "badge_awarded"     // an achievement badge was awarded, maybe with bonus coins
+)
+
+// Notification is an in-app message for a user.
+type Notification struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UserID    uuid.UUID
+	Kind      Kind
+	Message   string
+	ItemID    *uuid.UUID // nil if the notification isn't about an item
+	ReadAt    *time.Time // nil if unread
+
+	ItemName string
+}
+
+type Row struct {
+	ID        uuid.UUID  `db:"id"`
+	CreatedAt time.Time  `db:"created_at"`
+	UserID    uuid.UUID  `db:"user_id"`
+	Kind      string     `db:"kind"`
+	Message   string     `db:"message"`
+	ItemID    *uuid.UUID `db:"item_id"`
+	ReadAt    *time.Time `db:"read_at"`
+}
+
+func RowToNotification(collectable pgx.CollectableRow) (*Notification, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		UserID:    collected.UserID,
+		Kind:      Kind(collected.Kind),
+		Message:   collected.Message,
+		ItemID:    collected.ItemID,
+		ReadAt:    collected.ReadAt,
+	}, nil
+}
+
+type RowWithItemName struct {
+	Row
+	ItemName string `db:"item_name"`
+}
+
+func RowToNotificationWithItemName(collectable pgx.CollectableRow) (*Notification, error) {
+	collected, err := pgx.RowToStructByName[RowWithItemName](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		UserID:    collected.UserID,
+		Kind:      Kind(collected.Kind),
+		Message:   collected.Message,
+		ItemID:    collected.ItemID,
+		ReadAt:    collected.ReadAt,
+		ItemName:  collected.ItemName,
+	}, nil
+}
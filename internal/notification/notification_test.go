@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestNotification(t *testing.T) {
+	t.Run("creates and reads notifications", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			nc  = NewCreator(db)
+			ng  = NewGetter(db)
+			nr  = NewReader(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		first, err := nc.CreateNotification(ctx, &CreatorCreateNotificationParams{
+			UserID:  alice.ID,
+			Kind:    KindPriceDrop,
+			Message: "first",
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = nc.CreateNotification(ctx, &CreatorCreateNotificationParams{
+			UserID:  alice.ID,
+			Kind:    KindBackInStock,
+			Message: "second",
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		err = nr.MarkRead(ctx, first.ID, bob.ID)
+		if got, want := err, ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		err = nr.MarkRead(ctx, uuid.New(), alice.ID)
+		if got, want := err, ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		err = nr.MarkRead(ctx, first.ID, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		unread, err := ng.GetNotificationsByUserID(ctx, alice.ID, true)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(unread), 1; got != want {
+			t.Fatalf("got %d unread notifications, want %d", got, want)
+		}
+		if got, want := unread[0].Message, "second"; got != want {
+			t.Errorf("got %q unread notification, want %q", got, want)
+		}
+
+		count, err := nr.MarkAllRead(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d marked notifications, want %d", got, want)
+		}
+
+		all, err := ng.GetNotificationsByUserID(ctx, alice.ID, false)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(all), 2; got != want {
+			t.Errorf("got %d notifications, want %d", got, want)
+		}
+	})
+}
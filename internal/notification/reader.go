@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Reader marks notifications as read.
+type Reader struct {
+	db app.PgxExecutor
+}
+
+func NewReader(db app.PgxExecutor) *Reader {
+	return &Reader{db: db}
+}
+
+// MarkRead marks the notification of the user as read.
+// It returns [ErrNotExist] if the user has no such notification.
+func (r *Reader) MarkRead(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	count, err := markRead(ctx, r.db, &id, userID)
+	if err != nil {
+		return fmt.Errorf("notification.Reader: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("notification.Reader: %w", ErrNotExist)
+	}
+	return nil
+}
+
+// MarkAllRead marks all notifications of the user as read and returns how many were unread.
+func (r *Reader) MarkAllRead(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := markRead(ctx, r.db, nil, userID)
+	if err != nil {
+		return 0, fmt.Errorf("notification.Reader: %w", err)
+	}
+	return count, nil
+}
+
+// markRead marks the notification or, if id is nil, all notifications of the user as read.
+// Notifications that are already read keep their read time but are counted.
+func markRead(ctx context.Context, db app.PgxExecutor, id *uuid.UUID, userID uuid.UUID) (int, error) {
+	query := `
+		UPDATE notifications
+		SET read_at = coalesce(read_at, now())
+		WHERE user_id = $2 AND ($1::uuid IS NULL OR id = $1) AND ($1::uuid IS NOT NULL OR read_at IS NULL)
+	`
+	args := []any{id, userID}
+
+	tag, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
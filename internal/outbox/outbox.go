@@ -0,0 +1,57 @@
+package outbox
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+var ErrNotExist = errors.New("does not exist")
+
+// Event is an [app.Event] written to the outbox in the transaction of its change.
+type Event struct {
+	ID             uuid.UUID
+	CreatedAt      time.Time
+	Kind           app.EventKind
+	UserID         uuid.UUID
+	EntityID       uuid.UUID
+	Data           []byte // JSON
+	DispatchedAt   *time.Time
+	HandledAt      *time.Time
+	HandleAttempts int
+}
+
+type Row struct {
+	ID             uuid.UUID  `db:"id"`
+	CreatedAt      time.Time  `db:"created_at"`
+	Kind           string     `db:"kind"`
+	UserID         uuid.UUID  `db:"user_id"`
+	EntityID       uuid.UUID  `db:"entity_id"`
+	Data           []byte     `db:"data"`
+	DispatchedAt   *time.Time `db:"dispatched_at"`
+	HandledAt      *time.Time `db:"handled_at"`
+	HandleAttempts int        `db:"handle_attempts"`
+}
+
+func RowToEvent(collectable pgx.CollectableRow) (*Event, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		ID:             collected.ID,
+		CreatedAt:      collected.CreatedAt,
+		Kind:           app.EventKind(collected.Kind),
+		UserID:         collected.UserID,
+		EntityID:       collected.EntityID,
+		Data:           collected.Data,
+		DispatchedAt:   collected.DispatchedAt,
+		HandledAt:      collected.HandledAt,
+		HandleAttempts: collected.HandleAttempts,
+	}, nil
+}
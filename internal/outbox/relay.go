@@ -0,0 +1,174 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+const (
+	// MaxHandleAttempts is the number of failed attempts after which an event is given up on.
+	MaxHandleAttempts = 5
+
+	handleRetryDelay = time.Minute
+)
+
+// Relay passes committed outbox events to an event handler,
+// so the handler sees every change however it was made, and only committed ones.
+// The handler is called in a nested transaction of the one that marks the event handled,
+// its changes are discarded if it fails, and the event is handled again later.
+type Relay struct {
+	db      app.PgxExecutor
+	handler app.EventHandler
+}
+
+func NewRelay(db app.PgxExecutor, h app.EventHandler) *Relay {
+	return &Relay{db: db, handler: h}
+}
+
+// RelayPending passes every unhandled event whose attempt is due to the handler in order and marks it handled.
+// Events the handler fails on are retried later until MaxHandleAttempts, so they don't hold up the others.
+// It returns the number of relayed events.
+func (r *Relay) RelayPending(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		relayed, err := r.relayOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("outbox.Relay: %w", err)
+		}
+		if !relayed {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (r *Relay) relayOne(ctx context.Context) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	e, err := getDueUnhandledEventForUpdate(ctx, tx)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	handleErr := r.handle(ctx, tx, e)
+	switch {
+	case handleErr == nil:
+		err = updateEventHandled(ctx, tx, e.ID)
+	case e.HandleAttempts+1 >= MaxHandleAttempts:
+		slog.Error("gave up handling event", "kind", e.Kind, "id", e.ID, "err", handleErr)
+		err = updateEventHandled(ctx, tx, e.ID)
+	default:
+		slog.Error("didn't handle event", "kind", e.Kind, "id", e.ID, "err", handleErr)
+		err = updateEventHandleRetry(ctx, tx, e.ID, e.HandleAttempts+1, time.Now().Add(handleRetryDelay))
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *Relay) handle(ctx context.Context, tx pgx.Tx, e *Event) error {
+	handleTx, err := tx.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		rollbackErr := handleTx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	err = r.handler.HandleEvent(ctx, handleTx, &app.Event{
+		Kind:   e.Kind,
+		UserID: e.UserID,
+		ID:     e.EntityID,
+		Data:   json.RawMessage(e.Data),
+	})
+	if err != nil {
+		return err
+	}
+
+	return handleTx.Commit(ctx)
+}
+
+func getDueUnhandledEventForUpdate(ctx context.Context, db app.PgxExecutor) (*Event, error) {
+	query := `
+		SELECT id, created_at, kind, user_id, entity_id, data, dispatched_at, handled_at, handle_attempts
+		FROM outbox_events
+		WHERE handled_at IS NULL AND next_handle_at <= now()
+		ORDER BY next_handle_at, created_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	e, err := pgx.CollectExactlyOneRow(rows, RowToEvent)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func updateEventHandled(ctx context.Context, db app.PgxExecutor, id uuid.UUID) error {
+	query := `
+		UPDATE outbox_events
+		SET handled_at = now()
+		WHERE id = $1
+	`
+	args := []any{id}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func updateEventHandleRetry(ctx context.Context, db app.PgxExecutor, id uuid.UUID, attempts int, nextHandleAt time.Time) error {
+	query := `
+		UPDATE outbox_events
+		SET handle_attempts = $2, next_handle_at = $3
+		WHERE id = $1
+	`
+	args := []any{id, attempts, nextHandleAt}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
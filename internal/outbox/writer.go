@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Writer struct {
+	db app.PgxExecutor
+}
+
+// NewWriter returns a Writer that writes events with db.
+// db should be the transaction of the change the events describe.
+func NewWriter(db app.PgxExecutor) *Writer {
+	return &Writer{db: db}
+}
+
+func (w *Writer) WriteEvents(ctx context.Context, events ...*app.Event) error {
+	for _, e := range events {
+		data := []byte("{}")
+		if e.Data != nil {
+			var err error
+			data, err = json.Marshal(e.Data)
+			if err != nil {
+				return fmt.Errorf("outbox.Writer: %w", err)
+			}
+		}
+
+		err := createEvent(ctx, w.db, e.Kind, e.UserID, e.ID, data)
+		if err != nil {
+			return fmt.Errorf("outbox.Writer: %w", err)
+		}
+	}
+	return nil
+}
+
+func createEvent(ctx context.Context, db app.PgxExecutor, kind app.EventKind, userID uuid.UUID, entityID uuid.UUID, data []byte) error {
+	query := `
+		INSERT INTO outbox_events (kind, user_id, entity_id, data)
+		VALUES ($1, $2, $3, $4)
+	`
+	args := []any{string(kind), userID, entityID, data}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
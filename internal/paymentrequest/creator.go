@@ -0,0 +1,78 @@
+package paymentrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreatePaymentRequestParams struct {
+	RequesterID   uuid.UUID
+	PayerUsername string
+	Amount        int
+	Note          string
+	TTL           time.Duration // time after which the request expires
+}
+
+func (c *Creator) CreatePaymentRequest(ctx context.Context, params *CreatorCreatePaymentRequestParams) (*PaymentRequest, error) {
+	payer, err := user.NewGetter(c.db).GetUserByUsername(ctx, params.PayerUsername)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, fmt.Errorf("paymentrequest.Creator: %w", ErrPayerNotFound)
+		}
+		return nil, fmt.Errorf("paymentrequest.Creator: %w", err)
+	}
+
+	if payer.ID == params.RequesterID {
+		return nil, fmt.Errorf("paymentrequest.Creator: %w", ErrRequesterAndPayerEqual)
+	}
+
+	expiresAt := time.Now().Add(params.TTL)
+	pr, err := createPaymentRequest(ctx, c.db, params.RequesterID, payer.ID, params.Amount, params.Note, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Creator: %w", err)
+	}
+	pr.PayerUsername = payer.Username
+
+	return pr, nil
+}
+
+func createPaymentRequest(
+	ctx context.Context,
+	db app.PgxExecutor,
+	requesterID uuid.UUID,
+	payerID uuid.UUID,
+	amount int,
+	note string,
+	expiresAt time.Time,
+) (*PaymentRequest, error) {
+	query := `
+		INSERT INTO payment_requests (requester_id, payer_id, amount, note, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, requester_id, payer_id, amount, note, status, expires_at, decided_at, transfer_id
+	`
+	args := []any{requesterID, payerID, amount, note, expiresAt}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pr, err := pgx.CollectExactlyOneRow(rows, RowToPaymentRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, nil
+}
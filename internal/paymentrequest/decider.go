@@ -0,0 +1,160 @@
+package paymentrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+// Decider accepts and declines payment requests on behalf of their payers.
+type Decider struct {
+	db app.PgxExecutor
+}
+
+func NewDecider(db app.PgxExecutor) *Decider {
+	return &Decider{db: db}
+}
+
+// Accept transfers the requested amount from the payer to the requester and marks the request accepted.
+// If the transfer needs approval, the request is marked approving instead
+// and is settled when the transfer is approved, rejected or expires.
+// The transfer and the request update are committed together.
+// Errors of [transfer.Transferer], such as coin.ErrNotEnough, are returned as is.
+func (d *Decider) Accept(
+	ctx context.Context,
+	id uuid.UUID,
+	payerID uuid.UUID,
+) (*PaymentRequest, *transfer.Transfer, error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	pr, err := getPendingPaymentRequestForUpdate(ctx, tx, id, payerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+
+	t, err := transfer.NewTransferer(tx).TransferByUsername(ctx, pr.RequesterUsername, payerID, pr.Amount)
+	if err != nil {
+		return nil, nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+
+	status := StatusAccepted
+	if t.Status == transfer.StatusPending {
+		status = StatusApproving
+	}
+
+	pr, err = updatePaymentRequestDecision(ctx, tx, id, status, &t.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+
+	return pr, t, nil
+}
+
+// Decline marks the request declined without transferring coins.
+func (d *Decider) Decline(ctx context.Context, id uuid.UUID, payerID uuid.UUID) (*PaymentRequest, error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	_, err = getPendingPaymentRequestForUpdate(ctx, tx, id, payerID)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+
+	pr, err := updatePaymentRequestDecision(ctx, tx, id, StatusDeclined, nil)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Decider: %w", err)
+	}
+
+	return pr, nil
+}
+
+// getPendingPaymentRequestForUpdate locks the payment request
+// and checks that the payer can still decide on it.
+func getPendingPaymentRequestForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID, payerID uuid.UUID) (*PaymentRequest, error) {
+	query := `
+		SELECT pr.id, pr.created_at, pr.requester_id, pr.payer_id, pr.amount, pr.note,
+			   pr.status, pr.expires_at, pr.decided_at, pr.transfer_id,
+			   requester.username as requester_username,
+			   payer.username as payer_username
+		FROM payment_requests pr
+		JOIN users requester ON pr.requester_id = requester.id
+		JOIN users payer ON pr.payer_id = payer.id
+		WHERE pr.id = $1
+		FOR UPDATE OF pr
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pr, err := pgx.CollectExactlyOneRow(rows, RowToPaymentRequestWithUsernames)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	if pr.PayerID != payerID {
+		return nil, ErrNotPayer
+	}
+	if pr.Status != StatusPending || !pr.ExpiresAt.After(time.Now()) {
+		return nil, ErrNotPending
+	}
+
+	return pr, nil
+}
+
+func updatePaymentRequestDecision(ctx context.Context, db app.PgxExecutor, id uuid.UUID, status Status, transferID *uuid.UUID) (*PaymentRequest, error) {
+	query := `
+		UPDATE payment_requests
+		SET status = $2, decided_at = now(), transfer_id = $3
+		WHERE id = $1
+		RETURNING id, created_at, requester_id, payer_id, amount, note, status, expires_at, decided_at, transfer_id
+	`
+	args := []any{id, string(status), transferID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pr, err := pgx.CollectExactlyOneRow(rows, RowToPaymentRequest)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return pr, nil
+}
@@ -0,0 +1,42 @@
+package paymentrequest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Expirer expires pending payment requests that weren't decided in time.
+type Expirer struct {
+	db app.PgxExecutor
+}
+
+func NewExpirer(db app.PgxExecutor) *Expirer {
+	return &Expirer{db: db}
+}
+
+// ExpirePending returns the number of expired payment requests.
+// Nothing is held for pending payment requests, so they are expired with a single update.
+func (e *Expirer) ExpirePending(ctx context.Context) (int, error) {
+	count, err := expirePending(ctx, e.db)
+	if err != nil {
+		return 0, fmt.Errorf("paymentrequest.Expirer: %w", err)
+	}
+	return count, nil
+}
+
+func expirePending(ctx context.Context, db app.PgxExecutor) (int, error) {
+	query := `
+		UPDATE payment_requests
+		SET status = 'expired', decided_at = now()
+		WHERE status = 'pending' AND expires_at <= now()
+	`
+
+	tag, err := db.Exec(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
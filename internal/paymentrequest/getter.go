@@ -0,0 +1,118 @@
+package paymentrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetPaymentRequest returns [ErrNotExist] if the payment request doesn't exist.
+func (g *Getter) GetPaymentRequest(ctx context.Context, id uuid.UUID) (*PaymentRequest, error) {
+	pr, err := getPaymentRequest(ctx, g.db, id)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Getter: %w", err)
+	}
+	return pr, nil
+}
+
+// GetPendingPaymentRequestsByPayerID returns unexpired pending payment requests the payer should decide on.
+func (g *Getter) GetPendingPaymentRequestsByPayerID(ctx context.Context, payerID uuid.UUID) ([]*PaymentRequest, error) {
+	prs, err := getPendingPaymentRequestsByPayerID(ctx, g.db, payerID)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Getter: %w", err)
+	}
+	return prs, nil
+}
+
+// GetPaymentRequestsByRequesterID returns payment requests the requester created, most recent first.
+func (g *Getter) GetPaymentRequestsByRequesterID(ctx context.Context, requesterID uuid.UUID) ([]*PaymentRequest, error) {
+	prs, err := getPaymentRequestsByRequesterID(ctx, g.db, requesterID)
+	if err != nil {
+		return nil, fmt.Errorf("paymentrequest.Getter: %w", err)
+	}
+	return prs, nil
+}
+
+func getPaymentRequest(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*PaymentRequest, error) {
+	query := `
+		SELECT pr.id, pr.created_at, pr.requester_id, pr.payer_id, pr.amount, pr.note,
+			   pr.status, pr.expires_at, pr.decided_at, pr.transfer_id,
+			   requester.username as requester_username,
+			   payer.username as payer_username
+		FROM payment_requests pr
+		JOIN users requester ON pr.requester_id = requester.id
+		JOIN users payer ON pr.payer_id = payer.id
+		WHERE pr.id = $1
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pr, err := pgx.CollectExactlyOneRow(rows, RowToPaymentRequestWithUsernames)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+func getPendingPaymentRequestsByPayerID(ctx context.Context, db app.PgxExecutor, payerID uuid.UUID) ([]*PaymentRequest, error) {
+	query := `
+		SELECT pr.id, pr.created_at, pr.requester_id, pr.payer_id, pr.amount, pr.note,
+			   pr.status, pr.expires_at, pr.decided_at, pr.transfer_id,
+			   requester.username as requester_username,
+			   payer.username as payer_username
+		FROM payment_requests pr
+		JOIN users requester ON pr.requester_id = requester.id
+		JOIN users payer ON pr.payer_id = payer.id
+		WHERE pr.payer_id = $1 AND pr.status = 'pending' AND pr.expires_at > now()
+		ORDER BY pr.created_at DESC, pr.id
+	`
+	args := []any{payerID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	prs, err := pgx.CollectRows(rows, RowToPaymentRequestWithUsernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
+
+func getPaymentRequestsByRequesterID(ctx context.Context, db app.PgxExecutor, requesterID uuid.UUID) ([]*PaymentRequest, error) {
+	query := `
+		SELECT pr.id, pr.created_at, pr.requester_id, pr.payer_id, pr.amount, pr.note,
+			   pr.status, pr.expires_at, pr.decided_at, pr.transfer_id,
+			   requester.username as requester_username,
+			   payer.username as payer_username
+		FROM payment_requests pr
+		JOIN users requester ON pr.requester_id = requester.id
+		JOIN users payer ON pr.payer_id = payer.id
+		WHERE pr.requester_id = $1
+		ORDER BY pr.created_at DESC, pr.id
+	`
+	args := []any{requesterID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	prs, err := pgx.CollectRows(rows, RowToPaymentRequestWithUsernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return prs, nil
+}
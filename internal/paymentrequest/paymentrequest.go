@@ -0,0 +1,106 @@
+package paymentrequest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrNotExist               = errors.New("does not exist")
+	ErrNotPending             = errors.New("not pending")
+	ErrNotPayer               = errors.New("not the payer")
+	ErrPayerNotFound          = errors.New("payer not found")
+	ErrRequesterAndPayerEqual = errors.New("requester and payer are equal")
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusApproving Status = "approving" // accepted by the payer, the transfer is waiting for approval
+	StatusAccepted  Status = "accepted"  // accepted by the payer, the transfer is completed
+	StatusDeclined  Status = "declined"  // declined by the payer or the transfer was rejected
+	StatusExpired   Status = "expired"   // not decided in time by the payer or approvers
+)
+
+// PaymentRequest is a request from the requester to the payer to send coins.
+// When the payer accepts it, the coins are transferred from the payer to the requester.
+type PaymentRequest struct {
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	RequesterID uuid.UUID
+	PayerID     uuid.UUID
+	Amount      int
+	Note        string
+	Status      Status
+	ExpiresAt   time.Time
+	DecidedAt   *time.Time
+	TransferID  *uuid.UUID
+
+	RequesterUsername string
+	PayerUsername     string
+}
+
+type Row struct {
+	ID          uuid.UUID  `db:"id"`
+	CreatedAt   time.Time  `db:"created_at"`
+	RequesterID uuid.UUID  `db:"requester_id"`
+	PayerID     uuid.UUID  `db:"payer_id"`
+	Amount      int        `db:"amount"`
+	Note        string     `db:"note"`
+	Status      string     `db:"status"`
+	ExpiresAt   time.Time  `db:"expires_at"`
+	DecidedAt   *time.Time `db:"decided_at"`
+	TransferID  *uuid.UUID `db:"transfer_id"`
+}
+
+func RowToPaymentRequest(collectable pgx.CollectableRow) (*PaymentRequest, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaymentRequest{
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		RequesterID: collected.RequesterID,
+		PayerID:     collected.PayerID,
+		Amount:      collected.Amount,
+		Note:        collected.Note,
+		Status:      Status(collected.Status),
+		ExpiresAt:   collected.ExpiresAt,
+		DecidedAt:   collected.DecidedAt,
+		TransferID:  collected.TransferID,
+	}, nil
+}
+
+type RowWithUsernames struct {
+	Row
+	RequesterUsername string `db:"requester_username"`
+	PayerUsername     string `db:"payer_username"`
+}
+
+func RowToPaymentRequestWithUsernames(collectable pgx.CollectableRow) (*PaymentRequest, error) {
+	collected, err := pgx.RowToStructByName[RowWithUsernames](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaymentRequest{
+		ID:                collected.ID,
+		CreatedAt:         collected.CreatedAt,
+		RequesterID:       collected.RequesterID,
+		PayerID:           collected.PayerID,
+		Amount:            collected.Amount,
+		Note:              collected.Note,
+		Status:            Status(collected.Status),
+		ExpiresAt:         collected.ExpiresAt,
+		DecidedAt:         collected.DecidedAt,
+		TransferID:        collected.TransferID,
+		RequesterUsername: collected.RequesterUsername,
+		PayerUsername:     collected.PayerUsername,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package paymentrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestPaymentRequest(t *testing.T) {
+	t.Run("creates, accepts and declines payment requests", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			prc = NewCreator(db)
+			prd = NewDecider(db)
+			prg = NewGetter(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		initialAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		accepted, err := prc.CreatePaymentRequest(ctx, &CreatorCreatePaymentRequestParams{
+			RequesterID:   alice.ID,
+			PayerUsername: "bob",
+			Amount:        30,
+			Note:          "gift for carol",
+			TTL:           time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		declined, err := prc.CreatePaymentRequest(ctx, &CreatorCreatePaymentRequestParams{
+			RequesterID:   alice.ID,
+			PayerUsername: "bob",
+			Amount:        40,
+			TTL:           time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		incoming, err := prg.GetPendingPaymentRequestsByPayerID(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(incoming), 2; got != want {
+			t.Fatalf("got %d incoming payment requests, want %d", got, want)
+		}
+
+		_, _, err = prd.Accept(ctx, accepted.ID, alice.ID)
+		if got, want := err, ErrNotPayer; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, _, err = prd.Accept(ctx, accepted.ID, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = prd.Decline(ctx, accepted.ID, bob.ID)
+		if got, want := err, ErrNotPending; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = prd.Decline(ctx, declined.ID, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		outgoing, err := prg.GetPaymentRequestsByRequesterID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := aliceBalance, initialAliceBalance+30; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+		gotStatuses := make(map[string]Status)
+		for _, pr := range outgoing {
+			gotStatuses[pr.Note] = pr.Status
+		}
+		if got, want := gotStatuses["gift for carol"], StatusAccepted; got != want {
+			t.Errorf("got %s accepted payment request status, want %s", got, want)
+		}
+		if got, want := gotStatuses[""], StatusDeclined; got != want {
+			t.Errorf("got %s declined payment request status, want %s", got, want)
+		}
+	})
+
+	t.Run("settles accepted payment requests when their transfers are decided", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			prc = NewCreator(db)
+			prd = NewDecider(db)
+			prg = NewGetter(db)
+			ta  = transfer.NewApprover(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+		carol := usertest.CreateUser(t, ctx, db, "carol")
+
+		err := auth.NewAdminAuthorizer(db).GrantAdminByUsername(ctx, "carol")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = transfer.NewLimitsUpdater(db).UpdateLimits(ctx, &transfer.Limits{
+			RecipientPeriodDays:  30,
+			ApprovalThreshold:    100,
+			ApprovalTimeoutHours: 24,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		approved, err := prc.CreatePaymentRequest(ctx, &CreatorCreatePaymentRequestParams{
+			RequesterID:   alice.ID,
+			PayerUsername: "bob",
+			Amount:        101,
+			Note:          "approved",
+			TTL:           time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		rejected, err := prc.CreatePaymentRequest(ctx, &CreatorCreatePaymentRequestParams{
+			RequesterID:   alice.ID,
+			PayerUsername: "bob",
+			Amount:        102,
+			Note:          "rejected",
+			TTL:           time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		pr, approvedTransfer, err := prd.Accept(ctx, approved.ID, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := pr.Status, StatusApproving; got != want {
+			t.Fatalf("got %s status, want %s", got, want)
+		}
+		_, rejectedTransfer, err := prd.Accept(ctx, rejected.ID, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = prd.Decline(ctx, approved.ID, bob.ID)
+		if got, want := err, ErrNotPending; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		_, err = ta.Approve(ctx, approvedTransfer.ID, carol.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ta.Reject(ctx, rejectedTransfer.ID, carol.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		outgoing, err := prg.GetPaymentRequestsByRequesterID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		gotStatuses := make(map[string]Status)
+		for _, pr := range outgoing {
+			gotStatuses[pr.Note] = pr.Status
+		}
+		if got, want := gotStatuses["approved"], StatusAccepted; got != want {
+			t.Errorf("got %s approved payment request status, want %s", got, want)
+		}
+		if got, want := gotStatuses["rejected"], StatusDeclined; got != want {
+			t.Errorf("got %s rejected payment request status, want %s", got, want)
+		}
+	})
+
+	t.Run("expires payment requests", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			prc = NewCreator(db)
+			prd = NewDecider(db)
+			pre = NewExpirer(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		pr, err := prc.CreatePaymentRequest(ctx, &CreatorCreatePaymentRequestParams{
+			RequesterID:   alice.ID,
+			PayerUsername: "bob",
+			Amount:        30,
+			TTL:           time.Nanosecond,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		count, err := pre.ExpirePending(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d expired payment requests, want %d", got, want)
+		}
+
+		_, _, err = prd.Accept(ctx, pr.ID, bob.ID)
+		if got, want := err, ErrNotPending; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+func (g *Getter) GetProfile(ctx context.Context, userID uuid.UUID) (*Profile, error) {
+	p, err := getProfile(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile.Getter: %w", err)
+	}
+	return p, nil
+}
+
+func (g *Getter) GetProfileByUsername(ctx context.Context, username string) (*Profile, error) {
+	p, err := getProfileByUsername(ctx, g.db, username)
+	if err != nil {
+		return nil, fmt.Errorf("profile.Getter: %w", err)
+	}
+	return p, nil
+}
+
+type GetterSearchProfilesParams struct {
+	Query  string
+	Limit  int
+	Offset int
+}
+
+// SearchProfiles returns profiles of active users whose username or display name
+// start with or are similar to the query. Prefix matches are returned first.
+// An empty query returns all profiles of active users ordered by username.
+func (g *Getter) SearchProfiles(ctx context.Context, params *GetterSearchProfilesParams) ([]*Profile, error) {
+	profiles, err := searchProfiles(ctx, g.db, params)
+	if err != nil {
+		return nil, fmt.Errorf("profile.Getter: %w", err)
+	}
+	return profiles, nil
+}
+
+func getProfile(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (*Profile, error) {
+	query := `
+		SELECT u.id AS user_id, u.username,
+			   coalesce(p.display_name, '') AS display_name,
+			   coalesce(p.department, '') AS department,
+			   coalesce(p.title, '') AS title,
+			   coalesce(p.avatar_url, '') AS avatar_url,
+			   p.start_date
+		FROM users u
+		LEFT JOIN profiles p ON u.id = p.user_id
+		WHERE u.id = $1
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	p, err := pgx.CollectExactlyOneRow(rows, RowToProfile)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func getProfileByUsername(ctx context.Context, db app.PgxExecutor, username string) (*Profile, error) {
+	query := `
+		SELECT u.id AS user_id, u.username,
+			   coalesce(p.display_name, '') AS display_name,
+			   coalesce(p.department, '') AS department,
+			   coalesce(p.title, '') AS title,
+			   coalesce(p.avatar_url, '') AS avatar_url,
+			   p.start_date
+		FROM users u
+		LEFT JOIN profiles p ON u.id = p.user_id
+		WHERE u.username = $1
+	`
+	args := []any{username}
+
+	rows, _ := db.Query(ctx, query, args...)
+	p, err := pgx.CollectExactlyOneRow(rows, RowToProfile)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func searchProfiles(ctx context.Context, db app.PgxExecutor, params *GetterSearchProfilesParams) ([]*Profile, error) {
+	query := `
+		SELECT u.id AS user_id, u.username,
+			   coalesce(p.display_name, '') AS display_name,
+			   coalesce(p.department, '') AS department,
+			   coalesce(p.title, '') AS title,
+			   coalesce(p.avatar_url, '') AS avatar_url,
+			   p.start_date
+		FROM users u
+		LEFT JOIN profiles p ON u.id = p.user_id
+		WHERE u.deactivated_at IS NULL
+		  AND ($1 = ''
+			   OR u.username ILIKE $2
+			   OR p.display_name ILIKE $2
+			   OR p.display_name ILIKE $3
+			   OR $1 <% u.username
+			   OR $1 <% p.display_name)
+		ORDER BY (u.username ILIKE $2
+				  OR coalesce(p.display_name, '') ILIKE $2
+				  OR coalesce(p.display_name, '') ILIKE $3) DESC,
+				 greatest(word_similarity($1, u.username), word_similarity($1, coalesce(p.display_name, ''))) DESC,
+				 u.username
+		LIMIT $4
+		OFFSET $5
+	`
+	prefix := escapeLikePattern(params.Query) + "%"
+	wordPrefix := "% " + prefix
+	args := []any{params.Query, prefix, wordPrefix, params.Limit, params.Offset}
+
+	rows, _ := db.Query(ctx, query, args...)
+	profiles, err := pgx.CollectRows(rows, RowToProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// escapeLikePattern escapes LIKE pattern metacharacters in s
+// so that s is matched literally.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -0,0 +1,47 @@
+package profile
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Profile represents the public profile of a user.
+// Users without a saved profile have a profile with empty fields.
+type Profile struct {
+	UserID      uuid.UUID
+	Username    string
+	DisplayName string
+	Department  string
+	Title       string
+	AvatarURL   string
+	StartDate   *time.Time
+}
+
+type Row struct {
+	UserID      uuid.UUID  `db:"user_id"`
+	Username    string     `db:"username"`
+	DisplayName string     `db:"display_name"`
+	Department  string     `db:"department"`
+	Title       string     `db:"title"`
+	AvatarURL   string     `db:"avatar_url"`
+	StartDate   *time.Time `db:"start_date"`
+}
+
+func RowToProfile(collectable pgx.CollectableRow) (*Profile, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		UserID:      collected.UserID,
+		Username:    collected.Username,
+		DisplayName: collected.DisplayName,
+		Department:  collected.Department,
+		Title:       collected.Title,
+		AvatarURL:   collected.AvatarURL,
+		StartDate:   collected.StartDate,
+	}, nil
+}
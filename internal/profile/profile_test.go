@@ -0,0 +1,146 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/user"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestProfile(t *testing.T) {
+	var (
+		ctx = context.Background()
+		db  = apptest.NewPostgresPool(t, ctx)
+	)
+
+	t.Run("gets empty profile of user without profile", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			g     = NewGetter(tx)
+		)
+
+		p, err := g.GetProfile(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := p.Username, "alice"; got != want {
+			t.Errorf("got %s username, want %s", got, want)
+		}
+		if got, want := p.DisplayName, ""; got != want {
+			t.Errorf("got %q display name, want %q", got, want)
+		}
+		if p.StartDate != nil {
+			t.Errorf("got %v start date, want nil", p.StartDate)
+		}
+	})
+
+	t.Run("updates and gets profile by username", func(t *testing.T) {
+		var (
+			tx        = apptest.BeginPostgresTx(t, ctx, db)
+			alice     = usertest.CreateUser(t, ctx, tx, "alice")
+			g         = NewGetter(tx)
+			u         = NewUpdater(tx)
+			startDate = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		)
+
+		_, err := u.UpdateProfile(ctx, alice.ID, &UpdaterUpdateProfileParams{
+			DisplayName: "Alice Smith",
+			Department:  "Engineering",
+			Title:       "Engineer",
+			StartDate:   &startDate,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = u.UpdateProfile(ctx, alice.ID, &UpdaterUpdateProfileParams{
+			DisplayName: "Alice Jones",
+			Department:  "Engineering",
+			Title:       "Senior Engineer",
+			StartDate:   &startDate,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		p, err := g.GetProfileByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := p.DisplayName, "Alice Jones"; got != want {
+			t.Errorf("got %q display name, want %q", got, want)
+		}
+		if got, want := p.Title, "Senior Engineer"; got != want {
+			t.Errorf("got %q title, want %q", got, want)
+		}
+		if p.StartDate == nil || !p.StartDate.Equal(startDate) {
+			t.Errorf("got %v start date, want %v", p.StartDate, startDate)
+		}
+	})
+
+	t.Run("doesn't get profile by nonexistent username", func(t *testing.T) {
+		var (
+			tx = apptest.BeginPostgresTx(t, ctx, db)
+			g  = NewGetter(tx)
+		)
+
+		_, err := g.GetProfileByUsername(ctx, "nonexistent")
+		if got, want := err, user.ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+
+	t.Run("searches profiles by username and display name prefix", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			_     = usertest.CreateUser(t, ctx, tx, "bob")
+			carol = usertest.CreateUser(t, ctx, tx, "carol")
+			g     = NewGetter(tx)
+			u     = NewUpdater(tx)
+		)
+
+		_, err := u.UpdateProfile(ctx, carol.ID, &UpdaterUpdateProfileParams{DisplayName: "Carol Alison"})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = user.NewDeactivator(tx).Deactivate(ctx, alice.Username)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		profiles, err := g.SearchProfiles(ctx, &GetterSearchProfilesParams{Query: "ali", Limit: 10})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		gotUsernames := make([]string, len(profiles))
+		for i, p := range profiles {
+			gotUsernames[i] = p.Username
+		}
+		wantUsernames := []string{"carol"}
+
+		if got, want := gotUsernames, wantUsernames; !slices.Equal(got, want) {
+			t.Fatalf("got %v usernames, want %v", got, want)
+		}
+
+		err = user.NewDeactivator(tx).Reactivate(ctx, alice.Username)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		profiles, err = g.SearchProfiles(ctx, &GetterSearchProfilesParams{Query: "ali", Limit: 10})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(profiles), 2; got != want {
+			t.Fatalf("got %d profiles after reactivating, want %d", got, want)
+		}
+	})
+}
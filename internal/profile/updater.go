@@ -0,0 +1,73 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Updater struct {
+	db app.PgxExecutor
+}
+
+func NewUpdater(db app.PgxExecutor) *Updater {
+	return &Updater{db: db}
+}
+
+type UpdaterUpdateProfileParams struct {
+	DisplayName string
+	Department  string
+	Title       string
+	AvatarURL   string
+	StartDate   *time.Time
+}
+
+// UpdateProfile replaces the profile of the user with the provided values.
+func (u *Updater) UpdateProfile(ctx context.Context, userID uuid.UUID, params *UpdaterUpdateProfileParams) (*Profile, error) {
+	err := upsertProfile(ctx, u.db, userID, params)
+	if err != nil {
+		return nil, fmt.Errorf("profile.Updater: %w", err)
+	}
+	p, err := getProfile(ctx, u.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("profile.Updater: %w", err)
+	}
+	return p, nil
+}
+
+func upsertProfile(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, params *UpdaterUpdateProfileParams) error {
+	query := `
+		INSERT INTO profiles (user_id, display_name, department, title, avatar_url, start_date)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE
+		SET display_name = excluded.display_name,
+			department = excluded.department,
+			title = excluded.title,
+			avatar_url = excluded.avatar_url,
+			start_date = excluded.start_date
+	`
+	args := []any{userID, params.DisplayName, params.Department, params.Title, params.AvatarURL, params.StartDate}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "profiles_user_id_fkey") {
+			return user.ErrNotExist
+		}
+		return err
+	}
+
+	return nil
+}
+
+func isConstraintPgError(e *pgconn.PgError, constraint string) bool {
+	return pgerrcode.IsIntegrityConstraintViolation(e.Code) && e.ConstraintName == constraint
+}
@@ -0,0 +1,103 @@
+package promo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Creator creates promo codes.
+// It should only be used on behalf of admins.
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreatePromoCodeParams struct {
+	CreatedBy      uuid.UUID
+	Code           string
+	Kind           Kind
+	Value          int
+	MaxRedemptions *int
+	NewUserDays    *int
+	ExpiresAt      *time.Time
+}
+
+func (c *Creator) CreatePromoCode(ctx context.Context, params *CreatorCreatePromoCodeParams) (*PromoCode, error) {
+	code := NormalizeCode(params.Code)
+	if !codeRegexp.MatchString(code) {
+		return nil, fmt.Errorf("promo.Creator: %w", ErrInvalidCode)
+	}
+	if !params.Kind.Valid() || params.Value <= 0 || (params.Kind == KindPercent && params.Value > 100) {
+		return nil, fmt.Errorf("promo.Creator: %w", ErrInvalidValue)
+	}
+	if params.MaxRedemptions != nil && *params.MaxRedemptions <= 0 {
+		return nil, fmt.Errorf("promo.Creator: %w", ErrInvalidValue)
+	}
+	if params.NewUserDays != nil && *params.NewUserDays <= 0 {
+		return nil, fmt.Errorf("promo.Creator: %w", ErrInvalidValue)
+	}
+
+	pc, err := createPromoCode(
+		ctx,
+		c.db,
+		params.CreatedBy,
+		code,
+		params.Kind,
+		params.Value,
+		params.MaxRedemptions,
+		params.NewUserDays,
+		params.ExpiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Creator: %w", err)
+	}
+
+	return pc, nil
+}
+
+func createPromoCode(
+	ctx context.Context,
+	db app.PgxExecutor,
+	createdBy uuid.UUID,
+	code string,
+	kind Kind,
+	value int,
+	maxRedemptions *int,
+	newUserDays *int,
+	expiresAt *time.Time,
+) (*PromoCode, error) {
+	query := `
+		INSERT INTO promo_codes (created_by, code, kind, value, max_redemptions, new_user_days, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, created_by, code, kind, value, max_redemptions, redemption_count, new_user_days, expires_at
+	`
+	args := []any{createdBy, code, string(kind), value, maxRedemptions, newUserDays, expiresAt}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pc, err := pgx.CollectExactlyOneRow(rows, RowToPromoCode)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "promo_codes_code_idx") {
+			return nil, ErrExist
+		}
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func isConstraintPgError(e *pgconn.PgError, constraint string) bool {
+	return pgerrcode.IsIntegrityConstraintViolation(e.Code) && e.ConstraintName == constraint
+}
@@ -0,0 +1,104 @@
+package promo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+func (g *Getter) GetPromoCodes(ctx context.Context) ([]*PromoCode, error) {
+	pcs, err := getPromoCodes(ctx, g.db)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Getter: %w", err)
+	}
+	return pcs, nil
+}
+
+func (g *Getter) GetPromoCodeByCode(ctx context.Context, code string) (*PromoCode, error) {
+	pc, err := getPromoCodeByCode(ctx, g.db, NormalizeCode(code))
+	if err != nil {
+		return nil, fmt.Errorf("promo.Getter: %w", err)
+	}
+	return pc, nil
+}
+
+// GetRedemptionsByPromoCodeID returns redemptions of the promo code, newest first.
+func (g *Getter) GetRedemptionsByPromoCodeID(ctx context.Context, promoCodeID uuid.UUID) ([]*Redemption, error) {
+	redemptions, err := getRedemptionsByPromoCodeID(ctx, g.db, promoCodeID)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Getter: %w", err)
+	}
+	return redemptions, nil
+}
+
+func getPromoCodes(ctx context.Context, db app.PgxExecutor) ([]*PromoCode, error) {
+	query := `
+		SELECT id, created_at, created_by, code, kind, value, max_redemptions, redemption_count, new_user_days, expires_at
+		FROM promo_codes
+		ORDER BY created_at DESC, id
+	`
+
+	rows, _ := db.Query(ctx, query)
+	pcs, err := pgx.CollectRows(rows, RowToPromoCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return pcs, nil
+}
+
+func getPromoCodeByCode(ctx context.Context, db app.PgxExecutor, code string) (*PromoCode, error) {
+	query := `
+		SELECT id, created_at, created_by, code, kind, value, max_redemptions, redemption_count, new_user_days, expires_at
+		FROM promo_codes
+		WHERE code = $1
+	`
+	args := []any{code}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pc, err := pgx.CollectExactlyOneRow(rows, RowToPromoCode)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func getRedemptionsByPromoCodeID(ctx context.Context, db app.PgxExecutor, promoCodeID uuid.UUID) ([]*Redemption, error) {
+	query := `
+		SELECT r.id, r.created_at, r.promo_code_id, r.user_id, r.amount,
+			   pc.code as code,
+			   pc.kind as kind,
+			   u.username as username
+		FROM promo_code_redemptions r
+		JOIN promo_codes pc ON r.promo_code_id = pc.id
+		JOIN users u ON r.user_id = u.id
+		WHERE r.promo_code_id = $1
+		ORDER BY r.created_at DESC, r.id
+	`
+	args := []any{promoCodeID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	redemptions, err := pgx.CollectRows(rows, RowToRedemptionWithUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	return redemptions, nil
+}
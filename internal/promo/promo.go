@@ -0,0 +1,172 @@
+package promo
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrNotExist        = errors.New("does not exist")
+	ErrExist           = errors.New("already exists")
+	ErrInvalidCode     = errors.New("invalid code")
+	ErrInvalidValue    = errors.New("invalid value")
+	ErrExpired         = errors.New("expired")
+	ErrExhausted       = errors.New("redemption limit reached")
+	ErrAlreadyRedeemed = errors.New("already redeemed")
+	ErrNotNewUser      = errors.New("not a new user")
+	ErrNotCoins        = errors.New("not a coins promo code")
+	ErrNotDiscount     = errors.New("not a discount promo code")
+)
+
+type Kind string
+
+const (
+	KindCoins   Kind = "coins"   // value is coins credited to the user
+	KindPercent Kind = "percent" // value is percent off a purchase
+	KindFixed   Kind = "fixed"   // value is coins off a purchase
+)
+
+func (k Kind) Valid() bool {
+	switch k {
+	case KindCoins, KindPercent, KindFixed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDiscount reports whether promo codes of the kind are applied to purchases.
+func (k Kind) IsDiscount() bool {
+	return k == KindPercent || k == KindFixed
+}
+
+var codeRegexp = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// NormalizeCode returns the code in upper case, so codes are case-insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+type PromoCode struct {
+	ID              uuid.UUID
+	CreatedAt       time.Time
+	CreatedBy       uuid.UUID
+	Code            string
+	Kind            Kind
+	Value           int
+	MaxRedemptions  *int // nil if unlimited
+	RedemptionCount int
+	NewUserDays     *int // if set, only users created within this many days can redeem
+	ExpiresAt       *time.Time
+}
+
+// Price returns the price discounted by the promo code.
+// Percent discounts are rounded down, so the price is rounded up.
+func (pc *PromoCode) Price(price int) int {
+	switch pc.Kind {
+	case KindPercent:
+		price -= price * pc.Value / 100
+	case KindFixed:
+		price -= pc.Value
+	}
+	return max(price, 0)
+}
+
+type Row struct {
+	ID              uuid.UUID  `db:"id"`
+	CreatedAt       time.Time  `db:"created_at"`
+	CreatedBy       uuid.UUID  `db:"created_by"`
+	Code            string     `db:"code"`
+	Kind            string     `db:"kind"`
+	Value           int        `db:"value"`
+	MaxRedemptions  *int       `db:"max_redemptions"`
+	RedemptionCount int        `db:"redemption_count"`
+	NewUserDays     *int       `db:"new_user_days"`
+	ExpiresAt       *time.Time `db:"expires_at"`
+}
+
+func RowToPromoCode(collectable pgx.CollectableRow) (*PromoCode, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PromoCode{
+		ID:              collected.ID,
+		CreatedAt:       collected.CreatedAt,
+		CreatedBy:       collected.CreatedBy,
+		Code:            collected.Code,
+		Kind:            Kind(collected.Kind),
+		Value:           collected.Value,
+		MaxRedemptions:  collected.MaxRedemptions,
+		RedemptionCount: collected.RedemptionCount,
+		NewUserDays:     collected.NewUserDays,
+		ExpiresAt:       collected.ExpiresAt,
+	}, nil
+}
+
+// Redemption is a record of a user redeeming a promo code.
+type Redemption struct {
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	PromoCodeID uuid.UUID
+	UserID      uuid.UUID
+	Amount      int // coins credited or coins off the purchase
+
+	Code     string
+	Kind     Kind
+	Username string
+}
+
+type RedemptionRow struct {
+	ID          uuid.UUID `db:"id"`
+	CreatedAt   time.Time `db:"created_at"`
+	PromoCodeID uuid.UUID `db:"promo_code_id"`
+	UserID      uuid.UUID `db:"user_id"`
+	Amount      int       `db:"amount"`
+}
+
+func RowToRedemption(collectable pgx.CollectableRow) (*Redemption, error) {
+	collected, err := pgx.RowToStructByName[RedemptionRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Redemption{
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		PromoCodeID: collected.PromoCodeID,
+		UserID:      collected.UserID,
+		Amount:      collected.Amount,
+	}, nil
+}
+
+type RedemptionRowWithUsername struct {
+	RedemptionRow
+	Code     string `db:"code"`
+	Kind     string `db:"kind"`
+	Username string `db:"username"`
+}
+
+func RowToRedemptionWithUsername(collectable pgx.CollectableRow) (*Redemption, error) {
+	collected, err := pgx.RowToStructByName[RedemptionRowWithUsername](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Redemption{
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		PromoCodeID: collected.PromoCodeID,
+		UserID:      collected.UserID,
+		Amount:      collected.Amount,
+		Code:        collected.Code,
+		Kind:        Kind(collected.Kind),
+		Username:    collected.Username,
+	}, nil
+}
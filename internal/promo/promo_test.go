@@ -0,0 +1,188 @@
+package promo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestPromo(t *testing.T) {
+	t.Run("redeems coins promo codes", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			pcc = NewCreator(db)
+			pcr = NewRedeemer(db)
+			pcg = NewGetter(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+
+		maxRedemptions := 1
+		_, err := pcc.CreatePromoCode(ctx, &CreatorCreatePromoCodeParams{
+			CreatedBy:      admin.ID,
+			Code:           "hackathon24",
+			Kind:           KindCoins,
+			Value:          50,
+			MaxRedemptions: &maxRedemptions,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = pcc.CreatePromoCode(ctx, &CreatorCreatePromoCodeParams{
+			CreatedBy: admin.ID,
+			Code:      "HACKATHON24",
+			Kind:      KindCoins,
+			Value:     10,
+		})
+		if got, want := err, ErrExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		initialBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		redemption, err := pcr.Redeem(ctx, "Hackathon24", alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = pcr.Redeem(ctx, "HACKATHON24", alice.ID)
+		if got, want := err, ErrExhausted; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		balance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance+50; got != want {
+			t.Errorf("got %d balance, want %d", got, want)
+		}
+		if got, want := redemption.Code, "HACKATHON24"; got != want {
+			t.Errorf("got %s redemption code, want %s", got, want)
+		}
+
+		redemptions, err := pcg.GetRedemptionsByPromoCodeID(ctx, redemption.PromoCodeID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(redemptions), 1; got != want {
+			t.Fatalf("got %d redemptions, want %d", got, want)
+		}
+		if got, want := redemptions[0].Username, "alice"; got != want {
+			t.Errorf("got %s redemption user, want %s", got, want)
+		}
+	})
+
+	t.Run("enforces redemption limits concurrently", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			pcc = NewCreator(db)
+			pcr = NewRedeemer(db)
+			pcg = NewGetter(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+
+		maxRedemptions := 3
+		_, err := pcc.CreatePromoCode(ctx, &CreatorCreatePromoCodeParams{
+			CreatedBy:      admin.ID,
+			Code:           "LIMITED",
+			Kind:           KindCoins,
+			Value:          10,
+			MaxRedemptions: &maxRedemptions,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		const userCount = 10
+		var wg sync.WaitGroup
+		errs := make([]error, userCount)
+		for i := range userCount {
+			u := usertest.CreateUser(t, ctx, db, fmt.Sprintf("user%d", i))
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = pcr.Redeem(ctx, "LIMITED", u.ID)
+			}()
+		}
+		wg.Wait()
+
+		redeemed := 0
+		for _, err := range errs {
+			switch {
+			case err == nil:
+				redeemed++
+			case errors.Is(err, ErrExhausted):
+			default:
+				t.Fatalf("got %v error", err)
+			}
+		}
+		if got, want := redeemed, maxRedemptions; got != want {
+			t.Errorf("got %d redemptions, want %d", got, want)
+		}
+
+		pc, err := pcg.GetPromoCodeByCode(ctx, "LIMITED")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := pc.RedemptionCount, maxRedemptions; got != want {
+			t.Errorf("got %d redemption count, want %d", got, want)
+		}
+	})
+
+	t.Run("redeems discount promo codes", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			pcc = NewCreator(db)
+			pcr = NewRedeemer(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+
+		newUserDays := 30
+		expiresAt := time.Now().Add(time.Hour)
+		_, err := pcc.CreatePromoCode(ctx, &CreatorCreatePromoCodeParams{
+			CreatedBy:   admin.ID,
+			Code:        "WELCOME",
+			Kind:        KindPercent,
+			Value:       25,
+			NewUserDays: &newUserDays,
+			ExpiresAt:   &expiresAt,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = pcr.Redeem(ctx, "WELCOME", alice.ID)
+		if got, want := err, ErrNotCoins; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		price, redemption, err := pcr.RedeemDiscount(ctx, "WELCOME", alice.ID, 80)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, _, err = pcr.RedeemDiscount(ctx, "WELCOME", alice.ID, 80)
+		if got, want := err, ErrAlreadyRedeemed; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		if got, want := price, 60; got != want {
+			t.Errorf("got %d price, want %d", got, want)
+		}
+		if got, want := redemption.Amount, 20; got != want {
+			t.Errorf("got %d redemption amount, want %d", got, want)
+		}
+	})
+}
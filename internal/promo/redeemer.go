@@ -0,0 +1,259 @@
+package promo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Redeemer struct {
+	db app.PgxExecutor
+}
+
+func NewRedeemer(db app.PgxExecutor) *Redeemer {
+	return &Redeemer{db: db}
+}
+
+// Redeem redeems the coins promo code and credits the user.
+func (r *Redeemer) Redeem(ctx context.Context, code string, userID uuid.UUID) (*Redemption, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	// The user is locked before the promo code, the same way purchases do it.
+	u, err := getUserForUpdate(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+
+	pc, err := lockRedeemable(ctx, tx, NormalizeCode(code), userID)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+	if pc.Kind != KindCoins {
+		return nil, fmt.Errorf("promo.Redeemer: %w", ErrNotCoins)
+	}
+
+	redemption, err := createRedemption(ctx, tx, pc.ID, userID, pc.Value)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+
+	err = updateUserBalance(ctx, tx, userID, u.Balance+pc.Value)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+	redemption.Code = pc.Code
+	redemption.Kind = pc.Kind
+	redemption.Username = u.Username
+
+	return redemption, nil
+}
+
+// RedeemDiscount redeems the discount promo code for a purchase and returns the discounted price.
+// It should be called in the purchase transaction after the user is locked.
+func (r *Redeemer) RedeemDiscount(ctx context.Context, code string, userID uuid.UUID, price int) (int, *Redemption, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	pc, err := lockRedeemable(ctx, tx, NormalizeCode(code), userID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+	if !pc.Kind.IsDiscount() {
+		return 0, nil, fmt.Errorf("promo.Redeemer: %w", ErrNotDiscount)
+	}
+
+	discounted := pc.Price(price)
+	redemption, err := createRedemption(ctx, tx, pc.ID, userID, price-discounted)
+	if err != nil {
+		return 0, nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("promo.Redeemer: %w", err)
+	}
+	redemption.Code = pc.Code
+	redemption.Kind = pc.Kind
+
+	return discounted, redemption, nil
+}
+
+// lockRedeemable locks the promo code, checks that the user can redeem it and counts the redemption.
+// Concurrent redemptions of the same code wait for the lock, so the limit can't be exceeded.
+func lockRedeemable(ctx context.Context, db app.PgxExecutor, code string, userID uuid.UUID) (*PromoCode, error) {
+	pc, err := getPromoCodeForUpdateByCode(ctx, db, code)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if pc.ExpiresAt != nil && !now.Before(*pc.ExpiresAt) {
+		return nil, ErrExpired
+	}
+	if pc.MaxRedemptions != nil && pc.RedemptionCount >= *pc.MaxRedemptions {
+		return nil, ErrExhausted
+	}
+	if pc.NewUserDays != nil {
+		createdAt, err := getUserCreatedAt(ctx, db, userID)
+		if err != nil {
+			return nil, err
+		}
+		// Users created before registration times were recorded are not new.
+		if createdAt == nil || now.Sub(*createdAt) > time.Duration(*pc.NewUserDays)*24*time.Hour {
+			return nil, ErrNotNewUser
+		}
+	}
+
+	err = updatePromoCodeRedemptionCount(ctx, db, pc.ID, pc.RedemptionCount+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func getPromoCodeForUpdateByCode(ctx context.Context, db app.PgxExecutor, code string) (*PromoCode, error) {
+	query := `
+		SELECT id, created_at, created_by, code, kind, value, max_redemptions, redemption_count, new_user_days, expires_at
+		FROM promo_codes
+		WHERE code = $1
+		FOR UPDATE
+	`
+	args := []any{code}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pc, err := pgx.CollectExactlyOneRow(rows, RowToPromoCode)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return pc, nil
+}
+
+func updatePromoCodeRedemptionCount(ctx context.Context, db app.PgxExecutor, id uuid.UUID, redemptionCount int) error {
+	query := `
+		UPDATE promo_codes
+		SET redemption_count = $2
+		WHERE id = $1
+	`
+	args := []any{id, redemptionCount}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func createRedemption(ctx context.Context, db app.PgxExecutor, promoCodeID uuid.UUID, userID uuid.UUID, amount int) (*Redemption, error) {
+	query := `
+		INSERT INTO promo_code_redemptions (promo_code_id, user_id, amount)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, promo_code_id, user_id, amount
+	`
+	args := []any{promoCodeID, userID, amount}
+
+	rows, _ := db.Query(ctx, query, args...)
+	redemption, err := pgx.CollectExactlyOneRow(rows, RowToRedemption)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "promo_code_redemptions_promo_code_id_user_id_idx") {
+			return nil, ErrAlreadyRedeemed
+		}
+		return nil, err
+	}
+
+	return redemption, nil
+}
+
+func getUserCreatedAt(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*time.Time, error) {
+	query := `
+		SELECT created_at
+		FROM users
+		WHERE id = $1
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	createdAt, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[*time.Time])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return createdAt, nil
+}
+
+func getUserForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*user.User, error) {
+	query := `
+		SELECT id, username, password_hash, balance
+		FROM users
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	u, err := pgx.CollectExactlyOneRow(rows, user.RowToUser)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func updateUserBalance(ctx context.Context, db app.PgxExecutor, id uuid.UUID, balance int) error {
+	query := `
+		UPDATE users
+		SET balance = $2
+		WHERE id = $1
+	`
+	args := []any{id, balance}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package purchase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/user"
+)
+
+// Debiter takes coins from a user the same way Purchaser does,
+// so other domains that sell something for coins keep the balance invariants.
+// It should be created with the caller's transaction, the user stays locked until it ends.
+type Debiter struct {
+	db app.PgxExecutor
+}
+
+func NewDebiter(db app.PgxExecutor) *Debiter {
+	return &Debiter{db: db}
+}
+
+// Debit locks the user and takes the amount from their balance.
+// It returns coin.ErrNotEnough if the balance is too low.
+func (d *Debiter) Debit(ctx context.Context, userID uuid.UUID, amount int) (*user.User, error) {
+	usersMap, err := getUsersByIDsForUpdate(ctx, d.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Debiter: %w", err)
+	}
+
+	u, err := debit(ctx, d.db, usersMap[userID], amount)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Debiter: %w", err)
+	}
+
+	return u, nil
+}
+
+// debit takes the amount from the balance of the locked user.
+func debit(ctx context.Context, db app.PgxExecutor, u *user.User, amount int) (*user.User, error) {
+	balance := u.Balance - amount
+	if balance < 0 {
+		return nil, coin.ErrNotEnough
+	}
+	return updateUserBalance(ctx, db, u.ID, balance)
+}
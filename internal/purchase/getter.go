@@ -18,6 +18,8 @@ func NewGetter(db app.PgxExecutor) *Getter {
 	return &Getter{db: db}
 }
 
+// GetItemCountsByUserID returns the number of purchases per item made for the user.
+// Purchased items can be handed to other users later, see the inventory package for what the user owns now.
 func (g *Getter) GetItemCountsByUserID(ctx context.Context, userID uuid.UUID) ([]*ItemCount, error) {
 	itemCounts, err := getItemCountsByUserID(ctx, g.db, userID)
 	if err != nil {
@@ -26,6 +28,34 @@ func (g *Getter) GetItemCountsByUserID(ctx context.Context, userID uuid.UUID) ([
 	return itemCounts, nil
 }
 
+// GetGiftsByUserID returns the gifts the user has bought or received, newest first.
+func (g *Getter) GetGiftsByUserID(ctx context.Context, userID uuid.UUID) ([]*Purchase, error) {
+	gifts, err := getGiftsByUserID(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Getter: %w", err)
+	}
+	return gifts, nil
+}
+
+// GetAllowancesByUserID returns the limited items with how many units the user already got within the limits.
+func (g *Getter) GetAllowancesByUserID(ctx context.Context, userID uuid.UUID) ([]*Allowance, error) {
+	allowances, err := getAllowancesByUserID(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Getter: %w", err)
+	}
+	return allowances, nil
+}
+
+// GetActiveReservationsByUserID returns the user's active reservations, newest first.
+// Reservations that expired but weren't released yet are included.
+func (g *Getter) GetActiveReservationsByUserID(ctx context.Context, userID uuid.UUID) ([]*Reservation, error) {
+	reservations, err := getActiveReservationsByUserID(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Getter: %w", err)
+	}
+	return reservations, nil
+}
+
 func getItemCountsByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*ItemCount, error) {
 	query := `
 		SELECT p.user_id, p.item_id, count(*) AS count, i.name AS item_name
@@ -45,3 +75,86 @@ func getItemCountsByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.
 
 	return itemCounts, nil
 }
+
+func getGiftsByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Purchase, error) {
+	query := `
+		SELECT p.id, p.created_at, p.user_id, p.item_id, p.variant_id, p.list_price, p.amount,
+			   p.campaign_id, p.promo_code_id, p.buyer_id, p.note,
+			   i.name as item_name,
+			   coalesce(v.size, '') as variant_size,
+			   coalesce(v.color, '') as variant_color,
+			   u.username as username,
+			   buyer_u.username as buyer_username,
+			   coalesce(pr.display_name, '') as display_name,
+			   coalesce(buyer_pr.display_name, '') as buyer_display_name
+		FROM purchases p
+		JOIN items i ON p.item_id = i.id
+		LEFT JOIN item_variants v ON p.variant_id = v.id
+		JOIN users u ON p.user_id = u.id
+		JOIN users buyer_u ON p.buyer_id = buyer_u.id
+		LEFT JOIN profiles pr ON p.user_id = pr.user_id
+		LEFT JOIN profiles buyer_pr ON p.buyer_id = buyer_pr.user_id
+		WHERE (p.user_id = $1 OR p.buyer_id = $1) AND p.user_id <> p.buyer_id
+		ORDER BY p.created_at DESC, p.id
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	gifts, err := pgx.CollectRows(rows, RowToPurchaseWithUsernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return gifts, nil
+}
+
+func getAllowancesByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Allowance, error) {
+	query := `
+		SELECT l.item_id, l.max_count, l.period_days,
+			   (SELECT count(*)
+				FROM purchases p
+				WHERE p.user_id = $1
+				  AND p.item_id = l.item_id
+				  AND (l.period_days IS NULL OR p.created_at > now() - make_interval(days => l.period_days)))
+			   + (SELECT count(*)
+				  FROM reservations r
+				  WHERE r.user_id = $1
+					AND r.item_id = l.item_id
+					AND r.status = 'active') AS count
+		FROM purchase_limits l
+		ORDER BY l.item_id
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	allowances, err := pgx.CollectRows(rows, RowToAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return allowances, nil
+}
+
+func getActiveReservationsByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Reservation, error) {
+	query := `
+		SELECT r.id, r.created_at, r.user_id, r.item_id, r.variant_id, r.list_price, r.amount, r.campaign_id,
+			   r.status, r.expires_at, r.decided_at, r.purchase_id,
+			   i.name as item_name,
+			   coalesce(v.size, '') as variant_size,
+			   coalesce(v.color, '') as variant_color
+		FROM reservations r
+		JOIN items i ON r.item_id = i.id
+		LEFT JOIN item_variants v ON r.variant_id = v.id
+		WHERE r.user_id = $1 AND r.status = 'active'
+		ORDER BY r.created_at DESC, r.id
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	reservations, err := pgx.CollectRows(rows, RowToReservationWithItem)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
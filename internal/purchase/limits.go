@@ -0,0 +1,193 @@
+package purchase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+)
+
+// Limit is how many units of an item a user can get, bought by them or gifted to them.
+type Limit struct {
+	ItemID     uuid.UUID
+	MaxCount   int
+	PeriodDays *int // nil if the limit is for a lifetime, otherwise it is for the last days
+
+	ItemName string
+}
+
+type LimitRow struct {
+	ItemID     uuid.UUID `db:"item_id"`
+	MaxCount   int       `db:"max_count"`
+	PeriodDays *int      `db:"period_days"`
+}
+
+func RowToLimit(collectable pgx.CollectableRow) (*Limit, error) {
+	collected, err := pgx.RowToStructByName[LimitRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limit{
+		ItemID:     collected.ItemID,
+		MaxCount:   collected.MaxCount,
+		PeriodDays: collected.PeriodDays,
+	}, nil
+}
+
+// Allowance is a limit together with how many units the user already got within it.
+// Units held by active reservations count as got.
+type Allowance struct {
+	Limit
+	Count int
+}
+
+// Remaining returns how many more units the user can get.
+func (a *Allowance) Remaining() int {
+	return max(a.MaxCount-a.Count, 0)
+}
+
+type AllowanceRow struct {
+	LimitRow
+	Count int `db:"count"`
+}
+
+func RowToAllowance(collectable pgx.CollectableRow) (*Allowance, error) {
+	collected, err := pgx.RowToStructByName[AllowanceRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Allowance{
+		Limit: Limit{
+			ItemID:     collected.ItemID,
+			MaxCount:   collected.MaxCount,
+			PeriodDays: collected.PeriodDays,
+		},
+		Count: collected.Count,
+	}, nil
+}
+
+// LimitSetter sets item purchase limits.
+// It should only be used on behalf of admins.
+type LimitSetter struct {
+	db app.PgxExecutor
+}
+
+func NewLimitSetter(db app.PgxExecutor) *LimitSetter {
+	return &LimitSetter{db: db}
+}
+
+func (ls *LimitSetter) SetLimitByItemName(ctx context.Context, itemName string, maxCount int, periodDays *int) (*Limit, error) {
+	i, err := item.NewGetter(ls.db).GetItemByName(ctx, itemName)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.LimitSetter: %w", err)
+	}
+
+	l, err := setLimit(ctx, ls.db, i.ID, maxCount, periodDays)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.LimitSetter: %w", err)
+	}
+	l.ItemName = i.Name
+
+	return l, nil
+}
+
+func (ls *LimitSetter) DeleteLimitByItemName(ctx context.Context, itemName string) error {
+	i, err := item.NewGetter(ls.db).GetItemByName(ctx, itemName)
+	if err != nil {
+		return fmt.Errorf("purchase.LimitSetter: %w", err)
+	}
+
+	err = deleteLimit(ctx, ls.db, i.ID)
+	if err != nil {
+		return fmt.Errorf("purchase.LimitSetter: %w", err)
+	}
+
+	return nil
+}
+
+// checkLimit returns [ErrLimitReached] if the user can't get one more unit of the item.
+// It should be called in a transaction after the user is locked,
+// so concurrent purchases for the same user can't both pass the check.
+func checkLimit(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, userID uuid.UUID) error {
+	a, err := getAllowance(ctx, db, itemID, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if a.Remaining() <= 0 {
+		return ErrLimitReached
+	}
+	return nil
+}
+
+func setLimit(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, maxCount int, periodDays *int) (*Limit, error) {
+	query := `
+		INSERT INTO purchase_limits (item_id, max_count, period_days)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (item_id) DO UPDATE
+		SET max_count = excluded.max_count,
+			period_days = excluded.period_days
+		RETURNING item_id, max_count, period_days
+	`
+	args := []any{itemID, maxCount, periodDays}
+
+	rows, _ := db.Query(ctx, query, args...)
+	l, err := pgx.CollectExactlyOneRow(rows, RowToLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func deleteLimit(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID) error {
+	query := `
+		DELETE FROM purchase_limits
+		WHERE item_id = $1
+	`
+	args := []any{itemID}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getAllowance returns pgx.ErrNoRows if the item has no limit.
+func getAllowance(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, userID uuid.UUID) (*Allowance, error) {
+	query := `
+		SELECT l.item_id, l.max_count, l.period_days,
+			   (SELECT count(*)
+				FROM purchases p
+				WHERE p.user_id = $2
+				  AND p.item_id = l.item_id
+				  AND (l.period_days IS NULL OR p.created_at > now() - make_interval(days => l.period_days)))
+			   + (SELECT count(*)
+				  FROM reservations r
+				  WHERE r.user_id = $2
+					AND r.item_id = l.item_id
+					AND r.status = 'active') AS count
+		FROM purchase_limits l
+		WHERE l.item_id = $1
+	`
+	args := []any{itemID, userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	a, err := pgx.CollectExactlyOneRow(rows, RowToAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
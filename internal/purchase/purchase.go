@@ -1,26 +1,69 @@
 package purchase
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+var (
+	ErrRecipientNotFound      = errors.New("recipient not found")
+	ErrBuyerAndRecipientEqual = errors.New("buyer and recipient are equal")
+	ErrVariantRequired        = errors.New("variant required")
+	ErrOutOfStock             = errors.New("out of stock")
+	ErrLimitReached           = errors.New("purchase limit reached")
+	ErrReservationNotExist    = errors.New("reservation does not exist")
+	ErrReservationNotActive   = errors.New("reservation not active")
+)
+
+const (
+	EventBought       app.EventKind = "purchase.bought"        // the user bought an item for themselves or as a gift
+	EventGiftReceived app.EventKind = "purchase.gift_received" // another user bought an item for the user
 )
 
 type Purchase struct {
-	ID        uuid.UUID
-	CreatedAt time.Time
-	UserID    uuid.UUID
-	ItemID    uuid.UUID
-	Amount    int
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	UserID      uuid.UUID // owner of the item
+	ItemID      uuid.UUID
+	VariantID   *uuid.UUID // nil for items without variants
+	ListPrice   int        // price before discounts
+	Amount      int        // charged price
+	CampaignID  *uuid.UUID // campaign that discounted the price, if any
+	PromoCodeID *uuid.UUID // promo code that discounted the price, if any
+	BuyerID     uuid.UUID  // equals UserID unless the item was bought as a gift
+	Note        string
+
+	ItemName         string
+	VariantSize      string
+	VariantColor     string
+	Username         string
+	BuyerUsername    string
+	DisplayName      string
+	BuyerDisplayName string
+}
+
+// IsGift reports whether the item was bought for another user.
+func (p *Purchase) IsGift() bool {
+	return p.BuyerID != p.UserID
 }
 
 type Row struct {
-	ID        uuid.UUID `db:"id"`
-	CreatedAt time.Time `db:"created_at"`
-	UserID    uuid.UUID `db:"user_id"`
-	ItemID    uuid.UUID `db:"item_id"`
-	Amount    int       `db:"amount"`
+	ID          uuid.UUID  `db:"id"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UserID      uuid.UUID  `db:"user_id"`
+	ItemID      uuid.UUID  `db:"item_id"`
+	VariantID   *uuid.UUID `db:"variant_id"`
+	ListPrice   int        `db:"list_price"`
+	Amount      int        `db:"amount"`
+	CampaignID  *uuid.UUID `db:"campaign_id"`
+	PromoCodeID *uuid.UUID `db:"promo_code_id"`
+	BuyerID     uuid.UUID  `db:"buyer_id"`
+	Note        string     `db:"note"`
 }
 
 func RowToPurchase(collectable pgx.CollectableRow) (*Purchase, error) {
@@ -30,11 +73,56 @@ func RowToPurchase(collectable pgx.CollectableRow) (*Purchase, error) {
 	}
 
 	return &Purchase{
-		ID:        collected.ID,
-		CreatedAt: collected.CreatedAt,
-		UserID:    collected.UserID,
-		ItemID:    collected.ItemID,
-		Amount:    collected.Amount,
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		UserID:      collected.UserID,
+		ItemID:      collected.ItemID,
+		VariantID:   collected.VariantID,
+		ListPrice:   collected.ListPrice,
+		Amount:      collected.Amount,
+		CampaignID:  collected.CampaignID,
+		PromoCodeID: collected.PromoCodeID,
+		BuyerID:     collected.BuyerID,
+		Note:        collected.Note,
+	}, nil
+}
+
+type RowWithUsernames struct {
+	Row
+	ItemName         string `db:"item_name"`
+	VariantSize      string `db:"variant_size"`
+	VariantColor     string `db:"variant_color"`
+	Username         string `db:"username"`
+	BuyerUsername    string `db:"buyer_username"`
+	DisplayName      string `db:"display_name"`
+	BuyerDisplayName string `db:"buyer_display_name"`
+}
+
+func RowToPurchaseWithUsernames(collectable pgx.CollectableRow) (*Purchase, error) {
+	collected, err := pgx.RowToStructByName[RowWithUsernames](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Purchase{
+		ID:               collected.ID,
+		CreatedAt:        collected.CreatedAt,
+		UserID:           collected.UserID,
+		ItemID:           collected.ItemID,
+		VariantID:        collected.VariantID,
+		ListPrice:        collected.ListPrice,
+		Amount:           collected.Amount,
+		CampaignID:       collected.CampaignID,
+		PromoCodeID:      collected.PromoCodeID,
+		BuyerID:          collected.BuyerID,
+		Note:             collected.Note,
+		ItemName:         collected.ItemName,
+		VariantSize:      collected.VariantSize,
+		VariantColor:     collected.VariantColor,
+		Username:         collected.Username,
+		BuyerUsername:    collected.BuyerUsername,
+		DisplayName:      collected.DisplayName,
+		BuyerDisplayName: collected.BuyerDisplayName,
 	}, nil
 }
 
@@ -2,11 +2,15 @@ package purchase
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/campaign"
 	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/item"
 	"github.com/k11v/merch/internal/user/usertest"
 )
 
@@ -72,4 +76,462 @@ func TestPurchase(t *testing.T) {
 			t.Errorf("want %v", want)
 		}
 	})
+	t.Run("gifts items", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			pg  = NewGetter(db)
+			pp  = NewPurchaser(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		initialAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		initialBobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = pp.Purchase(ctx, &PurchaserPurchaseParams{
+			ItemName:          "cup",
+			BuyerID:           alice.ID,
+			RecipientUsername: "alice",
+		})
+		if got, want := err, ErrBuyerAndRecipientEqual; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		gift, err := pp.Purchase(ctx, &PurchaserPurchaseParams{
+			ItemName:          "cup",
+			BuyerID:           alice.ID,
+			RecipientUsername: "bob",
+			Note:              "happy birthday",
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		aliceItemCounts, err := pg.GetItemCountsByUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobItemCounts, err := pg.GetItemCountsByUserID(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		aliceGifts, err := pg.GetGiftsByUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobGifts, err := pg.GetGiftsByUserID(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := aliceBalance, initialAliceBalance-gift.Amount; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+		if got, want := bobBalance, initialBobBalance; got != want {
+			t.Errorf("got %d bob balance, want %d", got, want)
+		}
+		if got, want := len(aliceItemCounts), 0; got != want {
+			t.Errorf("got %d alice item counts, want %d", got, want)
+		}
+		if got, want := len(bobItemCounts), 1; got != want {
+			t.Fatalf("got %d bob item counts, want %d", got, want)
+		}
+		if got, want := bobItemCounts[0].ItemName, "cup"; got != want {
+			t.Errorf("got %s bob item, want %s", got, want)
+		}
+		if got, want := len(aliceGifts), 1; got != want {
+			t.Fatalf("got %d alice gifts, want %d", got, want)
+		}
+		if got, want := len(bobGifts), 1; got != want {
+			t.Fatalf("got %d bob gifts, want %d", got, want)
+		}
+		if got, want := bobGifts[0].BuyerUsername, "alice"; got != want {
+			t.Errorf("got %s gift buyer, want %s", got, want)
+		}
+		if got, want := bobGifts[0].Note, "happy birthday"; got != want {
+			t.Errorf("got %q gift note, want %q", got, want)
+		}
+	})
+	t.Run("purchases item variants", func(t *testing.T) {
+		var (
+			ctx  = context.Background()
+			db   = apptest.NewPostgresPool(t, ctx)
+			user = usertest.CreateUser(t, ctx, db, "alice")
+			ig   = item.NewGetter(db)
+			ivs  = item.NewVariantSetter(db)
+			pp   = NewPurchaser(db)
+		)
+
+		i, err := ig.GetItemByName(ctx, "t-shirt")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		price := 100
+		_, err = ivs.CreateVariant(ctx, &item.VariantSetterCreateVariantParams{
+			ItemID:   i.ID,
+			Selector: item.VariantSelector{Size: "M", Color: "black"},
+			Stock:    1,
+			Price:    &price,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = pp.PurchaseByName(ctx, "t-shirt", user.ID)
+		if got, want := err, ErrVariantRequired; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		params := &PurchaserPurchaseParams{
+			ItemName: "t-shirt",
+			BuyerID:  user.ID,
+			Variant:  &item.VariantSelector{Size: "M", Color: "black"},
+		}
+		p, err := pp.Purchase(ctx, params)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := p.Amount, price; got != want {
+			t.Errorf("got %d amount, want %d", got, want)
+		}
+		_, err = pp.Purchase(ctx, params)
+		if got, want := err, ErrOutOfStock; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+	t.Run("applies campaigns", func(t *testing.T) {
+		var (
+			ctx  = context.Background()
+			db   = apptest.NewPostgresPool(t, ctx)
+			user = usertest.CreateUser(t, ctx, db, "alice")
+			ig   = item.NewGetter(db)
+			cc   = campaign.NewCreator(db)
+			pp   = NewPurchaser(db)
+		)
+
+		wallet, err := ig.GetItemByName(ctx, "wallet")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		c, err := cc.CreateCampaign(ctx, &campaign.CreatorCreateCampaignParams{
+			Name:     "accessories sale",
+			Kind:     campaign.KindPercent,
+			Value:    50,
+			Category: wallet.Category,
+			StartsAt: time.Now().Add(-time.Hour),
+			EndsAt:   time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = cc.CreateCampaign(ctx, &campaign.CreatorCreateCampaignParams{
+			Name:     "upcoming wallet sale",
+			Kind:     campaign.KindFixed,
+			Value:    wallet.Price,
+			ItemName: "wallet",
+			StartsAt: time.Now().Add(time.Hour),
+			EndsAt:   time.Now().Add(2 * time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		p, err := pp.PurchaseByName(ctx, "wallet", user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := p.ListPrice, wallet.Price; got != want {
+			t.Errorf("got %d list price, want %d", got, want)
+		}
+		if got, want := p.Amount, c.Price(wallet.Price); got != want {
+			t.Errorf("got %d amount, want %d", got, want)
+		}
+		if p.CampaignID == nil || *p.CampaignID != c.ID {
+			t.Errorf("got %v campaign id, want %v", p.CampaignID, c.ID)
+		}
+	})
+	t.Run("enforces purchase limits", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			ls  = NewLimitSetter(db)
+			pg  = NewGetter(db)
+			pp  = NewPurchaser(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		_, err := pp.PurchaseByName(ctx, "pink-hoody", alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = pp.PurchaseByName(ctx, "pink-hoody", alice.ID)
+		if got, want := err, ErrLimitReached; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = pp.Purchase(ctx, &PurchaserPurchaseParams{
+			ItemName:          "pink-hoody",
+			BuyerID:           bob.ID,
+			RecipientUsername: "alice",
+		})
+		if got, want := err, ErrLimitReached; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		periodDays := 7
+		_, err = ls.SetLimitByItemName(ctx, "cup", 2, &periodDays)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = pp.PurchaseByName(ctx, "cup", alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		allowances, err := pg.GetAllowancesByUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		remaining := make(map[int]int)
+		for _, a := range allowances {
+			remaining[a.MaxCount] = a.Remaining()
+		}
+		if got, want := remaining[1], 0; got != want {
+			t.Errorf("got %d remaining pink-hoody purchases, want %d", got, want)
+		}
+		if got, want := remaining[2], 1; got != want {
+			t.Errorf("got %d remaining cup purchases, want %d", got, want)
+		}
+
+		err = ls.DeleteLimitByItemName(ctx, "pink-hoody")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = pp.PurchaseByName(ctx, "pink-hoody", bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+	})
+	t.Run("reserves, purchases and expires reservations", func(t *testing.T) {
+		var (
+			ctx  = context.Background()
+			db   = apptest.NewPostgresPool(t, ctx)
+			user = usertest.CreateUser(t, ctx, db, "alice")
+			cg   = coin.NewGetter(db)
+			ig   = item.NewGetter(db)
+			ivs  = item.NewVariantSetter(db)
+			pg   = NewGetter(db)
+			pp   = NewPurchaser(db)
+			pr   = NewReserver(db)
+			pre  = NewReservationExpirer(db)
+		)
+
+		i, err := ig.GetItemByName(ctx, "t-shirt")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		selector := item.VariantSelector{Size: "M", Color: "black"}
+		_, err = ivs.CreateVariant(ctx, &item.VariantSetterCreateVariantParams{
+			ItemID:   i.ID,
+			Selector: selector,
+			Stock:    1,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		initialBalance, err := cg.GetBalance(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		res, err := pr.Reserve(ctx, &ReserverReserveParams{
+			ItemName: "t-shirt",
+			UserID:   user.ID,
+			Variant:  &selector,
+			TTL:      time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		balance, err := cg.GetBalance(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance-res.Amount; got != want {
+			t.Errorf("got %d balance after reserving, want %d", got, want)
+		}
+		_, err = pp.Purchase(ctx, &PurchaserPurchaseParams{ItemName: "t-shirt", BuyerID: user.ID, Variant: &selector})
+		if got, want := err, ErrOutOfStock; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		_, err = pr.Cancel(ctx, res.ID, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = pp.PurchaseReservation(ctx, &PurchaserPurchaseReservationParams{ReservationID: res.ID, UserID: user.ID})
+		if got, want := err, ErrReservationNotActive; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		balance, err = cg.GetBalance(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance; got != want {
+			t.Errorf("got %d balance after cancelling, want %d", got, want)
+		}
+
+		res, err = pr.Reserve(ctx, &ReserverReserveParams{
+			ItemName: "t-shirt",
+			UserID:   user.ID,
+			Variant:  &selector,
+			TTL:      time.Hour,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		p, err := pp.PurchaseReservation(ctx, &PurchaserPurchaseReservationParams{ReservationID: res.ID, UserID: user.ID})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := p.Amount, res.Amount; got != want {
+			t.Errorf("got %d amount, want %d", got, want)
+		}
+		if got, want := p.Username, user.Username; got != want {
+			t.Errorf("got %s username, want %s", got, want)
+		}
+		if got, want := p.BuyerUsername, user.Username; got != want {
+			t.Errorf("got %s buyer username, want %s", got, want)
+		}
+		itemCounts, err := pg.GetItemCountsByUserID(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(itemCounts), 1; got != want {
+			t.Fatalf("got %d item counts, want %d", got, want)
+		}
+
+		res, err = pr.Reserve(ctx, &ReserverReserveParams{
+			ItemName: "cup",
+			UserID:   user.ID,
+			TTL:      time.Nanosecond,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		count, err := pre.ExpireActive(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d expired reservations, want %d", got, want)
+		}
+		reservations, err := pg.GetActiveReservationsByUserID(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(reservations), 0; got != want {
+			t.Errorf("got %d active reservations, want %d", got, want)
+		}
+		balance, err = cg.GetBalance(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance-p.Amount; got != want {
+			t.Errorf("got %d balance after expiring, want %d", got, want)
+		}
+	})
+	t.Run("reserves items with variants and picks the variant on purchase", func(t *testing.T) {
+		var (
+			ctx  = context.Background()
+			db   = apptest.NewPostgresPool(t, ctx)
+			user = usertest.CreateUser(t, ctx, db, "alice")
+			cg   = coin.NewGetter(db)
+			ig   = item.NewGetter(db)
+			ivs  = item.NewVariantSetter(db)
+			pp   = NewPurchaser(db)
+			pr   = NewReserver(db)
+		)
+
+		i, err := ig.GetItemByName(ctx, "t-shirt")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		cheap := item.VariantSelector{Size: "M"}
+		expensivePrice := i.Price + 10
+		_, err = ivs.CreateVariant(ctx, &item.VariantSetterCreateVariantParams{ItemID: i.ID, Selector: cheap, Stock: 1})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ivs.CreateVariant(ctx, &item.VariantSetterCreateVariantParams{
+			ItemID:   i.ID,
+			Selector: item.VariantSelector{Size: "L"},
+			Stock:    1,
+			Price:    &expensivePrice,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		initialBalance, err := cg.GetBalance(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		res, err := pr.Reserve(ctx, &ReserverReserveParams{ItemName: "t-shirt", UserID: user.ID, TTL: time.Hour})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := res.Amount, expensivePrice; got != want {
+			t.Errorf("got %d held amount, want %d", got, want)
+		}
+		if res.VariantID != nil {
+			t.Errorf("got %v variant id, want nil", res.VariantID)
+		}
+
+		_, err = pp.PurchaseReservation(ctx, &PurchaserPurchaseReservationParams{ReservationID: res.ID, UserID: user.ID})
+		if got, want := err, ErrVariantRequired; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		p, err := pp.PurchaseReservation(ctx, &PurchaserPurchaseReservationParams{
+			ReservationID: res.ID,
+			UserID:        user.ID,
+			Variant:       &cheap,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := p.Amount, i.Price; got != want {
+			t.Errorf("got %d amount, want %d", got, want)
+		}
+		if got, want := p.VariantSize, "M"; got != want {
+			t.Errorf("got %s variant size, want %s", got, want)
+		}
+
+		balance, err := cg.GetBalance(ctx, user.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance-i.Price; got != want {
+			t.Errorf("got %d balance, want %d", got, want)
+		}
+		_, err = pp.Purchase(ctx, &PurchaserPurchaseParams{ItemName: "t-shirt", BuyerID: user.ID, Variant: &cheap})
+		if got, want := err, ErrOutOfStock; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
 }
@@ -5,13 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/k11v/merch/internal/app"
-	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/campaign"
 	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/promo"
 	"github.com/k11v/merch/internal/user"
 )
 
@@ -24,14 +26,57 @@ func NewPurchaser(db app.PgxExecutor) *Purchaser {
 }
 
 func (h *Purchaser) PurchaseByName(ctx context.Context, itemName string, userID uuid.UUID) (*Purchase, error) {
-	i, err := item.NewGetter(h.db).GetItemByName(ctx, itemName)
+	return h.Purchase(ctx, &PurchaserPurchaseParams{ItemName: itemName, BuyerID: userID})
+}
+
+type PurchaserPurchaseParams struct {
+	ItemName string
+	BuyerID  uuid.UUID
+
+	// Variant is required for items with variants and must be nil for items without them.
+	Variant *item.VariantSelector
+
+	// RecipientUsername is set when the item is bought as a gift.
+	// The buyer is debited and the item is put into the recipient's inventory.
+	RecipientUsername string
+	Note              string
+
+	// PromoCode is an optional discount promo code.
+	// It is applied to the price after campaigns.
+	PromoCode string
+}
+
+func (h *Purchaser) Purchase(ctx context.Context, params *PurchaserPurchaseParams) (*Purchase, error) {
+	ownerID := params.BuyerID
+	ownerUsername := ""
+	if params.RecipientUsername != "" {
+		recipient, err := user.NewGetter(h.db).GetUserByUsername(ctx, params.RecipientUsername)
+		if err != nil {
+			if errors.Is(err, user.ErrNotExist) {
+				return nil, fmt.Errorf("purchase.Purchaser: %w", ErrRecipientNotFound)
+			}
+			return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+		}
+		if recipient.ID == params.BuyerID {
+			return nil, fmt.Errorf("purchase.Purchaser: %w", ErrBuyerAndRecipientEqual)
+		}
+		ownerID = recipient.ID
+		ownerUsername = recipient.Username
+	}
+
+	i, err := item.NewGetter(h.db).GetItemByName(ctx, params.ItemName)
 	if err != nil {
-		return nil, fmt.Errorf("purhcase.Purchaser: %w", err)
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+
+	variant, err := getVariant(ctx, h.db, i, params.Variant)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
 	}
 
 	tx, err := h.db.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("purhcase.Purchaser: %w", err)
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
 	}
 	defer func() {
 		err = tx.Rollback(ctx)
@@ -40,63 +85,337 @@ func (h *Purchaser) PurchaseByName(ctx context.Context, itemName string, userID
 		}
 	}()
 
-	u, err := getUserForUpdate(ctx, tx, userID)
+	// The owner of a gift is locked too, so purchase limits are checked consistently.
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, params.BuyerID, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+	u := usersMap[params.BuyerID]
+
+	err = checkLimit(ctx, tx, i.ID, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+
+	listPrice, variant, err := takeStock(ctx, tx, i, variant)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+	var variantID *uuid.UUID
+	if variant != nil {
+		variantID = &variant.ID
+	}
+
+	price, campaignID, err := campaignPrice(ctx, tx, i, listPrice)
 	if err != nil {
-		return nil, fmt.Errorf("purhcase.Purchaser: %w", err)
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
 	}
 
-	balance := u.Balance
-	balance -= i.Price
-	if balance < 0 {
-		return nil, fmt.Errorf("purhcase.Purchaser: %w", coin.ErrNotEnough)
+	var promoCodeID *uuid.UUID
+	if params.PromoCode != "" {
+		var redemption *promo.Redemption
+		price, redemption, err = promo.NewRedeemer(tx).RedeemDiscount(ctx, params.PromoCode, params.BuyerID, price)
+		if err != nil {
+			return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+		}
+		promoCodeID = &redemption.PromoCodeID
 	}
 
-	p, err := createPurchase(ctx, tx, userID, i.ID, i.Price)
+	_, err = debit(ctx, tx, u, price)
 	if err != nil {
-		return nil, fmt.Errorf("purhcase.Purchaser: %w", err)
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
 	}
 
-	_, err = updateUserBalance(ctx, tx, userID, balance)
+	p, err := recordPurchase(ctx, tx, &Purchase{
+		UserID:      ownerID,
+		ItemID:      i.ID,
+		VariantID:   variantID,
+		ListPrice:   listPrice,
+		Amount:      price,
+		CampaignID:  campaignID,
+		PromoCodeID: promoCodeID,
+		BuyerID:     params.BuyerID,
+		Note:        params.Note,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("purhcase.Purchaser: %w", err)
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
 	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("purhcase.Purchaser: %w", err)
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+
+	p.ItemName = i.Name
+	p.BuyerUsername = u.Username
+	p.Username = u.Username
+	if ownerUsername != "" {
+		p.Username = ownerUsername
+	}
+	if variant != nil {
+		p.VariantSize = variant.Size
+		p.VariantColor = variant.Color
 	}
 
 	return p, nil
 }
 
-func getUserForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*user.User, error) {
+type PurchaserPurchaseReservationParams struct {
+	ReservationID uuid.UUID
+	UserID        uuid.UUID
+
+	// Variant is required if the reservation is for an item with variants and the variant wasn't picked yet.
+	// It is ignored otherwise.
+	Variant *item.VariantSelector
+}
+
+// PurchaseReservation converts the user's active reservation into a purchase.
+// The held coins are charged and the held unit is put into the user's inventory.
+// If the variant is picked now, its stock is taken and it is charged at its price evaluated now,
+// but never more than the held coins, the rest of them is returned.
+// Purchase limits aren't checked again because the reservation counts towards them already.
+func (h *Purchaser) PurchaseReservation(ctx context.Context, params *PurchaserPurchaseReservationParams) (*Purchase, error) {
+	reservationID, userID := params.ReservationID, params.UserID
+
+	tx, err := h.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	r, err := getUserReservationForUpdate(ctx, tx, reservationID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+	if r.Status != ReservationStatusActive || !time.Now().Before(r.ExpiresAt) {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", ErrReservationNotActive)
+	}
+
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+	u := usersMap[userID]
+
+	bought := &Purchase{
+		UserID:       userID,
+		ItemID:       r.ItemID,
+		VariantID:    r.VariantID,
+		ListPrice:    r.ListPrice,
+		Amount:       r.Amount,
+		CampaignID:   r.CampaignID,
+		BuyerID:      userID,
+		VariantSize:  r.VariantSize,
+		VariantColor: r.VariantColor,
+	}
+	if r.VariantID == nil {
+		err = pickReservedVariant(ctx, tx, bought, params.Variant)
+		if err != nil {
+			return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+		}
+		if refund := r.Amount - bought.Amount; refund > 0 {
+			_, err = updateUserBalance(ctx, tx, userID, u.Balance+refund)
+			if err != nil {
+				return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+			}
+		}
+	}
+
+	p, err := recordPurchase(ctx, tx, bought)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+
+	_, err = updateReservationDecision(ctx, tx, r.ID, ReservationStatusPurchased, &p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Purchaser: %w", err)
+	}
+
+	p.ItemName = r.ItemName
+	p.BuyerUsername = u.Username
+	p.Username = u.Username
+	p.VariantSize = bought.VariantSize
+	p.VariantColor = bought.VariantColor
+
+	return p, nil
+}
+
+// pickReservedVariant takes the stock of the variant picked for the reservation purchase p
+// and sets its price, which is capped at the held coins in p.Amount.
+// Items without variants have nothing to pick and p is left as is.
+func pickReservedVariant(ctx context.Context, db app.PgxExecutor, p *Purchase, selector *item.VariantSelector) error {
+	i, err := item.NewGetter(db).GetItemByID(ctx, p.ItemID)
+	if err != nil {
+		return err
+	}
+	variant, err := getVariant(ctx, db, i, selector)
+	if err != nil {
+		return err
+	}
+	if variant == nil {
+		return nil
+	}
+
+	listPrice, variant, err := takeStock(ctx, db, i, variant)
+	if err != nil {
+		return err
+	}
+	price, campaignID, err := campaignPrice(ctx, db, i, listPrice)
+	if err != nil {
+		return err
+	}
+
+	p.VariantID = &variant.ID
+	p.ListPrice = listPrice
+	p.Amount = min(price, p.Amount)
+	p.CampaignID = campaignID
+	p.VariantSize = variant.Size
+	p.VariantColor = variant.Color
+	return nil
+}
+
+// purchaseEvents returns the events of the purchase for the buyer and, for gifts, the recipient.
+func purchaseEvents(p *Purchase) []*app.Event {
+	data := purchaseEventData{
+		ID:        p.ID,
+		CreatedAt: p.CreatedAt,
+		BuyerID:   p.BuyerID,
+		UserID:    p.UserID,
+		ItemID:    p.ItemID,
+		VariantID: p.VariantID,
+		Amount:    p.Amount,
+	}
+	events := []*app.Event{{Kind: EventBought, UserID: p.BuyerID, ID: p.ID, Data: data}}
+	if p.IsGift() {
+		events = append(events, &app.Event{Kind: EventGiftReceived, UserID: p.UserID, ID: p.ID, Data: data})
+	}
+	return events
+}
+
+type purchaseEventData struct {
+	ID        uuid.UUID  `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	BuyerID   uuid.UUID  `json:"buyerId"`
+	UserID    uuid.UUID  `json:"userId"`
+	ItemID    uuid.UUID  `json:"itemId"`
+	VariantID *uuid.UUID `json:"variantId,omitempty"`
+	Amount    int        `json:"amount"`
+}
+
+// takeStock takes a unit of the variant from stock and returns the list price.
+// The variant should be nil for items without variants, their stock isn't tracked.
+// It should be called in a transaction after the user is locked,
+// the variant is locked then and its stock is checked again under the lock.
+func takeStock(ctx context.Context, db app.PgxExecutor, i *item.Item, variant *item.Variant) (int, *item.Variant, error) {
+	if variant == nil {
+		return i.Price, nil, nil
+	}
+
+	variant, err := getVariantForUpdate(ctx, db, variant.ID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if variant.Stock <= 0 {
+		return 0, nil, ErrOutOfStock
+	}
+	err = updateVariantStock(ctx, db, variant.ID, variant.Stock-1)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return variant.PriceOr(i.Price), variant, nil
+}
+
+// campaignPrice returns listPrice discounted by the best active campaign for the item
+// and the ID of that campaign, if any.
+// Campaigns are evaluated at purchase time.
+func campaignPrice(ctx context.Context, db app.PgxExecutor, i *item.Item, listPrice int) (int, *uuid.UUID, error) {
+	campaigns, err := campaign.NewGetter(db).GetActiveCampaignsForItem(ctx, i.ID, i.Category, time.Now())
+	if err != nil {
+		return 0, nil, err
+	}
+	price, c := campaign.BestPrice(campaigns, listPrice)
+	if c == nil {
+		return price, nil, nil
+	}
+	return price, &c.ID, nil
+}
+
+// getVariant returns the variant chosen by the selector or nil if the item has no variants.
+func getVariant(ctx context.Context, db app.PgxExecutor, i *item.Item, selector *item.VariantSelector) (*item.Variant, error) {
+	if selector != nil {
+		return item.NewGetter(db).GetVariant(ctx, i.ID, selector)
+	}
+	variants, err := item.NewGetter(db).GetVariantsByItemID(ctx, i.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(variants) > 0 {
+		return nil, ErrVariantRequired
+	}
+	return nil, nil
+}
+
+func getUsersByIDsForUpdate(ctx context.Context, db app.PgxExecutor, ids ...uuid.UUID) (map[uuid.UUID]*user.User, error) {
 	query := `
 		SELECT id, username, password_hash, balance
 		FROM users
-		WHERE id = $1
+		WHERE id = ANY($1)
+		ORDER BY id
 		FOR UPDATE
 	`
-	args := []any{id}
+	args := []any{ids}
 
 	rows, _ := db.Query(ctx, query, args...)
-	u, err := pgx.CollectExactlyOneRow(rows, user.RowToUser)
+	users, err := pgx.CollectRows(rows, user.RowToUser)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	usersMap := make(map[uuid.UUID]*user.User)
+	for _, u := range users {
+		usersMap[u.ID] = u
+	}
+
+	for _, id := range ids {
+		_, ok := usersMap[id]
+		if !ok {
 			return nil, user.ErrNotExist
 		}
-		return nil, err
 	}
 
-	return u, nil
+	return usersMap, nil
 }
 
-func createPurchase(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, itemID uuid.UUID, amount int) (*Purchase, error) {
+func createPurchase(
+	ctx context.Context,
+	db app.PgxExecutor,
+	userID uuid.UUID,
+	itemID uuid.UUID,
+	variantID *uuid.UUID,
+	listPrice int,
+	amount int,
+	campaignID *uuid.UUID,
+	promoCodeID *uuid.UUID,
+	buyerID uuid.UUID,
+	note string,
+) (*Purchase, error) {
 	query := `
-		INSERT INTO purchases (user_id, item_id, amount)
-		VALUES ($1, $2, $3)
-		RETURNING id, created_at, user_id, item_id, amount
+		INSERT INTO purchases (user_id, item_id, variant_id, list_price, amount, campaign_id, promo_code_id, buyer_id, note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, user_id, item_id, variant_id, list_price, amount, campaign_id, promo_code_id, buyer_id, note
 	`
-	args := []any{userID, itemID, amount}
+	args := []any{userID, itemID, variantID, listPrice, amount, campaignID, promoCodeID, buyerID, note}
 
 	rows, _ := db.Query(ctx, query, args...)
 	p, err := pgx.CollectExactlyOneRow(rows, RowToPurchase)
@@ -107,6 +426,59 @@ func createPurchase(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, i
 	return p, nil
 }
 
+// createItemUnit puts the purchased item into the owner's inventory.
+func createItemUnit(ctx context.Context, db app.PgxExecutor, itemID uuid.UUID, variantID *uuid.UUID, ownerID uuid.UUID, purchaseID uuid.UUID) error {
+	query := `
+		INSERT INTO item_units (item_id, variant_id, owner_id, purchase_id)
+		VALUES ($1, $2, $3, $4)
+	`
+	args := []any{itemID, variantID, ownerID, purchaseID}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func getVariantForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*item.Variant, error) {
+	query := `
+		SELECT id, created_at, item_id, size, color, stock, price
+		FROM item_variants
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	v, err := pgx.CollectExactlyOneRow(rows, item.RowToVariant)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, item.ErrVariantNotExist
+		}
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func updateVariantStock(ctx context.Context, db app.PgxExecutor, id uuid.UUID, stock int) error {
+	query := `
+		UPDATE item_variants
+		SET stock = $2
+		WHERE id = $1
+	`
+	args := []any{id, stock}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func updateUserBalance(ctx context.Context, db app.PgxExecutor, id uuid.UUID, balance int) (*user.User, error) {
 	query := `
 		UPDATE users
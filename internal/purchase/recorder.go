@@ -0,0 +1,109 @@
+package purchase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/outbox"
+)
+
+// Recorder records purchases of items paid for outside of Purchaser, e.g. won auctions and raffle prizes,
+// the same way Purchaser records its own, so stock is taken and purchase events are emitted.
+// It should be created with the caller's transaction, the purchase is committed with it.
+type Recorder struct {
+	db app.PgxExecutor
+}
+
+func NewRecorder(db app.PgxExecutor) *Recorder {
+	return &Recorder{db: db}
+}
+
+type RecorderRecordParams struct {
+	ItemID uuid.UUID
+	UserID uuid.UUID // buyer and owner of the item
+	Amount int       // charged price, the caller takes the coins
+	Note   string
+}
+
+// Record records the purchase of the item at the amount and puts the item into the user's inventory.
+// Purchase limits aren't checked, the caller decides whether they apply.
+// Only items without variants can be recorded, [ErrVariantRequired] is returned for the others.
+func (r *Recorder) Record(ctx context.Context, params *RecorderRecordParams) (*Purchase, error) {
+	i, err := item.NewGetter(r.db).GetItemByID(ctx, params.ItemID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Recorder: %w", err)
+	}
+
+	variant, err := getVariant(ctx, r.db, i, nil)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Recorder: %w", err)
+	}
+
+	listPrice, variant, err := takeStock(ctx, r.db, i, variant)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Recorder: %w", err)
+	}
+	var variantID *uuid.UUID
+	if variant != nil {
+		variantID = &variant.ID
+	}
+
+	p, err := recordPurchase(ctx, r.db, &Purchase{
+		UserID:    params.UserID,
+		ItemID:    i.ID,
+		VariantID: variantID,
+		ListPrice: listPrice,
+		Amount:    params.Amount,
+		BuyerID:   params.UserID,
+		Note:      params.Note,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Recorder: %w", err)
+	}
+
+	p.ItemName = i.Name
+	if variant != nil {
+		p.VariantSize = variant.Size
+		p.VariantColor = variant.Color
+	}
+
+	return p, nil
+}
+
+// recordPurchase creates the purchase, puts its item into the owner's inventory
+// and writes the purchase events to the outbox.
+// Every purchase is recorded by it, so the events are emitted however the item was paid for.
+func recordPurchase(ctx context.Context, db app.PgxExecutor, p *Purchase) (*Purchase, error) {
+	p, err := createPurchase(
+		ctx,
+		db,
+		p.UserID,
+		p.ItemID,
+		p.VariantID,
+		p.ListPrice,
+		p.Amount,
+		p.CampaignID,
+		p.PromoCodeID,
+		p.BuyerID,
+		p.Note,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createItemUnit(ctx, db, p.ItemID, p.VariantID, p.UserID, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = outbox.NewWriter(db).WriteEvents(ctx, purchaseEvents(p)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
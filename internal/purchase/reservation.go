@@ -0,0 +1,106 @@
+package purchase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusActive    ReservationStatus = "active"    // stock and coins are held
+	ReservationStatusPurchased ReservationStatus = "purchased" // converted into a purchase
+	ReservationStatusCancelled ReservationStatus = "cancelled" // cancelled by the user, stock and coins are returned
+	ReservationStatusExpired   ReservationStatus = "expired"   // not purchased in time, stock and coins are returned
+)
+
+// Reservation holds a unit of an item and the coins for it while the user confirms the purchase.
+type Reservation struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	UserID     uuid.UUID
+	ItemID     uuid.UUID
+	VariantID  *uuid.UUID // nil for items without variants
+	ListPrice  int
+	Amount     int // held coins, charged when the reservation is purchased
+	CampaignID *uuid.UUID
+	Status     ReservationStatus
+	ExpiresAt  time.Time
+	DecidedAt  *time.Time
+	PurchaseID *uuid.UUID
+
+	ItemName     string
+	VariantSize  string
+	VariantColor string
+}
+
+type ReservationRow struct {
+	ID         uuid.UUID  `db:"id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UserID     uuid.UUID  `db:"user_id"`
+	ItemID     uuid.UUID  `db:"item_id"`
+	VariantID  *uuid.UUID `db:"variant_id"`
+	ListPrice  int        `db:"list_price"`
+	Amount     int        `db:"amount"`
+	CampaignID *uuid.UUID `db:"campaign_id"`
+	Status     string     `db:"status"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	DecidedAt  *time.Time `db:"decided_at"`
+	PurchaseID *uuid.UUID `db:"purchase_id"`
+}
+
+func RowToReservation(collectable pgx.CollectableRow) (*Reservation, error) {
+	collected, err := pgx.RowToStructByName[ReservationRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reservation{
+		ID:         collected.ID,
+		CreatedAt:  collected.CreatedAt,
+		UserID:     collected.UserID,
+		ItemID:     collected.ItemID,
+		VariantID:  collected.VariantID,
+		ListPrice:  collected.ListPrice,
+		Amount:     collected.Amount,
+		CampaignID: collected.CampaignID,
+		Status:     ReservationStatus(collected.Status),
+		ExpiresAt:  collected.ExpiresAt,
+		DecidedAt:  collected.DecidedAt,
+		PurchaseID: collected.PurchaseID,
+	}, nil
+}
+
+type ReservationRowWithItem struct {
+	ReservationRow
+	ItemName     string `db:"item_name"`
+	VariantSize  string `db:"variant_size"`
+	VariantColor string `db:"variant_color"`
+}
+
+func RowToReservationWithItem(collectable pgx.CollectableRow) (*Reservation, error) {
+	collected, err := pgx.RowToStructByName[ReservationRowWithItem](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reservation{
+		ID:           collected.ID,
+		CreatedAt:    collected.CreatedAt,
+		UserID:       collected.UserID,
+		ItemID:       collected.ItemID,
+		VariantID:    collected.VariantID,
+		ListPrice:    collected.ListPrice,
+		Amount:       collected.Amount,
+		CampaignID:   collected.CampaignID,
+		Status:       ReservationStatus(collected.Status),
+		ExpiresAt:    collected.ExpiresAt,
+		DecidedAt:    collected.DecidedAt,
+		PurchaseID:   collected.PurchaseID,
+		ItemName:     collected.ItemName,
+		VariantSize:  collected.VariantSize,
+		VariantColor: collected.VariantColor,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package purchase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// ReservationExpirer releases active reservations that weren't purchased in time.
+type ReservationExpirer struct {
+	db app.PgxExecutor
+}
+
+func NewReservationExpirer(db app.PgxExecutor) *ReservationExpirer {
+	return &ReservationExpirer{db: db}
+}
+
+// ExpireActive expires all overdue active reservations and returns the held stock and coins.
+// It returns the number of expired reservations.
+func (e *ReservationExpirer) ExpireActive(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		expired, err := e.expireOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("purchase.ReservationExpirer: %w", err)
+		}
+		if !expired {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (e *ReservationExpirer) expireOne(ctx context.Context) (bool, error) {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	res, err := getOverdueActiveReservationForUpdate(ctx, tx)
+	if err != nil {
+		if errors.Is(err, ErrReservationNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	_, err = releaseReservation(ctx, tx, res, ReservationStatusExpired)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func getOverdueActiveReservationForUpdate(ctx context.Context, db app.PgxExecutor) (*Reservation, error) {
+	query := `
+		SELECT id, created_at, user_id, item_id, variant_id, list_price, amount, campaign_id,
+			   status, expires_at, decided_at, purchase_id
+		FROM reservations
+		WHERE status = 'active' AND expires_at <= now()
+		ORDER BY expires_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	res, err := pgx.CollectExactlyOneRow(rows, RowToReservation)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReservationNotExist
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
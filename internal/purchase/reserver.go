@@ -0,0 +1,291 @@
+package purchase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+)
+
+// Reserver reserves items and cancels reservations.
+type Reserver struct {
+	db app.PgxExecutor
+}
+
+func NewReserver(db app.PgxExecutor) *Reserver {
+	return &Reserver{db: db}
+}
+
+type ReserverReserveParams struct {
+	ItemName string
+	UserID   uuid.UUID
+
+	// Variant must be nil for items without variants.
+	// For items with variants, it can be nil to pick the variant when the reservation is purchased.
+	Variant *item.VariantSelector
+
+	// TTL is how long the reservation holds the stock and the coins.
+	TTL time.Duration
+}
+
+// Reserve holds a unit of the item and its price in coins for the user until the TTL passes.
+// The price is evaluated now and doesn't change when the reservation is purchased.
+// If the variant isn't picked yet, the coins for the most expensive variant in stock are held
+// and no stock is, the variant is picked and its stock is taken when the reservation is purchased.
+// Active reservations count towards purchase limits.
+func (r *Reserver) Reserve(ctx context.Context, params *ReserverReserveParams) (*Reservation, error) {
+	i, err := item.NewGetter(r.db).GetItemByName(ctx, params.ItemName)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+
+	var variants []*item.Variant
+	variant, err := getVariant(ctx, r.db, i, params.Variant)
+	if errors.Is(err, ErrVariantRequired) {
+		variants, err = item.NewGetter(r.db).GetVariantsByItemID(ctx, i.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, params.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+	u := usersMap[params.UserID]
+
+	err = checkLimit(ctx, tx, i.ID, params.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+
+	var listPrice int
+	if variants != nil {
+		listPrice, err = highestVariantPrice(i, variants)
+	} else {
+		listPrice, variant, err = takeStock(ctx, tx, i, variant)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+	var variantID *uuid.UUID
+	if variant != nil {
+		variantID = &variant.ID
+	}
+
+	price, campaignID, err := campaignPrice(ctx, tx, i, listPrice)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+
+	_, err = debit(ctx, tx, u, price)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+
+	expiresAt := time.Now().Add(params.TTL)
+	res, err := createReservation(ctx, tx, params.UserID, i.ID, variantID, listPrice, price, campaignID, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+	res.ItemName = i.Name
+	if variant != nil {
+		res.VariantSize = variant.Size
+		res.VariantColor = variant.Color
+	}
+
+	return res, nil
+}
+
+// Cancel cancels the user's active reservation and returns the held stock and coins.
+func (r *Reserver) Cancel(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*Reservation, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	res, err := getUserReservationForUpdate(ctx, tx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+	if res.Status != ReservationStatusActive {
+		return nil, fmt.Errorf("purchase.Reserver: %w", ErrReservationNotActive)
+	}
+
+	decided, err := releaseReservation(ctx, tx, res, ReservationStatusCancelled)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("purchase.Reserver: %w", err)
+	}
+	decided.ItemName = res.ItemName
+	decided.VariantSize = res.VariantSize
+	decided.VariantColor = res.VariantColor
+
+	return decided, nil
+}
+
+// highestVariantPrice returns the list price of the most expensive variant in stock.
+func highestVariantPrice(i *item.Item, variants []*item.Variant) (int, error) {
+	price, inStock := 0, false
+	for _, v := range variants {
+		if v.Stock > 0 {
+			price, inStock = max(price, v.PriceOr(i.Price)), true
+		}
+	}
+	if !inStock {
+		return 0, ErrOutOfStock
+	}
+	return price, nil
+}
+
+// releaseReservation returns the held stock and coins of the locked reservation
+// and sets its status.
+func releaseReservation(ctx context.Context, db app.PgxExecutor, res *Reservation, status ReservationStatus) (*Reservation, error) {
+	usersMap, err := getUsersByIDsForUpdate(ctx, db, res.UserID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = updateUserBalance(ctx, db, res.UserID, usersMap[res.UserID].Balance+res.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.VariantID != nil {
+		err = returnVariantStock(ctx, db, *res.VariantID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return updateReservationDecision(ctx, db, res.ID, status, nil)
+}
+
+func createReservation(
+	ctx context.Context,
+	db app.PgxExecutor,
+	userID uuid.UUID,
+	itemID uuid.UUID,
+	variantID *uuid.UUID,
+	listPrice int,
+	amount int,
+	campaignID *uuid.UUID,
+	expiresAt time.Time,
+) (*Reservation, error) {
+	query := `
+		INSERT INTO reservations (user_id, item_id, variant_id, list_price, amount, campaign_id, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, user_id, item_id, variant_id, list_price, amount, campaign_id,
+				  status, expires_at, decided_at, purchase_id
+	`
+	args := []any{userID, itemID, variantID, listPrice, amount, campaignID, expiresAt}
+
+	rows, _ := db.Query(ctx, query, args...)
+	res, err := pgx.CollectExactlyOneRow(rows, RowToReservation)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// getUserReservationForUpdate locks the reservation if it belongs to the user.
+// Reservations of other users are reported as not existing.
+func getUserReservationForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID, userID uuid.UUID) (*Reservation, error) {
+	query := `
+		SELECT r.id, r.created_at, r.user_id, r.item_id, r.variant_id, r.list_price, r.amount, r.campaign_id,
+			   r.status, r.expires_at, r.decided_at, r.purchase_id,
+			   i.name as item_name,
+			   coalesce(v.size, '') as variant_size,
+			   coalesce(v.color, '') as variant_color
+		FROM reservations r
+		JOIN items i ON r.item_id = i.id
+		LEFT JOIN item_variants v ON r.variant_id = v.id
+		WHERE r.id = $1 AND r.user_id = $2
+		FOR UPDATE OF r
+	`
+	args := []any{id, userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	res, err := pgx.CollectExactlyOneRow(rows, RowToReservationWithItem)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReservationNotExist
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func updateReservationDecision(ctx context.Context, db app.PgxExecutor, id uuid.UUID, status ReservationStatus, purchaseID *uuid.UUID) (*Reservation, error) {
+	query := `
+		UPDATE reservations
+		SET status = $2, decided_at = now(), purchase_id = $3
+		WHERE id = $1
+		RETURNING id, created_at, user_id, item_id, variant_id, list_price, amount, campaign_id,
+				  status, expires_at, decided_at, purchase_id
+	`
+	args := []any{id, string(status), purchaseID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	res, err := pgx.CollectExactlyOneRow(rows, RowToReservation)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReservationNotExist
+		}
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// returnVariantStock puts a held unit back into the variant's stock.
+// The stock may have been changed by an admin in the meantime, so it is incremented in place.
+func returnVariantStock(ctx context.Context, db app.PgxExecutor, id uuid.UUID) error {
+	query := `
+		UPDATE item_variants
+		SET stock = stock + 1
+		WHERE id = $1
+	`
+	args := []any{id}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package raffle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+)
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreateRaffleParams struct {
+	Name              string
+	ItemName          string
+	TicketPrice       int
+	WinnerCount       int
+	MaxTicketsPerUser *int // nil if unlimited
+	DrawsAt           time.Time
+	CreatedBy         uuid.UUID
+}
+
+// CreateRaffle opens a raffle for the item that is drawn at DrawsAt.
+// Its seed is generated now and only its hash is shown until the draw.
+// Only items without variants can be raffled because there is no stock to take a unit from.
+func (c *Creator) CreateRaffle(ctx context.Context, params *CreatorCreateRaffleParams) (*Raffle, error) {
+	if params.TicketPrice <= 0 || params.WinnerCount <= 0 || !params.DrawsAt.After(time.Now()) {
+		return nil, fmt.Errorf("raffle.Creator: %w", ErrInvalidValue)
+	}
+	if params.MaxTicketsPerUser != nil && *params.MaxTicketsPerUser <= 0 {
+		return nil, fmt.Errorf("raffle.Creator: %w", ErrInvalidValue)
+	}
+
+	i, err := item.NewGetter(c.db).GetItemByName(ctx, params.ItemName)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.Creator: %w", err)
+	}
+	variants, err := item.NewGetter(c.db).GetVariantsByItemID(ctx, i.ID)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.Creator: %w", err)
+	}
+	if len(variants) > 0 {
+		return nil, fmt.Errorf("raffle.Creator: %w", ErrItemHasVariants)
+	}
+
+	seed, seedHash, err := NewSeed()
+	if err != nil {
+		return nil, fmt.Errorf("raffle.Creator: %w", err)
+	}
+
+	r, err := createRaffle(ctx, c.db, params, i.ID, seed, seedHash)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.Creator: %w", err)
+	}
+	r.ItemName = i.Name
+
+	return r, nil
+}
+
+func createRaffle(ctx context.Context, db app.PgxExecutor, params *CreatorCreateRaffleParams, itemID uuid.UUID, seed string, seedHash string) (*Raffle, error) {
+	query := `
+		INSERT INTO raffles (name, item_id, ticket_price, winner_count, max_tickets_per_user, draws_at,
+							 seed, seed_hash, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, name, item_id, ticket_price, winner_count, max_tickets_per_user, draws_at,
+				  status, seed, seed_hash, ticket_count, drawn_at, created_by
+	`
+	args := []any{
+		params.Name,
+		itemID,
+		params.TicketPrice,
+		params.WinnerCount,
+		params.MaxTicketsPerUser,
+		params.DrawsAt,
+		seed,
+		seedHash,
+		params.CreatedBy,
+	}
+
+	rows, _ := db.Query(ctx, query, args...)
+	r, err := pgx.CollectExactlyOneRow(rows, RowToRaffle)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,294 @@
+package raffle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/notification"
+	"github.com/k11v/merch/internal/purchase"
+	"github.com/k11v/merch/internal/user"
+)
+
+// Drawer draws the winners of raffles whose draw time has come.
+type Drawer struct {
+	db app.PgxExecutor
+}
+
+func NewDrawer(db app.PgxExecutor) *Drawer {
+	return &Drawer{db: db}
+}
+
+// DrawDue draws all open raffles whose draw time has come.
+// Each winning ticket gets a free purchase of the prize that puts it into the winner's inventory.
+// Raffles whose prize can't be given away anymore, e.g. it got variants, are cancelled and their tickets are refunded.
+// It returns the number of drawn raffles.
+func (d *Drawer) DrawDue(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		drawn, err := d.drawOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("raffle.Drawer: %w", err)
+		}
+		if !drawn {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (d *Drawer) drawOne(ctx context.Context) (bool, error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	r, err := getDueOpenRaffleForUpdate(ctx, tx)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = drawWinners(ctx, tx, r)
+	if errors.Is(err, purchase.ErrVariantRequired) || errors.Is(err, purchase.ErrOutOfStock) {
+		slog.Warn("cancelled raffle whose prize can't be given away", "raffle_id", r.ID, "err", err)
+		err = cancelRaffle(ctx, tx, r)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// drawWinners gives the prize to the winning tickets of the locked raffle and marks it drawn.
+func drawWinners(ctx context.Context, db app.PgxExecutor, r *Raffle) error {
+	winners, err := Winners(r.Seed, r.TicketCount, r.WinnerCount)
+	if err != nil {
+		return err
+	}
+
+	for _, number := range winners {
+		t, err := getTicketByNumber(ctx, db, r.ID, number)
+		if err != nil {
+			return err
+		}
+		// The prize is free, the coins were spent on tickets.
+		p, err := purchase.NewRecorder(db).Record(ctx, &purchase.RecorderRecordParams{
+			ItemID: r.ItemID,
+			UserID: t.UserID,
+			Amount: 0,
+			Note:   fmt.Sprintf("raffle prize: %s", r.Name),
+		})
+		if err != nil {
+			return err
+		}
+		err = updateTicketPurchaseID(ctx, db, t.ID, p.ID)
+		if err != nil {
+			return err
+		}
+		_, err = notification.NewCreator(db).CreateNotification(ctx, &notification.CreatorCreateNotificationParams{
+			UserID:  t.UserID,
+			Kind:    notification.KindRaffleWon,
+			Message: fmt.Sprintf("your ticket #%d won the %s raffle, the prize is in your inventory", t.Number, r.Name),
+			ItemID:  &r.ItemID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateRaffleStatus(ctx, db, r.ID, StatusDrawn)
+}
+
+// cancelRaffle refunds the tickets of the locked raffle and marks it cancelled.
+func cancelRaffle(ctx context.Context, db app.PgxExecutor, r *Raffle) error {
+	refunds, err := getTicketRefunds(ctx, db, r.ID)
+	if err != nil {
+		return err
+	}
+	userIDs := make([]uuid.UUID, len(refunds))
+	for i, refund := range refunds {
+		userIDs[i] = refund.UserID
+	}
+
+	usersMap, err := getUsersByIDsForUpdate(ctx, db, userIDs...)
+	if err != nil {
+		return err
+	}
+	for _, refund := range refunds {
+		err = updateUserBalance(ctx, db, refund.UserID, usersMap[refund.UserID].Balance+refund.Amount)
+		if err != nil {
+			return err
+		}
+		_, err = notification.NewCreator(db).CreateNotification(ctx, &notification.CreatorCreateNotificationParams{
+			UserID:  refund.UserID,
+			Kind:    notification.KindRaffleCancelled,
+			Message: fmt.Sprintf("the %s raffle was cancelled, your %d coins for tickets were returned", r.Name, refund.Amount),
+			ItemID:  &r.ItemID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateRaffleStatus(ctx, db, r.ID, StatusCancelled)
+}
+
+func getDueOpenRaffleForUpdate(ctx context.Context, db app.PgxExecutor) (*Raffle, error) {
+	query := `
+		SELECT id, created_at, name, item_id, ticket_price, winner_count, max_tickets_per_user, draws_at,
+			   status, seed, seed_hash, ticket_count, drawn_at, created_by
+		FROM raffles
+		WHERE status = 'open' AND draws_at <= now()
+		ORDER BY draws_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	r, err := pgx.CollectExactlyOneRow(rows, RowToRaffle)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func getTicketByNumber(ctx context.Context, db app.PgxExecutor, raffleID uuid.UUID, number int) (*Ticket, error) {
+	query := `
+		SELECT id, created_at, raffle_id, user_id, number, amount, purchase_id
+		FROM raffle_tickets
+		WHERE raffle_id = $1 AND number = $2
+	`
+	args := []any{raffleID, number}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTicket)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func updateTicketPurchaseID(ctx context.Context, db app.PgxExecutor, id uuid.UUID, purchaseID uuid.UUID) error {
+	query := `
+		UPDATE raffle_tickets
+		SET purchase_id = $2
+		WHERE id = $1
+	`
+	args := []any{id, purchaseID}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ticketRefund is the total amount a user paid for the tickets of a raffle.
+type ticketRefund struct {
+	UserID uuid.UUID `db:"user_id"`
+	Amount int       `db:"amount"`
+}
+
+func getTicketRefunds(ctx context.Context, db app.PgxExecutor, raffleID uuid.UUID) ([]*ticketRefund, error) {
+	query := `
+		SELECT user_id, sum(amount)::integer as amount
+		FROM raffle_tickets
+		WHERE raffle_id = $1
+		GROUP BY user_id
+	`
+	args := []any{raffleID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	refunds, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ticketRefund])
+	if err != nil {
+		return nil, err
+	}
+
+	return refunds, nil
+}
+
+func getUsersByIDsForUpdate(ctx context.Context, db app.PgxExecutor, ids ...uuid.UUID) (map[uuid.UUID]*user.User, error) {
+	query := `
+		SELECT id, username, password_hash, balance
+		FROM users
+		WHERE id = ANY($1)
+		ORDER BY id
+		FOR UPDATE
+	`
+	args := []any{ids}
+
+	rows, _ := db.Query(ctx, query, args...)
+	users, err := pgx.CollectRows(rows, user.RowToUser)
+	if err != nil {
+		return nil, err
+	}
+
+	usersMap := make(map[uuid.UUID]*user.User)
+	for _, u := range users {
+		usersMap[u.ID] = u
+	}
+
+	return usersMap, nil
+}
+
+func updateUserBalance(ctx context.Context, db app.PgxExecutor, id uuid.UUID, balance int) error {
+	query := `
+		UPDATE users
+		SET balance = $2
+		WHERE id = $1
+	`
+	args := []any{id, balance}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateRaffleStatus sets the final status of the raffle, drawn_at is set only for drawn raffles.
+func updateRaffleStatus(ctx context.Context, db app.PgxExecutor, id uuid.UUID, status Status) error {
+	query := `
+		UPDATE raffles
+		SET status = $2, drawn_at = CASE WHEN $2 = 'drawn' THEN now() END
+		WHERE id = $1
+	`
+	args := []any{id, string(status)}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package raffle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetOpenRaffles returns open raffles, drawn soonest first.
+func (g *Getter) GetOpenRaffles(ctx context.Context) ([]*Raffle, error) {
+	raffles, err := getOpenRaffles(ctx, g.db)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.Getter: %w", err)
+	}
+	return raffles, nil
+}
+
+func (g *Getter) GetRaffle(ctx context.Context, id uuid.UUID) (*Raffle, error) {
+	r, err := getRaffle(ctx, g.db, id)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.Getter: %w", err)
+	}
+	return r, nil
+}
+
+// GetTicketsByRaffleID returns the raffle's tickets ordered by number.
+// Together with the revealed seed they are enough to repeat the draw.
+func (g *Getter) GetTicketsByRaffleID(ctx context.Context, raffleID uuid.UUID) ([]*Ticket, error) {
+	tickets, err := getTicketsByRaffleID(ctx, g.db, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.Getter: %w", err)
+	}
+	return tickets, nil
+}
+
+func getOpenRaffles(ctx context.Context, db app.PgxExecutor) ([]*Raffle, error) {
+	query := `
+		SELECT r.id, r.created_at, r.name, r.item_id, r.ticket_price, r.winner_count, r.max_tickets_per_user, r.draws_at,
+			   r.status, r.seed, r.seed_hash, r.ticket_count, r.drawn_at, r.created_by,
+			   i.name as item_name
+		FROM raffles r
+		JOIN items i ON r.item_id = i.id
+		WHERE r.status = 'open'
+		ORDER BY r.draws_at, r.id
+	`
+
+	rows, _ := db.Query(ctx, query)
+	raffles, err := pgx.CollectRows(rows, RowToRaffleWithItemName)
+	if err != nil {
+		return nil, err
+	}
+
+	return raffles, nil
+}
+
+func getRaffle(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Raffle, error) {
+	query := `
+		SELECT r.id, r.created_at, r.name, r.item_id, r.ticket_price, r.winner_count, r.max_tickets_per_user, r.draws_at,
+			   r.status, r.seed, r.seed_hash, r.ticket_count, r.drawn_at, r.created_by,
+			   i.name as item_name
+		FROM raffles r
+		JOIN items i ON r.item_id = i.id
+		WHERE r.id = $1
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	r, err := pgx.CollectExactlyOneRow(rows, RowToRaffleWithItemName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func getTicketsByRaffleID(ctx context.Context, db app.PgxExecutor, raffleID uuid.UUID) ([]*Ticket, error) {
+	query := `
+		SELECT t.id, t.created_at, t.raffle_id, t.user_id, t.number, t.amount, t.purchase_id,
+			   u.username
+		FROM raffle_tickets t
+		JOIN users u ON t.user_id = u.id
+		WHERE t.raffle_id = $1
+		ORDER BY t.number
+	`
+	args := []any{raffleID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	tickets, err := pgx.CollectRows(rows, RowToTicketWithUsername)
+	if err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
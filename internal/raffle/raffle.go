@@ -0,0 +1,183 @@
+package raffle
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrNotExist           = errors.New("does not exist")
+	ErrInvalidValue       = errors.New("invalid value")
+	ErrItemHasVariants    = errors.New("item has variants")
+	ErrClosed             = errors.New("raffle closed")
+	ErrTicketLimitReached = errors.New("ticket limit reached")
+)
+
+type Status string
+
+const (
+	StatusOpen      Status = "open"      // sells tickets until the draw
+	StatusDrawn     Status = "drawn"     // winners were drawn and the seed was revealed
+	StatusCancelled Status = "cancelled" // the prize couldn't be given away, the tickets were refunded
+)
+
+// Raffle gives away WinnerCount units of an item to the holders of randomly drawn tickets.
+type Raffle struct {
+	ID                uuid.UUID
+	CreatedAt         time.Time
+	Name              string
+	ItemID            uuid.UUID
+	TicketPrice       int
+	WinnerCount       int
+	MaxTicketsPerUser *int // nil if unlimited
+	DrawsAt           time.Time
+	Status            Status
+	Seed              string // hex, must stay secret until drawn
+	SeedHash          string // hex sha256 of the seed bytes, published in advance
+	TicketCount       int
+	DrawnAt           *time.Time
+	CreatedBy         uuid.UUID
+
+	ItemName string
+}
+
+type Row struct {
+	ID                uuid.UUID  `db:"id"`
+	CreatedAt         time.Time  `db:"created_at"`
+	Name              string     `db:"name"`
+	ItemID            uuid.UUID  `db:"item_id"`
+	TicketPrice       int        `db:"ticket_price"`
+	WinnerCount       int        `db:"winner_count"`
+	MaxTicketsPerUser *int       `db:"max_tickets_per_user"`
+	DrawsAt           time.Time  `db:"draws_at"`
+	Status            string     `db:"status"`
+	Seed              string     `db:"seed"`
+	SeedHash          string     `db:"seed_hash"`
+	TicketCount       int        `db:"ticket_count"`
+	DrawnAt           *time.Time `db:"drawn_at"`
+	CreatedBy         uuid.UUID  `db:"created_by"`
+}
+
+func RowToRaffle(collectable pgx.CollectableRow) (*Raffle, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Raffle{
+		ID:                collected.ID,
+		CreatedAt:         collected.CreatedAt,
+		Name:              collected.Name,
+		ItemID:            collected.ItemID,
+		TicketPrice:       collected.TicketPrice,
+		WinnerCount:       collected.WinnerCount,
+		MaxTicketsPerUser: collected.MaxTicketsPerUser,
+		DrawsAt:           collected.DrawsAt,
+		Status:            Status(collected.Status),
+		Seed:              collected.Seed,
+		SeedHash:          collected.SeedHash,
+		TicketCount:       collected.TicketCount,
+		DrawnAt:           collected.DrawnAt,
+		CreatedBy:         collected.CreatedBy,
+	}, nil
+}
+
+type RowWithItemName struct {
+	Row
+	ItemName string `db:"item_name"`
+}
+
+func RowToRaffleWithItemName(collectable pgx.CollectableRow) (*Raffle, error) {
+	collected, err := pgx.RowToStructByName[RowWithItemName](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Raffle{
+		ID:                collected.ID,
+		CreatedAt:         collected.CreatedAt,
+		Name:              collected.Name,
+		ItemID:            collected.ItemID,
+		TicketPrice:       collected.TicketPrice,
+		WinnerCount:       collected.WinnerCount,
+		MaxTicketsPerUser: collected.MaxTicketsPerUser,
+		DrawsAt:           collected.DrawsAt,
+		Status:            Status(collected.Status),
+		Seed:              collected.Seed,
+		SeedHash:          collected.SeedHash,
+		TicketCount:       collected.TicketCount,
+		DrawnAt:           collected.DrawnAt,
+		CreatedBy:         collected.CreatedBy,
+		ItemName:          collected.ItemName,
+	}, nil
+}
+
+// Ticket is a numbered raffle ticket bought by a user.
+type Ticket struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	RaffleID   uuid.UUID
+	UserID     uuid.UUID
+	Number     int
+	Amount     int
+	PurchaseID *uuid.UUID // nil unless the ticket won, refers to the prize
+
+	Username string
+}
+
+func (t *Ticket) Won() bool {
+	return t.PurchaseID != nil
+}
+
+type TicketRow struct {
+	ID         uuid.UUID  `db:"id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	RaffleID   uuid.UUID  `db:"raffle_id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	Number     int        `db:"number"`
+	Amount     int        `db:"amount"`
+	PurchaseID *uuid.UUID `db:"purchase_id"`
+}
+
+func RowToTicket(collectable pgx.CollectableRow) (*Ticket, error) {
+	collected, err := pgx.RowToStructByName[TicketRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ticket{
+		ID:         collected.ID,
+		CreatedAt:  collected.CreatedAt,
+		RaffleID:   collected.RaffleID,
+		UserID:     collected.UserID,
+		Number:     collected.Number,
+		Amount:     collected.Amount,
+		PurchaseID: collected.PurchaseID,
+	}, nil
+}
+
+type TicketRowWithUsername struct {
+	TicketRow
+	Username string `db:"username"`
+}
+
+func RowToTicketWithUsername(collectable pgx.CollectableRow) (*Ticket, error) {
+	collected, err := pgx.RowToStructByName[TicketRowWithUsername](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ticket{
+		ID:         collected.ID,
+		CreatedAt:  collected.CreatedAt,
+		RaffleID:   collected.RaffleID,
+		UserID:     collected.UserID,
+		Number:     collected.Number,
+		Amount:     collected.Amount,
+		PurchaseID: collected.PurchaseID,
+		Username:   collected.Username,
+	}, nil
+}
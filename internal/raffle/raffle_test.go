@@ -0,0 +1,270 @@
+package raffle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/inventory"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestRaffle(t *testing.T) {
+	t.Run("draws winners reproducibly", func(t *testing.T) {
+		seed, seedHash, err := NewSeed()
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		seedBytes, err := hex.DecodeString(seed)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		sum := sha256.Sum256(seedBytes)
+		if got, want := seedHash, hex.EncodeToString(sum[:]); got != want {
+			t.Errorf("got %s seed hash, want %s", got, want)
+		}
+
+		winners, err := Winners(seed, 10, 3)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		again, err := Winners(seed, 10, 3)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := winners, again; !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v winners, want %v", got, want)
+		}
+		seen := make(map[int]bool)
+		for _, w := range winners {
+			if w < 0 || w >= 10 || seen[w] {
+				t.Errorf("got %v winners, want 3 distinct tickets from 0 to 9", winners)
+			}
+			seen[w] = true
+		}
+
+		winners, err = Winners(seed, 2, 3)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(winners), 2; got != want {
+			t.Errorf("got %d winners of 2 tickets, want %d", got, want)
+		}
+	})
+	t.Run("sells tickets and draws prizes", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			rc  = NewCreator(db)
+			rtb = NewTicketBuyer(db)
+			rd  = NewDrawer(db)
+			rg  = NewGetter(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		initialBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		maxTickets := 3
+		r, err := rc.CreateRaffle(ctx, &CreatorCreateRaffleParams{
+			Name:              "powerbank giveaway",
+			ItemName:          "powerbank",
+			TicketPrice:       10,
+			WinnerCount:       1,
+			MaxTicketsPerUser: &maxTickets,
+			DrawsAt:           time.Now().Add(time.Hour),
+			CreatedBy:         admin.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = rtb.BuyTickets(ctx, r.ID, alice.ID, 2)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = rtb.BuyTickets(ctx, r.ID, alice.ID, 2)
+		if got, want := err, ErrTicketLimitReached; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = rtb.BuyTickets(ctx, r.ID, bob.ID, initialBalance)
+		if got, want := err, coin.ErrNotEnough; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = rtb.BuyTickets(ctx, r.ID, bob.ID, 1)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := aliceBalance, initialBalance-20; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+
+		_, err = db.Exec(ctx, "UPDATE raffles SET draws_at = now() WHERE id = $1", r.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = rtb.BuyTickets(ctx, r.ID, bob.ID, 1)
+		if got, want := err, ErrClosed; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		count, err := rd.DrawDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d drawn raffles, want %d", got, want)
+		}
+
+		drawn, err := rg.GetRaffle(ctx, r.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := drawn.Status, StatusDrawn; got != want {
+			t.Errorf("got %s status, want %s", got, want)
+		}
+		tickets, err := rg.GetTicketsByRaffleID(ctx, r.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		winners, err := Winners(drawn.Seed, len(tickets), drawn.WinnerCount)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(winners), 1; got != want {
+			t.Fatalf("got %d winners, want %d", got, want)
+		}
+		winner := tickets[winners[0]]
+		if !winner.Won() {
+			t.Errorf("got ticket #%d not won, want won", winner.Number)
+		}
+
+		itemCounts, err := inventory.NewGetter(db).GetItemCountsByOwnerID(ctx, winner.UserID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(itemCounts), 1; got != want {
+			t.Fatalf("got %d winner item counts, want %d", got, want)
+		}
+		if got, want := itemCounts[0].ItemName, "powerbank"; got != want {
+			t.Errorf("got %s winner item, want %s", got, want)
+		}
+	})
+	t.Run("cancels due raffles whose prize got variants and draws later ones", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			rc  = NewCreator(db)
+			rtb = NewTicketBuyer(db)
+			rd  = NewDrawer(db)
+			rg  = NewGetter(db)
+		)
+		admin := usertest.CreateUser(t, ctx, db, "admin")
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+
+		initialBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		unsellable, err := rc.CreateRaffle(ctx, &CreatorCreateRaffleParams{
+			Name:        "powerbank giveaway",
+			ItemName:    "powerbank",
+			TicketPrice: 10,
+			WinnerCount: 1,
+			DrawsAt:     time.Now().Add(time.Hour),
+			CreatedBy:   admin.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		sellable, err := rc.CreateRaffle(ctx, &CreatorCreateRaffleParams{
+			Name:        "pen giveaway",
+			ItemName:    "pen",
+			TicketPrice: 5,
+			WinnerCount: 1,
+			DrawsAt:     time.Now().Add(2 * time.Hour),
+			CreatedBy:   admin.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = rtb.BuyTickets(ctx, unsellable.ID, alice.ID, 2)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = rtb.BuyTickets(ctx, sellable.ID, alice.ID, 1)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = item.NewVariantSetter(db).CreateVariant(ctx, &item.VariantSetterCreateVariantParams{
+			ItemID:   unsellable.ItemID,
+			Selector: item.VariantSelector{Size: "M"},
+			Stock:    1,
+		})
+		if got, want := err, item.ErrOnAuctionOrRaffle; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		// The variant is added as if it had been created before the check could see the raffle.
+		_, err = db.Exec(
+			ctx,
+			"INSERT INTO item_variants (item_id, size, color, stock) VALUES ($1, 'M', '', 1)",
+			unsellable.ItemID,
+		)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = db.Exec(ctx, "UPDATE raffles SET draws_at = now() WHERE id IN ($1, $2)", unsellable.ID, sellable.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		count, err := rd.DrawDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 2; got != want {
+			t.Errorf("got %d drawn raffles, want %d", got, want)
+		}
+
+		cancelled, err := rg.GetRaffle(ctx, unsellable.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := cancelled.Status, StatusCancelled; got != want {
+			t.Errorf("got %s unsellable raffle status, want %s", got, want)
+		}
+		drawn, err := rg.GetRaffle(ctx, sellable.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := drawn.Status, StatusDrawn; got != want {
+			t.Errorf("got %s sellable raffle status, want %s", got, want)
+		}
+		balance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := balance, initialBalance-5; got != want {
+			t.Errorf("got %d balance, want %d", got, want)
+		}
+	})
+}
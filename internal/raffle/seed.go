@@ -0,0 +1,51 @@
+package raffle
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+)
+
+const seedLen = 32
+
+// NewSeed returns a random hex seed and its hex SHA-256 hash.
+func NewSeed() (seed string, seedHash string, err error) {
+	b := make([]byte, seedLen)
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(b), hex.EncodeToString(sum[:]), nil
+}
+
+// Winners returns the winning ticket numbers for the hex seed.
+// Tickets are numbered from 0 to ticketCount-1 and each ticket wins at most once.
+//
+// The k-th winner (from 0) is remaining[h mod len(remaining)], where remaining are
+// the ticket numbers that haven't won yet in ascending order and h is the first 8 bytes
+// of SHA-256(seed bytes || k as a 4-byte big-endian integer) as a big-endian integer.
+// Anyone who knows the revealed seed and the ticket count can repeat the draw.
+func Winners(seed string, ticketCount int, winnerCount int) ([]int, error) {
+	b, err := hex.DecodeString(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make([]int, ticketCount)
+	for i := range remaining {
+		remaining[i] = i
+	}
+
+	winners := make([]int, 0, min(winnerCount, ticketCount))
+	for k := 0; k < winnerCount && len(remaining) > 0; k++ {
+		msg := binary.BigEndian.AppendUint32(append([]byte(nil), b...), uint32(k))
+		sum := sha256.Sum256(msg)
+		i := binary.BigEndian.Uint64(sum[:8]) % uint64(len(remaining))
+		winners = append(winners, remaining[i])
+		remaining = append(remaining[:i], remaining[i+1:]...)
+	}
+
+	return winners, nil
+}
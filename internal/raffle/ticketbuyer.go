@@ -0,0 +1,165 @@
+package raffle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/purchase"
+)
+
+type TicketBuyer struct {
+	db app.PgxExecutor
+}
+
+func NewTicketBuyer(db app.PgxExecutor) *TicketBuyer {
+	return &TicketBuyer{db: db}
+}
+
+// BuyTickets buys count tickets of the open raffle for the user.
+// Coins are taken with purchase.Debiter, which locks the user before the raffle like purchase.Purchaser does.
+func (b *TicketBuyer) BuyTickets(ctx context.Context, raffleID uuid.UUID, userID uuid.UUID, count int) ([]*Ticket, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", ErrInvalidValue)
+	}
+
+	// The ticket price doesn't change, so it is read before anything is locked.
+	r, err := getRaffle(ctx, b.db, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+	}
+
+	tx, err := b.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	u, err := purchase.NewDebiter(tx).Debit(ctx, userID, r.TicketPrice*count)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+	}
+
+	r, err = getRaffleForUpdate(ctx, tx, raffleID)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+	}
+	if r.Status != StatusOpen || !time.Now().Before(r.DrawsAt) {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", ErrClosed)
+	}
+
+	if r.MaxTicketsPerUser != nil {
+		owned, err := getTicketCount(ctx, tx, raffleID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+		}
+		if owned+count > *r.MaxTicketsPerUser {
+			return nil, fmt.Errorf("raffle.TicketBuyer: %w", ErrTicketLimitReached)
+		}
+	}
+
+	tickets := make([]*Ticket, count)
+	for i := range tickets {
+		t, err := createTicket(ctx, tx, raffleID, userID, r.TicketCount+i, r.TicketPrice)
+		if err != nil {
+			return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+		}
+		t.Username = u.Username
+		tickets[i] = t
+	}
+
+	err = updateRaffleTicketCount(ctx, tx, raffleID, r.TicketCount+count)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("raffle.TicketBuyer: %w", err)
+	}
+
+	return tickets, nil
+}
+
+func getRaffleForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Raffle, error) {
+	query := `
+		SELECT id, created_at, name, item_id, ticket_price, winner_count, max_tickets_per_user, draws_at,
+			   status, seed, seed_hash, ticket_count, drawn_at, created_by
+		FROM raffles
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	r, err := pgx.CollectExactlyOneRow(rows, RowToRaffle)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func getTicketCount(ctx context.Context, db app.PgxExecutor, raffleID uuid.UUID, userID uuid.UUID) (int, error) {
+	query := `
+		SELECT count(*)
+		FROM raffle_tickets
+		WHERE raffle_id = $1 AND user_id = $2
+	`
+	args := []any{raffleID, userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	count, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[int])
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func createTicket(ctx context.Context, db app.PgxExecutor, raffleID uuid.UUID, userID uuid.UUID, number int, amount int) (*Ticket, error) {
+	query := `
+		INSERT INTO raffle_tickets (raffle_id, user_id, number, amount)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, raffle_id, user_id, number, amount, purchase_id
+	`
+	args := []any{raffleID, userID, number, amount}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTicket)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func updateRaffleTicketCount(ctx context.Context, db app.PgxExecutor, id uuid.UUID, ticketCount int) error {
+	query := `
+		UPDATE raffles
+		SET ticket_count = $2
+		WHERE id = $1
+	`
+	args := []any{id, ticketCount}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
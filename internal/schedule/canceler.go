@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Canceler struct {
+	db app.PgxExecutor
+}
+
+func NewCanceler(db app.PgxExecutor) *Canceler {
+	return &Canceler{db: db}
+}
+
+// Cancel cancels the active scheduled transfer of the user.
+// A run that is already in progress is waited for.
+func (c *Canceler) Cancel(ctx context.Context, id uuid.UUID, srcUserID uuid.UUID) (*ScheduledTransfer, error) {
+	tx, err := c.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("schedule.Canceler: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	st, err := getScheduledTransferForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("schedule.Canceler: %w", err)
+	}
+	if st.SrcUserID != srcUserID {
+		return nil, fmt.Errorf("schedule.Canceler: %w", ErrNotOwner)
+	}
+	if st.Status != StatusActive {
+		return nil, fmt.Errorf("schedule.Canceler: %w", ErrNotActive)
+	}
+
+	dstUsername := st.DstUsername
+	st, err = updateScheduledTransferStatus(ctx, tx, id, StatusCancelled, nil, st.LastError)
+	if err != nil {
+		return nil, fmt.Errorf("schedule.Canceler: %w", err)
+	}
+	st.DstUsername = dstUsername
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("schedule.Canceler: %w", err)
+	}
+
+	return st, nil
+}
+
+func getScheduledTransferForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*ScheduledTransfer, error) {
+	query := `
+		SELECT st.id, st.created_at, st.src_user_id, st.dst_user_id, st.amount, st.cron,
+			   st.status, st.next_run_at, st.last_run_at, st.last_error,
+			   dst_u.username as dst_username
+		FROM scheduled_transfers st
+		JOIN users dst_u ON st.dst_user_id = dst_u.id
+		WHERE st.id = $1
+		FOR UPDATE OF st
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	st, err := pgx.CollectExactlyOneRow(rows, RowToScheduledTransferWithUsernames)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return st, nil
+}
@@ -0,0 +1,105 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreateScheduledTransferParams struct {
+	SrcUserID   uuid.UUID
+	DstUsername string
+	Amount      int
+
+	// RunAt is when a one-shot transfer is made.
+	// For a recurring transfer, it is optional and delays the first run.
+	RunAt *time.Time
+
+	// Cron is the recurrence, nil for a one-shot transfer.
+	Cron *Cron
+}
+
+func (c *Creator) CreateScheduledTransfer(ctx context.Context, params *CreatorCreateScheduledTransferParams) (*ScheduledTransfer, error) {
+	dstUser, err := user.NewGetter(c.db).GetActiveUserByUsername(ctx, params.DstUsername)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, fmt.Errorf("schedule.Creator: %w", ErrDstUserNotFound)
+		}
+		if errors.Is(err, user.ErrDeactivated) {
+			return nil, fmt.Errorf("schedule.Creator: %w", ErrDstUserDeactivated)
+		}
+		return nil, fmt.Errorf("schedule.Creator: %w", err)
+	}
+
+	if dstUser.ID == params.SrcUserID {
+		return nil, fmt.Errorf("schedule.Creator: %w", ErrSrcUserAndDstUserEqual)
+	}
+
+	var nextRunAt time.Time
+	var cron *string
+	if params.Cron != nil {
+		start := time.Now()
+		if params.RunAt != nil && params.RunAt.After(start) {
+			start = params.RunAt.Add(-time.Nanosecond)
+		}
+		nextRunAt = params.Cron.Next(start)
+		if nextRunAt.IsZero() {
+			return nil, fmt.Errorf("schedule.Creator: %w", ErrNeverRuns)
+		}
+		s := params.Cron.String()
+		cron = &s
+	} else {
+		if params.RunAt == nil {
+			return nil, fmt.Errorf("schedule.Creator: %w", ErrNeverRuns)
+		}
+		nextRunAt = *params.RunAt
+	}
+
+	st, err := createScheduledTransfer(ctx, c.db, params.SrcUserID, dstUser.ID, params.Amount, cron, nextRunAt)
+	if err != nil {
+		return nil, fmt.Errorf("schedule.Creator: %w", err)
+	}
+	st.DstUsername = dstUser.Username
+
+	return st, nil
+}
+
+func createScheduledTransfer(
+	ctx context.Context,
+	db app.PgxExecutor,
+	srcUserID uuid.UUID,
+	dstUserID uuid.UUID,
+	amount int,
+	cron *string,
+	nextRunAt time.Time,
+) (*ScheduledTransfer, error) {
+	query := `
+		INSERT INTO scheduled_transfers (src_user_id, dst_user_id, amount, cron, next_run_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, src_user_id, dst_user_id, amount, cron, status, next_run_at, last_run_at, last_error
+	`
+	args := []any{srcUserID, dstUserID, amount, cron, nextRunAt}
+
+	rows, _ := db.Query(ctx, query, args...)
+	st, err := pgx.CollectExactlyOneRow(rows, RowToScheduledTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
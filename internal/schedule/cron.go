@@ -0,0 +1,173 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCron = errors.New("invalid cron expression")
+
+// Cron is a parsed cron expression with the standard five fields:
+// minute, hour, day of month, month and day of week.
+// Fields support "*", numbers, ranges "a-b", lists "a,b" and steps "*/n" or "a-b/n".
+// Day of week is 0-6 starting on Sunday, 7 is also Sunday.
+// Descriptors "@hourly", "@daily", "@weekly", "@monthly" and "@yearly" are supported too.
+//
+// As in the classic cron, if both day of month and day of week are restricted,
+// a day matches if either of them matches.
+type Cron struct {
+	minutes  uint64
+	hours    uint64
+	doms     uint64
+	months   uint64
+	dows     uint64
+	domStar  bool
+	dowStar  bool
+	original string
+}
+
+var cronDescriptors = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+func ParseCron(expr string) (*Cron, error) {
+	original := expr
+	if descriptorExpr, ok := cronDescriptors[strings.TrimSpace(expr)]; ok {
+		expr = descriptorExpr
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: got %d fields, want 5", ErrInvalidCron, len(fields))
+	}
+
+	c := &Cron{original: original}
+	var err error
+	if c.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("%w: minute: %w", ErrInvalidCron, err)
+	}
+	if c.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("%w: hour: %w", ErrInvalidCron, err)
+	}
+	if c.doms, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("%w: day of month: %w", ErrInvalidCron, err)
+	}
+	if c.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("%w: month: %w", ErrInvalidCron, err)
+	}
+	if c.dows, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("%w: day of week: %w", ErrInvalidCron, err)
+	}
+	if c.dows&(1<<7) != 0 {
+		c.dows |= 1 << 0
+	}
+	c.domStar = strings.HasPrefix(fields[2], "*")
+	c.dowStar = strings.HasPrefix(fields[4], "*")
+
+	return c, nil
+}
+
+func (c *Cron) String() string {
+	return c.original
+}
+
+// maxCronSearch bounds the search of Next, so impossible dates like "0 0 30 2 *" terminate.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+// Next returns the earliest time after t that matches the expression, in UTC.
+// It returns the zero time if there is no such time within five years.
+func (c *Cron) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		if c.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if c.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+		if c.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (c *Cron) matchesDay(t time.Time) bool {
+	domMatches := c.doms&(1<<uint(t.Day())) != 0
+	dowMatches := c.dows&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatches && dowMatches
+	}
+	return domMatches || dowMatches
+}
+
+// parseCronField returns a bitset where bit i is set if value i matches the field.
+func parseCronField(field string, minValue, maxValue int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangePart = part[:i]
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+		}
+
+		var lo, hi int
+		switch {
+		case rangePart == "*":
+			lo, hi = minValue, maxValue
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			lo, err = strconv.Atoi(bounds[0])
+			if err != nil {
+				return 0, fmt.Errorf("invalid range in %q", part)
+			}
+			hi, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				return 0, fmt.Errorf("invalid range in %q", part)
+			}
+		default:
+			var err error
+			lo, err = strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			hi = lo
+			if step != 1 {
+				hi = maxValue
+			}
+		}
+
+		if lo < minValue || hi > maxValue || lo > hi {
+			return 0, fmt.Errorf("%q out of range %d-%d", part, minValue, maxValue)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
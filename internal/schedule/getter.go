@@ -0,0 +1,49 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetScheduledTransfersBySrcUserID returns the user's scheduled transfers, active ones first.
+func (g *Getter) GetScheduledTransfersBySrcUserID(ctx context.Context, srcUserID uuid.UUID) ([]*ScheduledTransfer, error) {
+	sts, err := getScheduledTransfersBySrcUserID(ctx, g.db, srcUserID)
+	if err != nil {
+		return nil, fmt.Errorf("schedule.Getter: %w", err)
+	}
+	return sts, nil
+}
+
+func getScheduledTransfersBySrcUserID(ctx context.Context, db app.PgxExecutor, srcUserID uuid.UUID) ([]*ScheduledTransfer, error) {
+	query := `
+		SELECT st.id, st.created_at, st.src_user_id, st.dst_user_id, st.amount, st.cron,
+			   st.status, st.next_run_at, st.last_run_at, st.last_error,
+			   dst_u.username as dst_username
+		FROM scheduled_transfers st
+		JOIN users dst_u ON st.dst_user_id = dst_u.id
+		WHERE st.src_user_id = $1
+		ORDER BY st.status = 'active' DESC, st.next_run_at, st.created_at DESC, st.id
+	`
+	args := []any{srcUserID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	sts, err := pgx.CollectRows(rows, RowToScheduledTransferWithUsernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return sts, nil
+}
@@ -0,0 +1,213 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+// Runner makes scheduled transfers that are due.
+type Runner struct {
+	db app.PgxExecutor
+}
+
+func NewRunner(db app.PgxExecutor) *Runner {
+	return &Runner{db: db}
+}
+
+// RunDue makes all due scheduled transfers and returns the number of runs.
+// Runs that fail because of the src user, e.g. not enough coin, are recorded and counted too.
+func (r *Runner) RunDue(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		ran, err := r.runOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("schedule.Runner: %w", err)
+		}
+		if !ran {
+			return count, nil
+		}
+		count++
+	}
+}
+
+// runOne runs a single due scheduled transfer in its own transaction.
+// The transfer is made in a nested transaction, so its failure is recorded
+// while the scheduled transfer is still locked.
+func (r *Runner) runOne(ctx context.Context) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	st, err := getDueScheduledTransferForUpdate(ctx, tx)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var transferID *uuid.UUID
+	lastError := ""
+	t, err := transfer.NewTransferer(tx).TransferByUsername(ctx, st.DstUsername, st.SrcUserID, st.Amount)
+	if err != nil {
+		if !isRunError(err) {
+			return false, err
+		}
+		lastError = runErrorMessage(err)
+	} else {
+		transferID = &t.ID
+	}
+
+	err = createRun(ctx, tx, st.ID, transferID, lastError)
+	if err != nil {
+		return false, err
+	}
+
+	status := StatusActive
+	var nextRunAt *time.Time
+	if st.Cron != nil {
+		var cron *Cron
+		cron, err = ParseCron(*st.Cron)
+		if err != nil {
+			return false, err
+		}
+		next := cron.Next(time.Now())
+		if next.IsZero() {
+			status = StatusCompleted
+		} else {
+			nextRunAt = &next
+		}
+	} else {
+		status = StatusCompleted
+		if lastError != "" {
+			status = StatusFailed
+		}
+	}
+
+	_, err = updateScheduledTransferStatus(ctx, tx, st.ID, status, nextRunAt, lastError)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// isRunError reports whether err is caused by the state of the users rather than by the system,
+// so the run should be recorded as failed instead of being retried.
+func isRunError(err error) bool {
+	return errors.Is(err, coin.ErrNotEnough) ||
+		errors.Is(err, transfer.ErrLimitExceeded) ||
+		errors.Is(err, transfer.ErrDstUserNotFound) ||
+		errors.Is(err, transfer.ErrDstUserDeactivated) ||
+		errors.Is(err, transfer.ErrSrcUserAndDstUserEqual)
+}
+
+func runErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, coin.ErrNotEnough):
+		return "not enough coin"
+	case errors.Is(err, transfer.ErrLimitExceeded):
+		return "transfer limit exceeded"
+	case errors.Is(err, transfer.ErrDstUserNotFound):
+		return "toUser doesn't exist"
+	case errors.Is(err, transfer.ErrDstUserDeactivated):
+		return "toUser is deactivated"
+	default:
+		return "transfer failed"
+	}
+}
+
+func getDueScheduledTransferForUpdate(ctx context.Context, db app.PgxExecutor) (*ScheduledTransfer, error) {
+	query := `
+		SELECT st.id, st.created_at, st.src_user_id, st.dst_user_id, st.amount, st.cron,
+			   st.status, st.next_run_at, st.last_run_at, st.last_error,
+			   dst_u.username as dst_username
+		FROM scheduled_transfers st
+		JOIN users dst_u ON st.dst_user_id = dst_u.id
+		WHERE st.status = 'active' AND st.next_run_at <= now()
+		ORDER BY st.next_run_at
+		LIMIT 1
+		FOR UPDATE OF st SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	st, err := pgx.CollectExactlyOneRow(rows, RowToScheduledTransferWithUsernames)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return st, nil
+}
+
+func createRun(ctx context.Context, db app.PgxExecutor, scheduledTransferID uuid.UUID, transferID *uuid.UUID, runError string) error {
+	query := `
+		INSERT INTO scheduled_transfer_runs (scheduled_transfer_id, transfer_id, error)
+		VALUES ($1, $2, $3)
+	`
+	args := []any{scheduledTransferID, transferID, runError}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateScheduledTransferStatus sets the status after a run or a cancellation.
+// nextRunAt is nil unless the scheduled transfer stays active.
+func updateScheduledTransferStatus(
+	ctx context.Context,
+	db app.PgxExecutor,
+	id uuid.UUID,
+	status Status,
+	nextRunAt *time.Time,
+	lastError string,
+) (*ScheduledTransfer, error) {
+	query := `
+		UPDATE scheduled_transfers
+		SET status = $2,
+			next_run_at = $3,
+			last_run_at = CASE WHEN $2 = 'cancelled' THEN last_run_at ELSE now() END,
+			last_error = $4
+		WHERE id = $1
+		RETURNING id, created_at, src_user_id, dst_user_id, amount, cron, status, next_run_at, last_run_at, last_error
+	`
+	args := []any{id, string(status), nextRunAt, lastError}
+
+	rows, _ := db.Query(ctx, query, args...)
+	st, err := pgx.CollectExactlyOneRow(rows, RowToScheduledTransfer)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return st, nil
+}
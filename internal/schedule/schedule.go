@@ -0,0 +1,104 @@
+package schedule
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrNotExist               = errors.New("does not exist")
+	ErrNotActive              = errors.New("not active")
+	ErrNotOwner               = errors.New("not the owner")
+	ErrNeverRuns              = errors.New("never runs")
+	ErrDstUserNotFound        = errors.New("dst user not found")
+	ErrDstUserDeactivated     = errors.New("dst user is deactivated")
+	ErrSrcUserAndDstUserEqual = errors.New("src user and dst user are equal")
+)
+
+type Status string
+
+const (
+	StatusActive    Status = "active"
+	StatusCompleted Status = "completed" // one-shot transfer was made or recurrence has no more runs
+	StatusFailed    Status = "failed"    // one-shot transfer couldn't be made
+	StatusCancelled Status = "cancelled"
+)
+
+// ScheduledTransfer is a transfer that is made later, once or on a recurring schedule.
+// A failed run of a recurring transfer doesn't stop it, the error is recorded and the next run is scheduled.
+type ScheduledTransfer struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	SrcUserID uuid.UUID
+	DstUserID uuid.UUID
+	Amount    int
+	Cron      *string // nil for one-shot transfers
+	Status    Status
+	NextRunAt *time.Time
+	LastRunAt *time.Time
+	LastError string
+
+	DstUsername string
+}
+
+type Row struct {
+	ID        uuid.UUID  `db:"id"`
+	CreatedAt time.Time  `db:"created_at"`
+	SrcUserID uuid.UUID  `db:"src_user_id"`
+	DstUserID uuid.UUID  `db:"dst_user_id"`
+	Amount    int        `db:"amount"`
+	Cron      *string    `db:"cron"`
+	Status    string     `db:"status"`
+	NextRunAt *time.Time `db:"next_run_at"`
+	LastRunAt *time.Time `db:"last_run_at"`
+	LastError string     `db:"last_error"`
+}
+
+func RowToScheduledTransfer(collectable pgx.CollectableRow) (*ScheduledTransfer, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduledTransfer{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		SrcUserID: collected.SrcUserID,
+		DstUserID: collected.DstUserID,
+		Amount:    collected.Amount,
+		Cron:      collected.Cron,
+		Status:    Status(collected.Status),
+		NextRunAt: collected.NextRunAt,
+		LastRunAt: collected.LastRunAt,
+		LastError: collected.LastError,
+	}, nil
+}
+
+type RowWithUsernames struct {
+	Row
+	DstUsername string `db:"dst_username"`
+}
+
+func RowToScheduledTransferWithUsernames(collectable pgx.CollectableRow) (*ScheduledTransfer, error) {
+	collected, err := pgx.RowToStructByName[RowWithUsernames](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduledTransfer{
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		SrcUserID:   collected.SrcUserID,
+		DstUserID:   collected.DstUserID,
+		Amount:      collected.Amount,
+		Cron:        collected.Cron,
+		Status:      Status(collected.Status),
+		NextRunAt:   collected.NextRunAt,
+		LastRunAt:   collected.LastRunAt,
+		LastError:   collected.LastError,
+		DstUsername: collected.DstUsername,
+	}, nil
+}
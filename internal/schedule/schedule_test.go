@@ -0,0 +1,225 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestCron(t *testing.T) {
+	t.Run("rejects invalid expressions", func(t *testing.T) {
+		invalidExprs := []string{
+			"",
+			"* * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"*/0 * * * *",
+			"5-1 * * * *",
+			"@sometimes",
+		}
+		for _, expr := range invalidExprs {
+			_, err := ParseCron(expr)
+			if got, want := err, ErrInvalidCron; !errors.Is(got, want) {
+				t.Errorf("got %v error for %q, want %v", got, expr, want)
+			}
+		}
+	})
+
+	t.Run("finds next time", func(t *testing.T) {
+		from := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC) // Wednesday
+		tests := []struct {
+			expr string
+			want time.Time
+		}{
+			{"* * * * *", time.Date(2025, time.January, 15, 10, 31, 0, 0, time.UTC)},
+			{"0 9 * * *", time.Date(2025, time.January, 16, 9, 0, 0, 0, time.UTC)},
+			{"*/15 * * * *", time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC)},
+			{"0 9 * * 1", time.Date(2025, time.January, 20, 9, 0, 0, 0, time.UTC)},
+			{"0 9 1 * *", time.Date(2025, time.February, 1, 9, 0, 0, 0, time.UTC)},
+			{"0 9 1 * 5", time.Date(2025, time.January, 17, 9, 0, 0, 0, time.UTC)}, // day of month or day of week
+			{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+			{"@monthly", time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)},
+			{"@weekly", time.Date(2025, time.January, 19, 0, 0, 0, 0, time.UTC)},
+		}
+		for _, tt := range tests {
+			c, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("got %v error for %q", err, tt.expr)
+			}
+			if got, want := c.Next(from), tt.want; !got.Equal(want) {
+				t.Errorf("got %v next time for %q, want %v", got, tt.expr, want)
+			}
+		}
+	})
+
+	t.Run("finds no next time for impossible dates", func(t *testing.T) {
+		c, err := ParseCron("0 0 30 2 *")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got := c.Next(time.Now()); !got.IsZero() {
+			t.Errorf("got %v next time, want zero", got)
+		}
+	})
+}
+
+func TestScheduledTransfer(t *testing.T) {
+	t.Run("runs due one-shot transfers", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			sc  = NewCreator(db)
+			sg  = NewGetter(db)
+			sr  = NewRunner(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		_ = usertest.CreateUser(t, ctx, db, "bob")
+
+		initialAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		past := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Hour)
+		_, err = sc.CreateScheduledTransfer(ctx, &CreatorCreateScheduledTransferParams{
+			SrcUserID:   alice.ID,
+			DstUsername: "bob",
+			Amount:      10,
+			RunAt:       &past,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = sc.CreateScheduledTransfer(ctx, &CreatorCreateScheduledTransferParams{
+			SrcUserID:   alice.ID,
+			DstUsername: "bob",
+			Amount:      20,
+			RunAt:       &future,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		count, err := sr.RunDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d runs, want %d", got, want)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := aliceBalance, initialAliceBalance-10; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+
+		sts, err := sg.GetScheduledTransfersBySrcUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		gotStatuses := make(map[int]Status)
+		for _, st := range sts {
+			gotStatuses[st.Amount] = st.Status
+		}
+		if got, want := gotStatuses[10], StatusCompleted; got != want {
+			t.Errorf("got %s run scheduled transfer status, want %s", got, want)
+		}
+		if got, want := gotStatuses[20], StatusActive; got != want {
+			t.Errorf("got %s future scheduled transfer status, want %s", got, want)
+		}
+	})
+
+	t.Run("records failed runs", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			sc  = NewCreator(db)
+			sg  = NewGetter(db)
+			sr  = NewRunner(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		_ = usertest.CreateUser(t, ctx, db, "bob")
+
+		past := time.Now().Add(-time.Minute)
+		_, err := sc.CreateScheduledTransfer(ctx, &CreatorCreateScheduledTransferParams{
+			SrcUserID:   alice.ID,
+			DstUsername: "bob",
+			Amount:      1_000_000,
+			RunAt:       &past,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = sr.RunDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		sts, err := sg.GetScheduledTransfersBySrcUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(sts), 1; got != want {
+			t.Fatalf("got %d scheduled transfers, want %d", got, want)
+		}
+		if got, want := sts[0].Status, StatusFailed; got != want {
+			t.Errorf("got %s status, want %s", got, want)
+		}
+		if got, want := sts[0].LastError, "not enough coin"; got != want {
+			t.Errorf("got %q last error, want %q", got, want)
+		}
+	})
+
+	t.Run("cancels scheduled transfers", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			sc  = NewCreator(db)
+			sca = NewCanceler(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+
+		c, err := ParseCron("@daily")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		st, err := sc.CreateScheduledTransfer(ctx, &CreatorCreateScheduledTransferParams{
+			SrcUserID:   alice.ID,
+			DstUsername: "bob",
+			Amount:      10,
+			Cron:        c,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = sca.Cancel(ctx, st.ID, bob.ID)
+		if got, want := err, ErrNotOwner; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		st, err = sca.Cancel(ctx, st.ID, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := st.Status, StatusCancelled; got != want {
+			t.Errorf("got %s status, want %s", got, want)
+		}
+		_, err = sca.Cancel(ctx, st.ID, alice.ID)
+		if got, want := err, ErrNotActive; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+}
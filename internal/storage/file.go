@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+var _ Storage = (*FileStorage)(nil)
+
+// FileStorage stores objects as files in a local directory.
+type FileStorage struct {
+	dir string
+}
+
+func NewFileStorage(dir string) *FileStorage {
+	return &FileStorage{dir: dir}
+}
+
+// Put writes the object to a temporary file first and renames it,
+// so readers never see a partially written object.
+func (s *FileStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	name, err := s.name(key)
+	if err != nil {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(name), 0o750)
+	if err != nil {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+	err = f.Close()
+	if err != nil {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	err = os.Rename(f.Name(), name)
+	if err != nil {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileStorage) Open(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	name, err := s.name(key)
+	if err != nil {
+		return nil, fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	f, err := os.Open(name) // #nosec G304 -- name is checked to be inside dir
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("storage.FileStorage: %w", ErrNotExist)
+		}
+		return nil, fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	return f, nil
+}
+
+func (s *FileStorage) Delete(ctx context.Context, key string) error {
+	name, err := s.name(key)
+	if err != nil {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	err = os.Remove(name)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("storage.FileStorage: %w", err)
+	}
+
+	return nil
+}
+
+// name returns the file name of the key.
+// Keys that would point outside of the directory are rejected.
+func (s *FileStorage) name(key string) (string, error) {
+	localKey := filepath.FromSlash(key)
+	if key == "" || !filepath.IsLocal(localKey) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.dir, localKey), nil
+}
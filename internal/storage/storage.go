@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	ErrNotExist   = errors.New("does not exist")
+	ErrInvalidKey = errors.New("invalid key")
+)
+
+// Storage stores objects by key.
+// Keys are slash-separated relative paths, e.g. "images/cup.png".
+// Implementations other than [FileStorage], e.g. object storage, can be added later.
+type Storage interface {
+	// Put stores the object read from r, replacing any object with the same key.
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Open returns the object for reading. It must be closed by the caller.
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, error)
+
+	// Delete deletes the object. It is not an error if the object doesn't exist.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFileStorage(t *testing.T) {
+	t.Run("puts, opens and deletes objects", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			s   = NewFileStorage(t.TempDir())
+		)
+
+		err := s.Put(ctx, "images/cup.png", strings.NewReader("cup"))
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		r, err := s.Open(ctx, "images/cup.png")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		b, err := io.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := string(b), "cup"; got != want {
+			t.Errorf("got %q object, want %q", got, want)
+		}
+
+		err = s.Delete(ctx, "images/cup.png")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = s.Open(ctx, "images/cup.png")
+		if got, want := err, ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+
+	t.Run("rejects keys outside of the directory", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			s   = NewFileStorage(t.TempDir())
+		)
+
+		for _, key := range []string{"", "../cup.png", "/cup.png", "images/../../cup.png"} {
+			err := s.Put(ctx, key, strings.NewReader("cup"))
+			if got, want := err, ErrInvalidKey; !errors.Is(got, want) {
+				t.Errorf("got %v error for %q, want %v", got, key, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package stream
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+const (
+	subscriptionBufferLen = 16
+	relistenDelay         = 5 * time.Second
+)
+
+// Listener listens to the Postgres notification channel
+// and passes the events to the subscriptions of their users.
+type Listener struct {
+	pool *pgxpool.Pool
+
+	mu            sync.Mutex
+	subscriptions map[uuid.UUID]map[chan *app.Event]struct{}
+}
+
+func NewListener(pool *pgxpool.Pool) *Listener {
+	return &Listener{pool: pool, subscriptions: make(map[uuid.UUID]map[chan *app.Event]struct{})}
+}
+
+// Subscribe returns a channel of the user's events and a function that ends the subscription.
+// Events are dropped for subscribers that don't keep up.
+func (l *Listener) Subscribe(userID uuid.UUID) (events <-chan *app.Event, unsubscribe func()) {
+	ch := make(chan *app.Event, subscriptionBufferLen)
+
+	l.mu.Lock()
+	if l.subscriptions[userID] == nil {
+		l.subscriptions[userID] = make(map[chan *app.Event]struct{})
+	}
+	l.subscriptions[userID][ch] = struct{}{}
+	l.mu.Unlock()
+
+	unsubscribe = func() {
+		l.mu.Lock()
+		delete(l.subscriptions[userID], ch)
+		if len(l.subscriptions[userID]) == 0 {
+			delete(l.subscriptions, userID)
+		}
+		l.mu.Unlock()
+	}
+	return ch, unsubscribe
+}
+
+// Listen listens to the channel until ctx is done.
+// It listens again after a delay when the connection fails.
+func (l *Listener) Listen(ctx context.Context) {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Error("didn't listen", "channel", channel, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(relistenDelay):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	poolConn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection is taken from the pool, so it isn't reused by queries while it listens.
+	conn := poolConn.Hijack()
+	defer func() {
+		closeErr := conn.Close(context.Background())
+		if closeErr != nil {
+			slog.Error("didn't close listener connection", "err", closeErr)
+		}
+	}()
+
+	err = listenChannel(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		e, err := decodeEvent(n.Payload)
+		if err != nil {
+			slog.Error("didn't decode event", "payload", n.Payload, "err", err)
+			continue
+		}
+		l.publish(e)
+	}
+}
+
+// listenChannel subscribes the connection to the notification channel.
+// LISTEN doesn't take parameters, so the channel is written in the query.
+func listenChannel(ctx context.Context, conn *pgx.Conn) error {
+	query := `
+		LISTEN merch_events
+	`
+
+	_, err := conn.Exec(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (l *Listener) publish(e *app.Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for ch := range l.subscriptions[e.UserID] {
+		select {
+		case ch <- e:
+		default:
+			slog.Warn("dropped event for slow subscriber", "kind", e.Kind, "user_id", e.UserID)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+var _ app.EventHandler = (*Notifier)(nil)
+
+// Notifier sends events to the Postgres notification channel
+// that a [Listener] of every server replica listens to.
+type Notifier struct{}
+
+func NewNotifier() *Notifier {
+	return &Notifier{}
+}
+
+// HandleEvent implements app.EventHandler.
+// Events of kinds that aren't pushed to users are skipped.
+// Postgres delivers the notification when tx commits, so the event is pushed once it is marked handled
+// and isn't pushed again if it is handled again after another handler fails.
+func (n *Notifier) HandleEvent(ctx context.Context, tx app.PgxExecutor, e *app.Event) error {
+	if !slices.Contains(Kinds, e.Kind) {
+		return nil
+	}
+
+	payload, err := encodeEvent(e)
+	if err != nil {
+		return fmt.Errorf("stream.Notifier: %w", err)
+	}
+
+	err = notify(ctx, tx, payload)
+	if err != nil {
+		return fmt.Errorf("stream.Notifier: %w", err)
+	}
+	return nil
+}
+
+func notify(ctx context.Context, db app.PgxExecutor, payload string) error {
+	query := `
+		SELECT pg_notify($1, $2)
+	`
+	args := []any{channel, payload}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
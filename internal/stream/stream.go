@@ -0,0 +1,49 @@
+package stream
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/achievement"
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/purchase"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+// channel is the Postgres notification channel events are sent over,
+// so every server replica can push them to its own subscribers.
+// It is also written in the query of listenChannel.
+const channel = "merch_events"
+
+// Kinds are the event kinds pushed to users.
+var Kinds = []app.EventKind{
+	transfer.EventReceived,
+	purchase.EventBought,
+	purchase.EventGiftReceived,
+	achievement.EventBadgeAwarded,
+}
+
+// Message is an event as it is sent over the channel and pushed to users.
+type Message struct {
+	Kind   app.EventKind `json:"kind"`
+	UserID uuid.UUID     `json:"userId"`
+	ID     uuid.UUID     `json:"id"`
+}
+
+func encodeEvent(e *app.Event) (string, error) {
+	b, err := json.Marshal(Message{Kind: e.Kind, UserID: e.UserID, ID: e.ID})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func decodeEvent(payload string) (*app.Event, error) {
+	var m Message
+	err := json.Unmarshal([]byte(payload), &m)
+	if err != nil {
+		return nil, err
+	}
+	return &app.Event{Kind: m.Kind, UserID: m.UserID, ID: m.ID}, nil
+}
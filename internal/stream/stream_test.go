@@ -0,0 +1,106 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+func TestStream(t *testing.T) {
+	t.Run("encodes and decodes events", func(t *testing.T) {
+		want := &app.Event{Kind: transfer.EventReceived, UserID: uuid.New(), ID: uuid.New()}
+
+		payload, err := encodeEvent(want)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		got, err := decodeEvent(payload)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if *got != *want {
+			t.Errorf("got %+v event, want %+v", got, want)
+		}
+	})
+	t.Run("passes notified events to subscribers of their users", func(t *testing.T) {
+		var (
+			ctx     = context.Background()
+			db      = apptest.NewPostgresPool(t, ctx)
+			l       = NewListener(db)
+			n       = NewNotifier()
+			aliceID = uuid.New()
+			bobID   = uuid.New()
+		)
+		listenCtx, cancelListen := context.WithCancel(ctx)
+		defer cancelListen()
+		go l.Listen(listenCtx)
+
+		aliceEvents, unsubscribeAlice := l.Subscribe(aliceID)
+		defer unsubscribeAlice()
+		bobEvents, unsubscribeBob := l.Subscribe(bobID)
+		defer unsubscribeBob()
+
+		// The listener may not be listening yet, so the event is notified until it is received.
+		want := &app.Event{Kind: transfer.EventReceived, UserID: aliceID, ID: uuid.New()}
+		timeout := time.After(10 * time.Second)
+		var got *app.Event
+		for got == nil {
+			err := n.HandleEvent(ctx, db, want)
+			if err != nil {
+				t.Fatalf("got %v error", err)
+			}
+			select {
+			case got = <-aliceEvents:
+			case <-time.After(100 * time.Millisecond):
+			case <-timeout:
+				t.Fatal("got no event")
+			}
+		}
+		if *got != *want {
+			t.Errorf("got %+v event, want %+v", got, want)
+		}
+
+		select {
+		case e := <-bobEvents:
+			t.Errorf("got %+v event for another user", e)
+		default:
+		}
+
+		err := n.HandleEvent(ctx, db, &app.Event{Kind: transfer.EventSent, UserID: aliceID, ID: uuid.New()})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		select {
+		case e := <-aliceEvents:
+			if e.Kind == transfer.EventSent {
+				t.Errorf("got %s event, want it skipped", e.Kind)
+			}
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = n.HandleEvent(ctx, tx, &app.Event{Kind: transfer.EventReceived, UserID: aliceID, ID: uuid.New()})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = tx.Rollback(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		select {
+		case e := <-aliceEvents:
+			t.Errorf("got %+v event notified in a rolled back transaction", e)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+func (c *Creator) CreateTeam(ctx context.Context, name string) (*Team, error) {
+	t, err := createTeam(ctx, c.db, name)
+	if err != nil {
+		return nil, fmt.Errorf("team.Creator: %w", err)
+	}
+	return t, nil
+}
+
+func createTeam(ctx context.Context, db app.PgxExecutor, name string) (*Team, error) {
+	query := `
+		INSERT INTO teams (name)
+		VALUES ($1)
+		RETURNING id, created_at, name, balance
+	`
+	args := []any{name}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTeam)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "teams_name_idx") {
+			return nil, ErrExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func isConstraintPgError(e *pgconn.PgError, constraint string) bool {
+	return pgerrcode.IsIntegrityConstraintViolation(e.Code) && e.ConstraintName == constraint
+}
@@ -0,0 +1,125 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Funder adds new coins to team coin pools.
+// It should only be used on behalf of admins.
+type Funder struct {
+	db app.PgxExecutor
+}
+
+func NewFunder(db app.PgxExecutor) *Funder {
+	return &Funder{db: db}
+}
+
+func (f *Funder) Fund(ctx context.Context, teamID uuid.UUID, userID uuid.UUID, amount int) (*PoolTransfer, error) {
+	tx, err := f.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("team.Funder: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	t, err := getTeamForUpdate(ctx, tx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("team.Funder: %w", err)
+	}
+
+	pt, err := createPoolTransfer(ctx, tx, teamID, PoolTransferKindFund, userID, nil, amount)
+	if err != nil {
+		return nil, fmt.Errorf("team.Funder: %w", err)
+	}
+
+	_, err = updateTeamBalance(ctx, tx, teamID, t.Balance+amount)
+	if err != nil {
+		return nil, fmt.Errorf("team.Funder: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("team.Funder: %w", err)
+	}
+
+	return pt, nil
+}
+
+func getTeamForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Team, error) {
+	query := `
+		SELECT id, created_at, name, balance
+		FROM teams
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTeam)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func createPoolTransfer(
+	ctx context.Context,
+	db app.PgxExecutor,
+	teamID uuid.UUID,
+	kind PoolTransferKind,
+	userID uuid.UUID,
+	dstUserID *uuid.UUID,
+	amount int,
+) (*PoolTransfer, error) {
+	query := `
+		INSERT INTO team_transfers (team_id, kind, user_id, dst_user_id, amount)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, team_id, kind, user_id, dst_user_id, amount
+	`
+	args := []any{teamID, string(kind), userID, dstUserID, amount}
+
+	rows, _ := db.Query(ctx, query, args...)
+	pt, err := pgx.CollectExactlyOneRow(rows, RowToPoolTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return pt, nil
+}
+
+func updateTeamBalance(ctx context.Context, db app.PgxExecutor, id uuid.UUID, balance int) (*Team, error) {
+	query := `
+		UPDATE teams
+		SET balance = $2
+		WHERE id = $1
+		RETURNING id, created_at, name, balance
+	`
+	args := []any{id, balance}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTeam)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
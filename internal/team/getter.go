@@ -0,0 +1,170 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+func (g *Getter) GetTeamByName(ctx context.Context, name string) (*Team, error) {
+	t, err := getTeamByName(ctx, g.db, name)
+	if err != nil {
+		return nil, fmt.Errorf("team.Getter: %w", err)
+	}
+	return t, nil
+}
+
+// GetTeamsByUserID returns teams the user is a member of.
+func (g *Getter) GetTeamsByUserID(ctx context.Context, userID uuid.UUID) ([]*Team, error) {
+	teams, err := getTeamsByUserID(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("team.Getter: %w", err)
+	}
+	return teams, nil
+}
+
+func (g *Getter) GetMembers(ctx context.Context, teamID uuid.UUID) ([]*Member, error) {
+	members, err := getMembers(ctx, g.db, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("team.Getter: %w", err)
+	}
+	return members, nil
+}
+
+// GetMember returns [ErrNotMember] if the user is not a member of the team.
+func (g *Getter) GetMember(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (*Member, error) {
+	m, err := getMember(ctx, g.db, teamID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("team.Getter: %w", err)
+	}
+	return m, nil
+}
+
+// GetPoolTransfers returns the history of the team coin pool.
+func (g *Getter) GetPoolTransfers(ctx context.Context, teamID uuid.UUID) ([]*PoolTransfer, error) {
+	poolTransfers, err := getPoolTransfers(ctx, g.db, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("team.Getter: %w", err)
+	}
+	return poolTransfers, nil
+}
+
+func getTeamByName(ctx context.Context, db app.PgxExecutor, name string) (*Team, error) {
+	query := `
+		SELECT id, created_at, name, balance
+		FROM teams
+		WHERE name = $1
+	`
+	args := []any{name}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTeam)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func getTeamsByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Team, error) {
+	query := `
+		SELECT t.id, t.created_at, t.name, t.balance
+		FROM teams t
+		JOIN team_members m ON t.id = m.team_id
+		WHERE m.user_id = $1
+		ORDER BY t.name
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	teams, err := pgx.CollectRows(rows, RowToTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func getMembers(ctx context.Context, db app.PgxExecutor, teamID uuid.UUID) ([]*Member, error) {
+	query := `
+		SELECT m.team_id, m.user_id, m.role,
+			   u.username,
+			   coalesce(p.display_name, '') AS display_name
+		FROM team_members m
+		JOIN users u ON m.user_id = u.id
+		LEFT JOIN profiles p ON m.user_id = p.user_id
+		WHERE m.team_id = $1
+		ORDER BY m.role = 'manager' DESC, u.username
+	`
+	args := []any{teamID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	members, err := pgx.CollectRows(rows, RowToMember)
+	if err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func getMember(ctx context.Context, db app.PgxExecutor, teamID uuid.UUID, userID uuid.UUID) (*Member, error) {
+	query := `
+		SELECT m.team_id, m.user_id, m.role,
+			   u.username,
+			   coalesce(p.display_name, '') AS display_name
+		FROM team_members m
+		JOIN users u ON m.user_id = u.id
+		LEFT JOIN profiles p ON m.user_id = p.user_id
+		WHERE m.team_id = $1 AND m.user_id = $2
+	`
+	args := []any{teamID, userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	m, err := pgx.CollectExactlyOneRow(rows, RowToMember)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotMember
+		}
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func getPoolTransfers(ctx context.Context, db app.PgxExecutor, teamID uuid.UUID) ([]*PoolTransfer, error) {
+	query := `
+		SELECT t.id, t.created_at, t.team_id, t.kind, t.user_id, t.dst_user_id, t.amount,
+			   u.username,
+			   dst_u.username AS dst_username
+		FROM team_transfers t
+		JOIN users u ON t.user_id = u.id
+		LEFT JOIN users dst_u ON t.dst_user_id = dst_u.id
+		WHERE t.team_id = $1
+		ORDER BY t.created_at, t.id
+	`
+	args := []any{teamID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	poolTransfers, err := pgx.CollectRows(rows, RowToPoolTransferWithUsernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return poolTransfers, nil
+}
@@ -0,0 +1,92 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/user"
+)
+
+type MemberUpdater struct {
+	db app.PgxExecutor
+}
+
+func NewMemberUpdater(db app.PgxExecutor) *MemberUpdater {
+	return &MemberUpdater{db: db}
+}
+
+// SetMemberByUsername adds the user to the team with the role
+// or changes the role if the user is already a member.
+func (mu *MemberUpdater) SetMemberByUsername(ctx context.Context, teamID uuid.UUID, username string, role Role) (*Member, error) {
+	u, err := user.NewGetter(mu.db).GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("team.MemberUpdater: %w", err)
+	}
+	err = upsertMember(ctx, mu.db, teamID, u.ID, role)
+	if err != nil {
+		return nil, fmt.Errorf("team.MemberUpdater: %w", err)
+	}
+	m, err := getMember(ctx, mu.db, teamID, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("team.MemberUpdater: %w", err)
+	}
+	return m, nil
+}
+
+// RemoveMemberByUsername removes the user from the team.
+// It returns [ErrNotMember] if the user is not a member.
+func (mu *MemberUpdater) RemoveMemberByUsername(ctx context.Context, teamID uuid.UUID, username string) error {
+	u, err := user.NewGetter(mu.db).GetUserByUsername(ctx, username)
+	if err != nil {
+		return fmt.Errorf("team.MemberUpdater: %w", err)
+	}
+	err = deleteMember(ctx, mu.db, teamID, u.ID)
+	if err != nil {
+		return fmt.Errorf("team.MemberUpdater: %w", err)
+	}
+	return nil
+}
+
+func upsertMember(ctx context.Context, db app.PgxExecutor, teamID uuid.UUID, userID uuid.UUID, role Role) error {
+	query := `
+		INSERT INTO team_members (team_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (team_id, user_id) DO UPDATE
+		SET role = excluded.role
+	`
+	args := []any{teamID, userID, string(role)}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "team_members_team_id_fkey") {
+			return ErrNotExist
+		}
+		return err
+	}
+
+	return nil
+}
+
+func deleteMember(ctx context.Context, db app.PgxExecutor, teamID uuid.UUID, userID uuid.UUID) error {
+	query := `
+		DELETE FROM team_members
+		WHERE team_id = $1 AND user_id = $2
+	`
+	args := []any{teamID, userID}
+
+	commandTag, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if commandTag.RowsAffected() == 0 {
+		return ErrNotMember
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package team
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrExist                  = errors.New("already exists")
+	ErrNotExist               = errors.New("does not exist")
+	ErrNotMember              = errors.New("not a team member")
+	ErrNotManager             = errors.New("not a team manager")
+	ErrDstUserNotFound        = errors.New("dst user not found")
+	ErrDstUserDeactivated     = errors.New("dst user is deactivated")
+	ErrDstUserNotMember       = errors.New("dst user not a team member")
+	ErrSrcUserAndDstUserEqual = errors.New("src user and dst user are equal")
+)
+
+// Team represents a group of users with a shared coin pool.
+// The pool is funded by admins and distributed among members by managers.
+type Team struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	Name      string
+	Balance   int
+}
+
+type Row struct {
+	ID        uuid.UUID `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	Name      string    `db:"name"`
+	Balance   int       `db:"balance"`
+}
+
+func RowToTeam(collectable pgx.CollectableRow) (*Team, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Team{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		Name:      collected.Name,
+		Balance:   collected.Balance,
+	}, nil
+}
+
+type Role string
+
+const (
+	RoleMember  Role = "member"
+	RoleManager Role = "manager"
+)
+
+func (r Role) Valid() bool {
+	return r == RoleMember || r == RoleManager
+}
+
+type Member struct {
+	TeamID uuid.UUID
+	UserID uuid.UUID
+	Role   Role
+
+	Username    string
+	DisplayName string
+}
+
+type MemberRow struct {
+	TeamID uuid.UUID `db:"team_id"`
+	UserID uuid.UUID `db:"user_id"`
+	Role   string    `db:"role"`
+
+	Username    string `db:"username"`
+	DisplayName string `db:"display_name"`
+}
+
+func RowToMember(collectable pgx.CollectableRow) (*Member, error) {
+	collected, err := pgx.RowToStructByName[MemberRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Member{
+		TeamID:      collected.TeamID,
+		UserID:      collected.UserID,
+		Role:        Role(collected.Role),
+		Username:    collected.Username,
+		DisplayName: collected.DisplayName,
+	}, nil
+}
+
+type PoolTransferKind string
+
+const (
+	// PoolTransferKindFund is a transfer of new coins into the pool by an admin.
+	PoolTransferKindFund PoolTransferKind = "fund"
+	// PoolTransferKindSend is a transfer of coins from the pool to a member by a manager.
+	PoolTransferKindSend PoolTransferKind = "send"
+)
+
+// PoolTransfer represents a change of the team coin pool.
+type PoolTransfer struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	TeamID    uuid.UUID
+	Kind      PoolTransferKind
+	UserID    uuid.UUID
+	DstUserID *uuid.UUID
+	Amount    int
+
+	Username    string
+	DstUsername *string
+}
+
+type PoolTransferRow struct {
+	ID        uuid.UUID  `db:"id"`
+	CreatedAt time.Time  `db:"created_at"`
+	TeamID    uuid.UUID  `db:"team_id"`
+	Kind      string     `db:"kind"`
+	UserID    uuid.UUID  `db:"user_id"`
+	DstUserID *uuid.UUID `db:"dst_user_id"`
+	Amount    int        `db:"amount"`
+}
+
+func RowToPoolTransfer(collectable pgx.CollectableRow) (*PoolTransfer, error) {
+	collected, err := pgx.RowToStructByName[PoolTransferRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PoolTransfer{
+		ID:        collected.ID,
+		CreatedAt: collected.CreatedAt,
+		TeamID:    collected.TeamID,
+		Kind:      PoolTransferKind(collected.Kind),
+		UserID:    collected.UserID,
+		DstUserID: collected.DstUserID,
+		Amount:    collected.Amount,
+	}, nil
+}
+
+type PoolTransferRowWithUsernames struct {
+	PoolTransferRow
+	Username    string  `db:"username"`
+	DstUsername *string `db:"dst_username"`
+}
+
+func RowToPoolTransferWithUsernames(collectable pgx.CollectableRow) (*PoolTransfer, error) {
+	collected, err := pgx.RowToStructByName[PoolTransferRowWithUsernames](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PoolTransfer{
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		TeamID:      collected.TeamID,
+		Kind:        PoolTransferKind(collected.Kind),
+		UserID:      collected.UserID,
+		DstUserID:   collected.DstUserID,
+		Amount:      collected.Amount,
+		Username:    collected.Username,
+		DstUsername: collected.DstUsername,
+	}, nil
+}
@@ -0,0 +1,205 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestTeam(t *testing.T) {
+	var (
+		ctx = context.Background()
+		db  = apptest.NewPostgresPool(t, ctx)
+	)
+
+	t.Run("funds pool and sends coins from it to member", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			admin = usertest.CreateUser(t, ctx, tx, "admin")
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			bob   = usertest.CreateUser(t, ctx, tx, "bob")
+			tc    = NewCreator(tx)
+			tg    = NewGetter(tx)
+			tmu   = NewMemberUpdater(tx)
+			tf    = NewFunder(tx)
+			tt    = NewTransferer(tx)
+			cg    = coin.NewGetter(tx)
+		)
+
+		tm, err := tc.CreateTeam(ctx, "platform")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "alice", RoleManager)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "bob", RoleMember)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tf.Fund(ctx, tm.ID, admin.ID, 100)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tt.TransferByUsername(ctx, tm.ID, "bob", alice.ID, 30)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		tm, err = tg.GetTeamByName(ctx, "platform")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		poolTransfers, err := tg.GetPoolTransfers(ctx, tm.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := tm.Balance, 70; got != want {
+			t.Errorf("got %d team balance, want %d", got, want)
+		}
+		if got, want := bobBalance, bob.Balance+30; got != want {
+			t.Errorf("got %d bob balance, want %d", got, want)
+		}
+		if got, want := len(poolTransfers), 2; got != want {
+			t.Fatalf("got %d pool transfers, want %d", got, want)
+		}
+		if got, want := poolTransfers[1].Kind, PoolTransferKindSend; got != want {
+			t.Errorf("got %s pool transfer kind, want %s", got, want)
+		}
+		if got, want := poolTransfers[1].DstUsername, "bob"; got == nil || *got != want {
+			t.Errorf("got %v pool transfer dst username, want %s", got, want)
+		}
+
+		var (
+			srcUserID uuid.UUID
+			teamID    *uuid.UUID
+		)
+		err = tx.QueryRow(ctx, `SELECT src_user_id, team_id FROM transfers WHERE dst_user_id = $1`, bob.ID).
+			Scan(&srcUserID, &teamID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := srcUserID, alice.ID; got != want {
+			t.Errorf("got %s transfer src user ID, want %s", got, want)
+		}
+		if got, want := teamID, tm.ID; got == nil || *got != want {
+			t.Errorf("got %v transfer team ID, want %s", got, want)
+		}
+	})
+
+	t.Run("doesn't send coins from pool by non-manager", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			admin = usertest.CreateUser(t, ctx, tx, "admin")
+			_     = usertest.CreateUser(t, ctx, tx, "alice")
+			bob   = usertest.CreateUser(t, ctx, tx, "bob")
+			tc    = NewCreator(tx)
+			tmu   = NewMemberUpdater(tx)
+			tf    = NewFunder(tx)
+			tt    = NewTransferer(tx)
+		)
+
+		tm, err := tc.CreateTeam(ctx, "platform")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "alice", RoleMember)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "bob", RoleMember)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tf.Fund(ctx, tm.ID, admin.ID, 100)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tt.TransferByUsername(ctx, tm.ID, "alice", bob.ID, 30)
+		if got, want := err, ErrNotManager; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+
+	t.Run("doesn't send more coins than pool has", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			_     = usertest.CreateUser(t, ctx, tx, "bob")
+			tc    = NewCreator(tx)
+			tmu   = NewMemberUpdater(tx)
+			tt    = NewTransferer(tx)
+		)
+
+		tm, err := tc.CreateTeam(ctx, "platform")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "alice", RoleManager)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "bob", RoleMember)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tt.TransferByUsername(ctx, tm.ID, "bob", alice.ID, 1)
+		if got, want := err, coin.ErrNotEnough; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+	t.Run("doesn't send more coins from pool than transfer limits allow", func(t *testing.T) {
+		var (
+			tx    = apptest.BeginPostgresTx(t, ctx, db)
+			admin = usertest.CreateUser(t, ctx, tx, "admin")
+			alice = usertest.CreateUser(t, ctx, tx, "alice")
+			_     = usertest.CreateUser(t, ctx, tx, "bob")
+			tc    = NewCreator(tx)
+			tmu   = NewMemberUpdater(tx)
+			tf    = NewFunder(tx)
+			tt    = NewTransferer(tx)
+		)
+
+		_, err := transfer.NewLimitsUpdater(tx).UpdateLimits(ctx, &transfer.Limits{MaxPerTransfer: 10})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		tm, err := tc.CreateTeam(ctx, "platform")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "alice", RoleManager)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tmu.SetMemberByUsername(ctx, tm.ID, "bob", RoleMember)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tf.Fund(ctx, tm.ID, admin.ID, 100)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tt.TransferByUsername(ctx, tm.ID, "bob", alice.ID, 30)
+		if got, want := err, transfer.ErrLimitExceeded; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user"
+)
+
+// Transferer sends coins from team coin pools to team members.
+// The coins are taken from the pool and sent with [transfer.Granter],
+// so they are subject to the transfer limits of the sending manager and show in coin history.
+type Transferer struct {
+	db app.PgxExecutor
+}
+
+func NewTransferer(db app.PgxExecutor) *Transferer {
+	return &Transferer{db: db}
+}
+
+// TransferByUsername sends coins from the team pool to the member with dstUsername.
+// The sending user must be a manager of the team.
+func (t *Transferer) TransferByUsername(
+	ctx context.Context,
+	teamID uuid.UUID,
+	dstUsername string,
+	srcUserID uuid.UUID,
+	amount int,
+) (*PoolTransfer, error) {
+	dstUser, err := user.NewGetter(t.db).GetActiveUserByUsername(ctx, dstUsername)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, fmt.Errorf("team.Transferer: %w", ErrDstUserNotFound)
+		}
+		if errors.Is(err, user.ErrDeactivated) {
+			return nil, fmt.Errorf("team.Transferer: %w", ErrDstUserDeactivated)
+		}
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+	dstUserID := dstUser.ID
+
+	if srcUserID == dstUserID {
+		return nil, fmt.Errorf("team.Transferer: %w", ErrSrcUserAndDstUserEqual)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	tm, err := getTeamForUpdate(ctx, tx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+
+	srcMember, err := getMember(ctx, tx, teamID, srcUserID)
+	if err != nil {
+		if errors.Is(err, ErrNotMember) {
+			return nil, fmt.Errorf("team.Transferer: %w", ErrNotManager)
+		}
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+	if srcMember.Role != RoleManager {
+		return nil, fmt.Errorf("team.Transferer: %w", ErrNotManager)
+	}
+
+	_, err = getMember(ctx, tx, teamID, dstUserID)
+	if err != nil {
+		if errors.Is(err, ErrNotMember) {
+			return nil, fmt.Errorf("team.Transferer: %w", ErrDstUserNotMember)
+		}
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+
+	teamBalance := tm.Balance
+	teamBalance -= amount
+	if teamBalance < 0 {
+		return nil, fmt.Errorf("team.Transferer: %w", coin.ErrNotEnough)
+	}
+
+	pt, err := createPoolTransfer(ctx, tx, teamID, PoolTransferKindSend, srcUserID, &dstUserID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+
+	_, err = updateTeamBalance(ctx, tx, teamID, teamBalance)
+	if err != nil {
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+
+	_, err = transfer.NewGranter(tx).Grant(ctx, &transfer.GranterGrantParams{
+		TeamID:    teamID,
+		DstUserID: dstUserID,
+		SrcUserID: srcUserID,
+		Amount:    amount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("team.Transferer: %w", err)
+	}
+
+	return pt, nil
+}
@@ -0,0 +1,168 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Approver approves and rejects pending transfers.
+// Admins can decide on any transfer, managers can decide on transfers from members of their teams.
+// Nobody can decide on a transfer they are a party of.
+type Approver struct {
+	db app.PgxExecutor
+}
+
+func NewApprover(db app.PgxExecutor) *Approver {
+	return &Approver{db: db}
+}
+
+// Approve completes the pending transfer and credits the held amount to the dst user.
+func (a *Approver) Approve(ctx context.Context, id uuid.UUID, approverUserID uuid.UUID) (*Transfer, error) {
+	t, err := a.decide(ctx, id, approverUserID, StatusCompleted)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Approver: %w", err)
+	}
+	return t, nil
+}
+
+// Reject rejects the pending transfer and returns the held amount to the src user.
+func (a *Approver) Reject(ctx context.Context, id uuid.UUID, approverUserID uuid.UUID) (*Transfer, error) {
+	t, err := a.decide(ctx, id, approverUserID, StatusRejected)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Approver: %w", err)
+	}
+	return t, nil
+}
+
+func (a *Approver) decide(ctx context.Context, id uuid.UUID, approverUserID uuid.UUID, status Status) (*Transfer, error) {
+	tx, err := a.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	t, err := getTransferForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != StatusPending || !t.ExpiresAt.After(time.Now()) {
+		return nil, ErrNotPending
+	}
+
+	if approverUserID == t.SrcUserID || approverUserID == t.DstUserID {
+		return nil, ErrNotApprover
+	}
+	ok, err := isApprover(ctx, tx, approverUserID, t.SrcUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotApprover
+	}
+
+	// Approved amount goes to the dst user, rejected amount goes back to the src user.
+	creditedUserID := t.DstUserID
+	if status != StatusCompleted {
+		creditedUserID = t.SrcUserID
+	}
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, creditedUserID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = updateUserBalance(ctx, tx, creditedUserID, usersMap[creditedUserID].Balance+t.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err = recordDecision(ctx, tx, id, status, &approverUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func getTransferForUpdate(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Transfer, error) {
+	query := `
+		SELECT id, created_at, dst_user_id, src_user_id, amount, message, from_budget, team_id,
+			   status, expires_at, decided_at, decided_by
+		FROM transfers
+		WHERE id = $1
+		FOR UPDATE
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTransfer)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// updateTransferDecision sets the final status of a pending transfer.
+// decidedBy is nil when the transfer is decided automatically, e.g. expired.
+func updateTransferDecision(ctx context.Context, db app.PgxExecutor, id uuid.UUID, status Status, decidedBy *uuid.UUID) (*Transfer, error) {
+	query := `
+		UPDATE transfers
+		SET status = $2, decided_at = now(), decided_by = $3
+		WHERE id = $1
+		RETURNING id, created_at, dst_user_id, src_user_id, amount, message, from_budget, team_id,
+				  status, expires_at, decided_at, decided_by
+	`
+	args := []any{id, string(status), decidedBy}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTransfer)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func isApprover(ctx context.Context, db app.PgxExecutor, approverUserID, srcUserID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM admins WHERE user_id = $1)
+			OR EXISTS (
+				SELECT 1
+				FROM team_members manager
+				JOIN team_members member ON manager.team_id = member.team_id
+				WHERE manager.user_id = $1 AND manager.role = 'manager' AND member.user_id = $2
+			)
+	`
+	args := []any{approverUserID, srcUserID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	ok, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,102 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Expirer expires pending transfers that weren't decided in time.
+type Expirer struct {
+	db app.PgxExecutor
+}
+
+func NewExpirer(db app.PgxExecutor) *Expirer {
+	return &Expirer{db: db}
+}
+
+// ExpirePending expires all overdue pending transfers and returns the held amounts to their src users.
+// It returns the number of expired transfers.
+func (e *Expirer) ExpirePending(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		expired, err := e.expireOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("transfer.Expirer: %w", err)
+		}
+		if !expired {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (e *Expirer) expireOne(ctx context.Context) (bool, error) {
+	tx, err := e.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	t, err := getOverduePendingTransferForUpdate(ctx, tx)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, t.SrcUserID)
+	if err != nil {
+		return false, err
+	}
+	_, err = updateUserBalance(ctx, tx, t.SrcUserID, usersMap[t.SrcUserID].Balance+t.Amount)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = recordDecision(ctx, tx, t.ID, StatusExpired, nil)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func getOverduePendingTransferForUpdate(ctx context.Context, db app.PgxExecutor) (*Transfer, error) {
+	query := `
+		SELECT id, created_at, dst_user_id, src_user_id, amount, message, from_budget, team_id,
+			   status, expires_at, decided_at, decided_by
+		FROM transfers
+		WHERE status = 'pending' AND expires_at <= now()
+		ORDER BY expires_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTransfer)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
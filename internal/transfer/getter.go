@@ -2,6 +2,7 @@ package transfer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -27,14 +28,80 @@ func (g *Getter) GetTransfersByUserID(ctx context.Context, userID uuid.UUID) ([]
 	return transfers, nil
 }
 
+// GetTransfer returns [ErrNotExist] if the transfer doesn't exist.
+func (g *Getter) GetTransfer(ctx context.Context, id uuid.UUID) (*Transfer, error) {
+	t, err := getTransfer(ctx, g.db, id)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Getter: %w", err)
+	}
+	return t, nil
+}
+
+// GetPendingTransfersByApproverID returns pending transfers that the approver can approve or reject.
+// Admins can decide on any transfer, managers can decide on transfers from members of their teams.
+// Nobody can decide on a transfer they are a party of.
+func (g *Getter) GetPendingTransfersByApproverID(ctx context.Context, approverID uuid.UUID) ([]*Transfer, error) {
+	transfers, err := getPendingTransfersByApproverID(ctx, g.db, approverID)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Getter: %w", err)
+	}
+	return transfers, nil
+}
+
+func (g *Getter) GetLimits(ctx context.Context) (*Limits, error) {
+	limits, err := getLimits(ctx, g.db)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Getter: %w", err)
+	}
+	return limits, nil
+}
+
+func getTransfer(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*Transfer, error) {
+	query := `
+		SELECT t.id, t.created_at, t.dst_user_id, t.src_user_id, t.amount, t.message, t.from_budget, t.team_id,
+			   t.status, t.expires_at, t.decided_at, t.decided_by,
+			   dst_u.username as dst_username,
+			   src_u.username as src_username,
+			   coalesce(dst_p.display_name, '') as dst_display_name,
+			   coalesce(src_p.display_name, '') as src_display_name,
+			   coalesce(tm.name, '') as team_name
+		FROM transfers t
+		LEFT JOIN users dst_u ON t.dst_user_id = dst_u.id
+		LEFT JOIN users src_u ON t.src_user_id = src_u.id
+		LEFT JOIN profiles dst_p ON t.dst_user_id = dst_p.user_id
+		LEFT JOIN profiles src_p ON t.src_user_id = src_p.user_id
+		LEFT JOIN teams tm ON t.team_id = tm.id
+		WHERE t.id = $1
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTransferWithUsernames)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
 func getTransfersByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Transfer, error) {
 	query := `
-		SELECT t.id, t.created_at, t.dst_user_id, t.src_user_id, t.amount,
+		SELECT t.id, t.created_at, t.dst_user_id, t.src_user_id, t.amount, t.message, t.from_budget, t.team_id,
+			   t.status, t.expires_at, t.decided_at, t.decided_by,
 			   dst_u.username as dst_username,
-			   src_u.username as src_username
+			   src_u.username as src_username,
+			   coalesce(dst_p.display_name, '') as dst_display_name,
+			   coalesce(src_p.display_name, '') as src_display_name,
+			   coalesce(tm.name, '') as team_name
 		FROM transfers t
 		LEFT JOIN users dst_u ON t.dst_user_id = dst_u.id
 		LEFT JOIN users src_u ON t.src_user_id = src_u.id
+		LEFT JOIN profiles dst_p ON t.dst_user_id = dst_p.user_id
+		LEFT JOIN profiles src_p ON t.src_user_id = src_p.user_id
+		LEFT JOIN teams tm ON t.team_id = tm.id
 		WHERE t.dst_user_id = $1 OR t.src_user_id = $1
 		ORDER BY t.created_at, t.id
 	`
@@ -48,3 +115,48 @@ func getTransfersByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.U
 
 	return transfers, nil
 }
+
+func getPendingTransfersByApproverID(
+	ctx context.Context,
+	db app.PgxExecutor,
+	approverID uuid.UUID,
+) ([]*Transfer, error) {
+	query := `
+		SELECT t.id, t.created_at, t.dst_user_id, t.src_user_id, t.amount, t.message, t.from_budget, t.team_id,
+			   t.status, t.expires_at, t.decided_at, t.decided_by,
+			   dst_u.username as dst_username,
+			   src_u.username as src_username,
+			   coalesce(dst_p.display_name, '') as dst_display_name,
+			   coalesce(src_p.display_name, '') as src_display_name,
+			   coalesce(tm.name, '') as team_name
+		FROM transfers t
+		LEFT JOIN users dst_u ON t.dst_user_id = dst_u.id
+		LEFT JOIN users src_u ON t.src_user_id = src_u.id
+		LEFT JOIN profiles dst_p ON t.dst_user_id = dst_p.user_id
+		LEFT JOIN profiles src_p ON t.src_user_id = src_p.user_id
+		LEFT JOIN teams tm ON t.team_id = tm.id
+		WHERE t.status = 'pending'
+		  AND t.expires_at > now()
+		  AND t.src_user_id <> $1
+		  AND t.dst_user_id <> $1
+		  AND (
+			EXISTS (SELECT 1 FROM admins a WHERE a.user_id = $1)
+			OR EXISTS (
+				SELECT 1
+				FROM team_members manager
+				JOIN team_members member ON manager.team_id = member.team_id
+				WHERE manager.user_id = $1 AND manager.role = 'manager' AND member.user_id = t.src_user_id
+			)
+		  )
+		ORDER BY t.created_at, t.id
+	`
+	args := []any{approverID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	transfers, err := pgx.CollectRows(rows, RowToTransferWithUsernames)
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
@@ -0,0 +1,73 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Granter sends coins from team coin pools as transfers from the managers who send them,
+// so grants are subject to [Limits], show in coin history and emit transfer events like other transfers.
+// It should be created with the caller's transaction, the caller takes the coins from the pool in it.
+type Granter struct {
+	db app.PgxExecutor
+}
+
+func NewGranter(db app.PgxExecutor) *Granter {
+	return &Granter{db: db}
+}
+
+type GranterGrantParams struct {
+	TeamID    uuid.UUID // team whose coin pool the coins are sent from
+	DstUserID uuid.UUID
+	SrcUserID uuid.UUID // manager who sends the coins, their balance isn't debited
+	Amount    int
+}
+
+// Grant locks the users, checks the limits, creates a completed transfer from the team pool and credits the dst user.
+// Grants don't need approval because pools are funded by admins, the same way budget transfers don't.
+func (g *Granter) Grant(ctx context.Context, params *GranterGrantParams) (*Transfer, error) {
+	dstUserID, srcUserID, amount := params.DstUserID, params.SrcUserID, params.Amount
+
+	if srcUserID == dstUserID {
+		return nil, fmt.Errorf("transfer.Granter: %w", ErrSrcUserAndDstUserEqual)
+	}
+
+	usersMap, err := getUsersByIDsForUpdate(ctx, g.db, srcUserID, dstUserID)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Granter: %w", err)
+	}
+	dstUser := usersMap[dstUserID]
+
+	limits, err := getLimits(ctx, g.db)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Granter: %w", err)
+	}
+	err = checkLimits(ctx, g.db, limits, dstUserID, srcUserID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Granter: %w", err)
+	}
+
+	createdTransfer, err := recordTransfer(ctx, g.db, &Transfer{
+		DstUserID: dstUserID,
+		SrcUserID: srcUserID,
+		Amount:    amount,
+		TeamID:    &params.TeamID,
+		Status:    StatusCompleted,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Granter: %w", err)
+	}
+
+	_, err = updateUserBalance(ctx, g.db, dstUserID, dstUser.Balance+amount)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Granter: %w", err)
+	}
+
+	createdTransfer.DstUsername = dstUser.Username
+	createdTransfer.SrcUsername = usersMap[srcUserID].Username
+	return createdTransfer, nil
+}
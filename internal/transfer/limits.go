@@ -0,0 +1,210 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// Limits is the transfer policy applied to every transfer from a user.
+// Zero values mean no limit.
+type Limits struct {
+	MaxPerTransfer       int
+	MaxPerDay            int // coins sent during the last 24 hours
+	MaxPerRecipient      int // coins sent to one recipient during the recipient period
+	RecipientPeriodDays  int
+	ApprovalThreshold    int // transfers of more coins stay pending until approved
+	ApprovalTimeoutHours int // pending transfers expire after this time
+}
+
+type LimitsRow struct {
+	MaxPerTransfer       int `db:"max_per_transfer"`
+	MaxPerDay            int `db:"max_per_day"`
+	MaxPerRecipient      int `db:"max_per_recipient"`
+	RecipientPeriodDays  int `db:"recipient_period_days"`
+	ApprovalThreshold    int `db:"approval_threshold"`
+	ApprovalTimeoutHours int `db:"approval_timeout_hours"`
+}
+
+// NeedsApproval reports whether a transfer of amount must be approved before it completes.
+func (l *Limits) NeedsApproval(amount int) bool {
+	return l.ApprovalThreshold > 0 && amount > l.ApprovalThreshold
+}
+
+func RowToLimits(collectable pgx.CollectableRow) (*Limits, error) {
+	collected, err := pgx.RowToStructByName[LimitsRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limits{
+		MaxPerTransfer:       collected.MaxPerTransfer,
+		MaxPerDay:            collected.MaxPerDay,
+		MaxPerRecipient:      collected.MaxPerRecipient,
+		RecipientPeriodDays:  collected.RecipientPeriodDays,
+		ApprovalThreshold:    collected.ApprovalThreshold,
+		ApprovalTimeoutHours: collected.ApprovalTimeoutHours,
+	}, nil
+}
+
+type LimitKind string
+
+const (
+	LimitKindPerTransfer  LimitKind = "per_transfer"
+	LimitKindPerDay       LimitKind = "per_day"
+	LimitKindPerRecipient LimitKind = "per_recipient"
+)
+
+// LimitExceededError is returned when a transfer would exceed one of the [Limits].
+// It matches [ErrLimitExceeded] with errors.Is.
+type LimitExceededError struct {
+	Kind      LimitKind
+	Max       int
+	Available int // coins that can still be sent within the limit
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("%s %s limit of %d coins, %d available", ErrLimitExceeded, e.Kind, e.Max, e.Available)
+}
+
+func (e *LimitExceededError) Is(target error) bool {
+	return target == ErrLimitExceeded
+}
+
+// LimitsUpdater updates the transfer policy.
+// It should only be used on behalf of admins.
+type LimitsUpdater struct {
+	db app.PgxExecutor
+}
+
+func NewLimitsUpdater(db app.PgxExecutor) *LimitsUpdater {
+	return &LimitsUpdater{db: db}
+}
+
+func (lu *LimitsUpdater) UpdateLimits(ctx context.Context, limits *Limits) (*Limits, error) {
+	l, err := updateLimits(ctx, lu.db, limits)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.LimitsUpdater: %w", err)
+	}
+	return l, nil
+}
+
+// checkLimits returns a [*LimitExceededError] if transferring amount from srcUserID to dstUserID
+// would exceed the limits.
+// It should be called in a transaction after the src user is locked,
+// so concurrent transfers from the same user can't both pass the check.
+func checkLimits(ctx context.Context, db app.PgxExecutor, limits *Limits, dstUserID, srcUserID uuid.UUID, amount int) error {
+	if limits.MaxPerTransfer > 0 && amount > limits.MaxPerTransfer {
+		return &LimitExceededError{
+			Kind:      LimitKindPerTransfer,
+			Max:       limits.MaxPerTransfer,
+			Available: limits.MaxPerTransfer,
+		}
+	}
+
+	if limits.MaxPerDay > 0 {
+		sent, err := getSentAmount(ctx, db, srcUserID, nil, 1)
+		if err != nil {
+			return err
+		}
+		if sent+amount > limits.MaxPerDay {
+			return &LimitExceededError{
+				Kind:      LimitKindPerDay,
+				Max:       limits.MaxPerDay,
+				Available: max(limits.MaxPerDay-sent, 0),
+			}
+		}
+	}
+
+	if limits.MaxPerRecipient > 0 {
+		sent, err := getSentAmount(ctx, db, srcUserID, &dstUserID, limits.RecipientPeriodDays)
+		if err != nil {
+			return err
+		}
+		if sent+amount > limits.MaxPerRecipient {
+			return &LimitExceededError{
+				Kind:      LimitKindPerRecipient,
+				Max:       limits.MaxPerRecipient,
+				Available: max(limits.MaxPerRecipient-sent, 0),
+			}
+		}
+	}
+
+	return nil
+}
+
+func getLimits(ctx context.Context, db app.PgxExecutor) (*Limits, error) {
+	query := `
+		SELECT max_per_transfer, max_per_day, max_per_recipient, recipient_period_days,
+			   approval_threshold, approval_timeout_hours
+		FROM transfer_limits
+	`
+
+	rows, _ := db.Query(ctx, query)
+	l, err := pgx.CollectExactlyOneRow(rows, RowToLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func updateLimits(ctx context.Context, db app.PgxExecutor, limits *Limits) (*Limits, error) {
+	query := `
+		UPDATE transfer_limits
+		SET max_per_transfer = $1,
+			max_per_day = $2,
+			max_per_recipient = $3,
+			recipient_period_days = $4,
+			approval_threshold = $5,
+			approval_timeout_hours = $6
+		RETURNING max_per_transfer, max_per_day, max_per_recipient, recipient_period_days,
+				  approval_threshold, approval_timeout_hours
+	`
+	args := []any{
+		limits.MaxPerTransfer,
+		limits.MaxPerDay,
+		limits.MaxPerRecipient,
+		limits.RecipientPeriodDays,
+		limits.ApprovalThreshold,
+		limits.ApprovalTimeoutHours,
+	}
+
+	rows, _ := db.Query(ctx, query, args...)
+	l, err := pgx.CollectExactlyOneRow(rows, RowToLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// getSentAmount returns the amount of coins the src user sent during the last days.
+// If dstUserID is not nil, only transfers to that user are counted.
+// Pending transfers are counted too because their amount is already held.
+func getSentAmount(ctx context.Context, db app.PgxExecutor, srcUserID uuid.UUID, dstUserID *uuid.UUID, days int) (int, error) {
+	query := `
+		SELECT coalesce(sum(amount), 0)
+		FROM transfers
+		WHERE src_user_id = $1
+		  AND status IN ('completed', 'pending')
+		  AND ($2::uuid IS NULL OR dst_user_id = $2)
+		  AND created_at > now() - make_interval(days => $3)
+	`
+	args := []any{srcUserID, dstUserID, days}
+
+	rows, _ := db.Query(ctx, query, args...)
+	sent, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[int])
+	if err != nil {
+		return 0, err
+	}
+
+	return sent, nil
+}
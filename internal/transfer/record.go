@@ -0,0 +1,80 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/outbox"
+)
+
+// recordTransfer creates the transfer and writes its events to the outbox.
+// The transfer is pending if t.Status is [StatusPending], then t.ExpiresAt must be set.
+// Every transfer is created by it, so completed transfers emit events however they were made.
+func recordTransfer(ctx context.Context, db app.PgxExecutor, t *Transfer) (*Transfer, error) {
+	var (
+		created *Transfer
+		err     error
+	)
+	if t.Status == StatusPending {
+		created, err = createPendingTransfer(ctx, db, t.DstUserID, t.SrcUserID, t.Amount, t.Message, *t.ExpiresAt)
+	} else {
+		created, err = createTransfer(ctx, db, &t.DstUserID, &t.SrcUserID, t.Amount, t.Message, t.FromBudget, t.TeamID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = outbox.NewWriter(db).WriteEvents(ctx, transferEvents(created)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// recordDecision sets the final status of the pending transfer and writes its events to the outbox,
+// so approved transfers emit the events they didn't when they were created.
+// The payment request the transfer was made for, if any, is settled with it.
+// decidedBy is nil when the transfer is decided automatically, e.g. expired.
+func recordDecision(ctx context.Context, db app.PgxExecutor, id uuid.UUID, status Status, decidedBy *uuid.UUID) (*Transfer, error) {
+	t, err := updateTransferDecision(ctx, db, id, status, decidedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = settlePaymentRequest(ctx, db, t.ID, t.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	err = outbox.NewWriter(db).WriteEvents(ctx, transferEvents(t)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// settlePaymentRequest settles the approving payment request accepted with the transfer.
+// Approved transfers make it accepted, rejected ones declined and expired ones expired.
+func settlePaymentRequest(ctx context.Context, db app.PgxExecutor, transferID uuid.UUID, status Status) error {
+	query := `
+		UPDATE payment_requests
+		SET status = CASE $2::text
+			WHEN 'completed' THEN 'accepted'
+			WHEN 'rejected' THEN 'declined'
+			ELSE 'expired'
+		END
+		WHERE transfer_id = $1 AND status = 'approving'
+	`
+	args := []any{transferID, string(status)}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
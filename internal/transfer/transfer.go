@@ -2,34 +2,96 @@ package transfer
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
 )
 
 var (
+	ErrNotExist               = errors.New("does not exist")
+	ErrNotPending             = errors.New("not pending")
+	ErrNotApprover            = errors.New("not an approver")
 	ErrDstUserNotFound        = errors.New("dst user not found")
+	ErrDstUserDeactivated     = errors.New("dst user is deactivated")
 	ErrSrcUserAndDstUserEqual = errors.New("src user and dst user are equal")
+	ErrDstUserDuplicate       = errors.New("dst user is duplicate")
 )
 
-type Transfer struct {
-	ID        uuid.UUID
-	CreatedAt time.Time
-	DstUserID uuid.UUID
-	SrcUserID uuid.UUID
-	Amount    int
+type Status string
+
+const (
+	StatusCompleted Status = "completed"
+	StatusPending   Status = "pending"  // waiting for approval, the amount is held from the src user
+	StatusRejected  Status = "rejected" // rejected by an approver, the amount is returned to the src user
+	StatusExpired   Status = "expired"  // not decided in time, the amount is returned to the src user
+)
+
+const (
+	EventSent     app.EventKind = "transfer.sent"     // the user sent a completed transfer
+	EventReceived app.EventKind = "transfer.received" // the user received a completed transfer
+)
+
+// BatchItem is one of the transfers made by [Transferer.BatchTransferByUsernames].
+type BatchItem struct {
+	DstUsername string
+	Amount      int
+	Message     string
+}
 
+// BatchItemError is returned when a batch transfer fails because of one of its items.
+// It wraps the cause, so errors.Is and errors.As match it too.
+type BatchItemError struct {
+	Index       int
 	DstUsername string
-	SrcUsername string
+	Err         error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("item %d to %s: %v", e.Index, e.DstUsername, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+type Transfer struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	DstUserID  uuid.UUID
+	SrcUserID  uuid.UUID
+	Amount     int
+	Message    string     // empty if the sender didn't leave a message
+	FromBudget bool       // true if sent from the sender's giving budget rather than balance
+	TeamID     *uuid.UUID // team whose coin pool the sender sent the coins from, nil if sent from balance or budget
+	Status     Status
+	ExpiresAt  *time.Time
+	DecidedAt  *time.Time
+	DecidedBy  *uuid.UUID
+
+	DstUsername    string
+	SrcUsername    string
+	DstDisplayName string
+	SrcDisplayName string
+	TeamName       string
 }
 
 type Row struct {
-	ID        uuid.UUID `db:"id"`
-	CreatedAt time.Time `db:"created_at"`
-	DstUserID uuid.UUID `db:"dst_user_id"`
-	SrcUserID uuid.UUID `db:"src_user_id"`
-	Amount    int       `db:"amount"`
+	ID         uuid.UUID  `db:"id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	DstUserID  uuid.UUID  `db:"dst_user_id"`
+	SrcUserID  uuid.UUID  `db:"src_user_id"`
+	Amount     int        `db:"amount"`
+	Message    string     `db:"message"`
+	FromBudget bool       `db:"from_budget"`
+	TeamID     *uuid.UUID `db:"team_id"`
+	Status     string     `db:"status"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	DecidedAt  *time.Time `db:"decided_at"`
+	DecidedBy  *uuid.UUID `db:"decided_by"`
 }
 
 func RowToTransfer(collectable pgx.CollectableRow) (*Transfer, error) {
@@ -39,18 +101,28 @@ func RowToTransfer(collectable pgx.CollectableRow) (*Transfer, error) {
 	}
 
 	return &Transfer{
-		ID:        collected.ID,
-		CreatedAt: collected.CreatedAt,
-		DstUserID: collected.DstUserID,
-		SrcUserID: collected.SrcUserID,
-		Amount:    collected.Amount,
+		ID:         collected.ID,
+		CreatedAt:  collected.CreatedAt,
+		DstUserID:  collected.DstUserID,
+		SrcUserID:  collected.SrcUserID,
+		Amount:     collected.Amount,
+		Message:    collected.Message,
+		FromBudget: collected.FromBudget,
+		TeamID:     collected.TeamID,
+		Status:     Status(collected.Status),
+		ExpiresAt:  collected.ExpiresAt,
+		DecidedAt:  collected.DecidedAt,
+		DecidedBy:  collected.DecidedBy,
 	}, nil
 }
 
 type RowWithUsernames struct {
 	Row
-	DstUsername string `db:"dst_username"`
-	SrcUsername string `db:"src_username"`
+	DstUsername    string `db:"dst_username"`
+	SrcUsername    string `db:"src_username"`
+	DstDisplayName string `db:"dst_display_name"`
+	SrcDisplayName string `db:"src_display_name"`
+	TeamName       string `db:"team_name"`
 }
 
 func RowToTransferWithUsernames(collectable pgx.CollectableRow) (*Transfer, error) {
@@ -60,12 +132,22 @@ func RowToTransferWithUsernames(collectable pgx.CollectableRow) (*Transfer, erro
 	}
 
 	return &Transfer{
-		ID:          collected.ID,
-		CreatedAt:   collected.CreatedAt,
-		DstUserID:   collected.DstUserID,
-		SrcUserID:   collected.SrcUserID,
-		Amount:      collected.Amount,
-		DstUsername: collected.DstUsername,
-		SrcUsername: collected.SrcUsername,
+		ID:             collected.ID,
+		CreatedAt:      collected.CreatedAt,
+		DstUserID:      collected.DstUserID,
+		SrcUserID:      collected.SrcUserID,
+		Amount:         collected.Amount,
+		Message:        collected.Message,
+		FromBudget:     collected.FromBudget,
+		TeamID:         collected.TeamID,
+		Status:         Status(collected.Status),
+		ExpiresAt:      collected.ExpiresAt,
+		DecidedAt:      collected.DecidedAt,
+		DecidedBy:      collected.DecidedBy,
+		DstUsername:    collected.DstUsername,
+		SrcUsername:    collected.SrcUsername,
+		DstDisplayName: collected.DstDisplayName,
+		SrcDisplayName: collected.SrcDisplayName,
+		TeamName:       collected.TeamName,
 	}, nil
 }
@@ -2,10 +2,14 @@ package transfer
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/auth"
+	"github.com/k11v/merch/internal/budget"
 	"github.com/k11v/merch/internal/coin"
+	"github.com/k11v/merch/internal/user"
 	"github.com/k11v/merch/internal/user/usertest"
 )
 
@@ -30,15 +34,15 @@ func TestTransfer(t *testing.T) {
 			t.Fatalf("got %v error", err)
 		}
 
-		err = tt.TransferByUsername(ctx, "bob", alice.ID, 40)
+		_, err = tt.TransferByUsername(ctx, "bob", alice.ID, 40)
 		if err != nil {
 			t.Fatalf("got %v error", err)
 		}
-		err = tt.TransferByUsername(ctx, "bob", alice.ID, 41)
+		_, err = tt.TransferByUsername(ctx, "bob", alice.ID, 41)
 		if err != nil {
 			t.Fatalf("got %v error", err)
 		}
-		err = tt.TransferByUsername(ctx, "alice", bob.ID, 42)
+		_, err = tt.TransferByUsername(ctx, "alice", bob.ID, 42)
 		if err != nil {
 			t.Fatalf("got %v error", err)
 		}
@@ -115,4 +119,387 @@ func TestTransfer(t *testing.T) {
 			t.Errorf("want %v", wantTransfers)
 		}
 	})
+
+	t.Run("transfers from budget by username", func(t *testing.T) {
+		var (
+			ctx   = context.Background()
+			db    = apptest.NewPostgresPool(t, ctx)
+			alice = usertest.CreateUser(t, ctx, db, "alice")
+			bob   = usertest.CreateUser(t, ctx, db, "bob")
+			bg    = budget.NewGetter(db)
+			bs    = budget.NewSetter(db)
+			cg    = coin.NewGetter(db)
+			tg    = NewGetter(db)
+			tt    = NewTransferer(db)
+		)
+
+		_, err := tt.TransferFromBudgetByUsername(ctx, "bob", alice.ID, 10)
+		if got, want := err, budget.ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		_, err = bs.SetBudgetByUsername(ctx, "alice", 50, budget.PeriodMonth)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		initialAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		initialBobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tt.TransferFromBudgetByUsername(ctx, "bob", alice.ID, 30)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tt.TransferFromBudgetByUsername(ctx, "bob", alice.ID, 30)
+		if got, want := err, coin.ErrNotEnough; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		aliceBudget, err := bg.GetBudget(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobTransfers, err := tg.GetTransfersByUserID(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := aliceBalance, initialAliceBalance; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+		if got, want := bobBalance, initialBobBalance+30; got != want {
+			t.Errorf("got %d bob balance, want %d", got, want)
+		}
+		if got, want := aliceBudget.Remaining, 20; got != want {
+			t.Errorf("got %d alice budget remaining, want %d", got, want)
+		}
+		if got, want := len(bobTransfers), 1; got != want {
+			t.Fatalf("got %d bob transfers, want %d", got, want)
+		}
+		if got := bobTransfers[0]; !got.FromBudget {
+			t.Errorf("got %v bob transfer, want from budget", got)
+		}
+	})
+
+	t.Run("enforces limits", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			tt  = NewTransferer(db)
+			lu  = NewLimitsUpdater(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		usertest.CreateUser(t, ctx, db, "bob")
+		usertest.CreateUser(t, ctx, db, "carol")
+
+		_, err := lu.UpdateLimits(ctx, &Limits{
+			MaxPerTransfer:      50,
+			MaxPerDay:           100,
+			MaxPerRecipient:     60,
+			RecipientPeriodDays: 30,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tt.TransferByUsername(ctx, "bob", alice.ID, 51)
+		var limitExceededErr *LimitExceededError
+		if !errors.As(err, &limitExceededErr) || limitExceededErr.Kind != LimitKindPerTransfer {
+			t.Fatalf("got %v error, want %s limit exceeded", err, LimitKindPerTransfer)
+		}
+
+		_, err = tt.TransferByUsername(ctx, "bob", alice.ID, 50)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tt.TransferByUsername(ctx, "bob", alice.ID, 20)
+		if !errors.As(err, &limitExceededErr) || limitExceededErr.Kind != LimitKindPerRecipient {
+			t.Fatalf("got %v error, want %s limit exceeded", err, LimitKindPerRecipient)
+		}
+		if got, want := limitExceededErr.Available, 10; got != want {
+			t.Errorf("got %d available, want %d", got, want)
+		}
+
+		_, err = tt.TransferByUsername(ctx, "carol", alice.ID, 50)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tt.TransferByUsername(ctx, "carol", alice.ID, 1)
+		if got, want := err, ErrLimitExceeded; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		if !errors.As(err, &limitExceededErr) || limitExceededErr.Kind != LimitKindPerDay {
+			t.Fatalf("got %v error, want %s limit exceeded", err, LimitKindPerDay)
+		}
+	})
+
+	t.Run("holds, approves, rejects and expires transfers above approval threshold", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			ta  = NewApprover(db)
+			te  = NewExpirer(db)
+			tt  = NewTransferer(db)
+			cg  = coin.NewGetter(db)
+			lu  = NewLimitsUpdater(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+		carol := usertest.CreateUser(t, ctx, db, "carol")
+
+		err := auth.NewAdminAuthorizer(db).GrantAdminByUsername(ctx, "carol")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = lu.UpdateLimits(ctx, &Limits{
+			RecipientPeriodDays:  30,
+			ApprovalThreshold:    100,
+			ApprovalTimeoutHours: 24,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		initialAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		initialBobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		approved, err := tt.TransferByUsername(ctx, "bob", alice.ID, 101)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := approved.Status, StatusPending; got != want {
+			t.Fatalf("got %s status, want %s", got, want)
+		}
+		_, err = ta.Approve(ctx, approved.ID, bob.ID)
+		if got, want := err, ErrNotApprover; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = ta.Approve(ctx, approved.ID, carol.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ta.Reject(ctx, approved.ID, carol.ID)
+		if got, want := err, ErrNotPending; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		rejected, err := tt.TransferByUsername(ctx, "bob", alice.ID, 102)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = ta.Reject(ctx, rejected.ID, carol.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		expired, err := tt.TransferByUsername(ctx, "bob", alice.ID, 103)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		heldAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = db.Exec(ctx, "UPDATE transfers SET expires_at = now() WHERE id = $1", expired.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		count, err := te.ExpirePending(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := heldAliceBalance, initialAliceBalance-101-103; got != want {
+			t.Errorf("got %d held alice balance, want %d", got, want)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d expired transfers, want %d", got, want)
+		}
+		if got, want := aliceBalance, initialAliceBalance-101; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+		if got, want := bobBalance, initialBobBalance+101; got != want {
+			t.Errorf("got %d bob balance, want %d", got, want)
+		}
+
+		var approvedEventCount, otherEventCount int
+		err = db.QueryRow(ctx, "SELECT count(*) FROM outbox_events WHERE entity_id = $1", approved.ID).
+			Scan(&approvedEventCount)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = db.QueryRow(ctx, "SELECT count(*) FROM outbox_events WHERE entity_id IN ($1, $2)", rejected.ID, expired.ID).
+			Scan(&otherEventCount)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := approvedEventCount, 2; got != want {
+			t.Errorf("got %d approved transfer events, want %d", got, want)
+		}
+		if got, want := otherEventCount, 0; got != want {
+			t.Errorf("got %d rejected and expired transfer events, want %d", got, want)
+		}
+	})
+
+	t.Run("batch transfers by usernames all or nothing", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			tt  = NewTransferer(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		bob := usertest.CreateUser(t, ctx, db, "bob")
+		carol := usertest.CreateUser(t, ctx, db, "carol")
+
+		initialAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		initialBobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		initialCarolBalance, err := cg.GetBalance(ctx, carol.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		transfers, err := tt.BatchTransferByUsernames(ctx, alice.ID, []BatchItem{
+			{DstUsername: "bob", Amount: 10},
+			{DstUsername: "carol", Amount: 20},
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(transfers), 2; got != want {
+			t.Fatalf("got %d transfers, want %d", got, want)
+		}
+
+		_, err = tt.BatchTransferByUsernames(ctx, alice.ID, []BatchItem{
+			{DstUsername: "bob", Amount: 10},
+			{DstUsername: "carol", Amount: initialAliceBalance},
+		})
+		var batchItemErr *BatchItemError
+		if !errors.As(err, &batchItemErr) || !errors.Is(err, coin.ErrNotEnough) {
+			t.Fatalf("got %v error, want %v batch item error", err, coin.ErrNotEnough)
+		}
+		if got, want := batchItemErr.Index, 1; got != want {
+			t.Errorf("got %d failed item index, want %d", got, want)
+		}
+
+		_, err = tt.BatchTransferByUsernames(ctx, alice.ID, []BatchItem{
+			{DstUsername: "bob", Amount: 10},
+			{DstUsername: "bob", Amount: 10},
+		})
+		if got, want := err, ErrDstUserDuplicate; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		bobBalance, err := cg.GetBalance(ctx, bob.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		carolBalance, err := cg.GetBalance(ctx, carol.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		if got, want := aliceBalance, initialAliceBalance-10-20; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+		if got, want := bobBalance, initialBobBalance+10; got != want {
+			t.Errorf("got %d bob balance, want %d", got, want)
+		}
+		if got, want := carolBalance, initialCarolBalance+20; got != want {
+			t.Errorf("got %d carol balance, want %d", got, want)
+		}
+	})
+	t.Run("doesn't transfer to deactivated users", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			cg  = coin.NewGetter(db)
+			tt  = NewTransferer(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+		usertest.CreateUser(t, ctx, db, "bob")
+		usertest.CreateUser(t, ctx, db, "carol")
+
+		initialAliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		err = user.NewDeactivator(db).Deactivate(ctx, "carol")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = tt.TransferByUsername(ctx, "carol", alice.ID, 10)
+		if got, want := err, ErrDstUserDeactivated; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = tt.BatchTransferByUsernames(ctx, alice.ID, []BatchItem{
+			{DstUsername: "bob", Amount: 10},
+			{DstUsername: "carol", Amount: 10},
+		})
+		var batchItemErr *BatchItemError
+		if !errors.As(err, &batchItemErr) || !errors.Is(err, ErrDstUserDeactivated) {
+			t.Fatalf("got %v error, want %v batch item error", err, ErrDstUserDeactivated)
+		}
+		if got, want := batchItemErr.Index, 1; got != want {
+			t.Errorf("got %d failed item index, want %d", got, want)
+		}
+
+		aliceBalance, err := cg.GetBalance(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := aliceBalance, initialAliceBalance; got != want {
+			t.Errorf("got %d alice balance, want %d", got, want)
+		}
+
+		err = user.NewDeactivator(db).Reactivate(ctx, "carol")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = tt.TransferByUsername(ctx, "carol", alice.ID, 10)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+	})
 }
@@ -5,41 +5,90 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/budget"
 	"github.com/k11v/merch/internal/coin"
 	"github.com/k11v/merch/internal/user"
 )
 
+// Transferer transfers coins between users.
+// It can be used within an outer transaction, then its changes are committed with it.
 type Transferer struct {
-	db *pgxpool.Pool
+	db app.PgxExecutor
 }
 
-func NewTransferer(db *pgxpool.Pool) *Transferer {
+func NewTransferer(db app.PgxExecutor) *Transferer {
 	return &Transferer{db: db}
 }
 
-func (t *Transferer) TransferByUsername(ctx context.Context, dstUsername string, srcUserID uuid.UUID, amount int) error {
-	dstUser, err := user.NewGetter(t.db).GetUserByUsername(ctx, dstUsername)
+// TransferByUsername transfers amount from the src user's balance to the dst user.
+// If the amount needs approval according to [Limits], the returned transfer is pending:
+// the amount is held from the src user's balance and the dst user doesn't receive it until approved.
+func (t *Transferer) TransferByUsername(ctx context.Context, dstUsername string, srcUserID uuid.UUID, amount int) (*Transfer, error) {
+	return t.Transfer(ctx, &TransfererTransferParams{DstUsername: dstUsername, SrcUserID: srcUserID, Amount: amount})
+}
+
+// TransferFromBudgetByUsername is like TransferByUsername
+// but debits the sender's giving budget instead of their balance.
+// It returns [budget.ErrNotExist] if the sender has no giving budget.
+// Budget transfers don't need approval because budgets are assigned by admins.
+func (t *Transferer) TransferFromBudgetByUsername(ctx context.Context, dstUsername string, srcUserID uuid.UUID, amount int) (*Transfer, error) {
+	return t.Transfer(ctx, &TransfererTransferParams{
+		DstUsername: dstUsername,
+		SrcUserID:   srcUserID,
+		Amount:      amount,
+		FromBudget:  true,
+	})
+}
+
+type TransfererTransferParams struct {
+	DstUsername string
+	SrcUserID   uuid.UUID
+	Amount      int
+
+	// Message is an optional note of appreciation shown to the dst user and in the kudos feed.
+	Message string
+
+	// FromBudget debits the src user's giving budget instead of their balance.
+	FromBudget bool
+}
+
+// Transfer transfers coins like TransferByUsername or,
+// if params.FromBudget is set, like TransferFromBudgetByUsername.
+func (t *Transferer) Transfer(ctx context.Context, params *TransfererTransferParams) (*Transfer, error) {
+	if params.FromBudget {
+		return t.transferFromBudget(ctx, params)
+	}
+	return t.transferFromBalance(ctx, params)
+}
+
+func (t *Transferer) transferFromBalance(ctx context.Context, params *TransfererTransferParams) (*Transfer, error) {
+	dstUsername, srcUserID, amount := params.DstUsername, params.SrcUserID, params.Amount
+
+	dstUser, err := user.NewGetter(t.db).GetActiveUserByUsername(ctx, dstUsername)
 	if err != nil {
 		if errors.Is(err, user.ErrNotExist) {
-			return fmt.Errorf("transfer.Transferer: %w", ErrDstUserNotFound)
+			return nil, fmt.Errorf("transfer.Transferer: %w", ErrDstUserNotFound)
+		}
+		if errors.Is(err, user.ErrDeactivated) {
+			return nil, fmt.Errorf("transfer.Transferer: %w", ErrDstUserDeactivated)
 		}
-		return fmt.Errorf("transfer.Transferer: %w", err)
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
 	}
 	dstUserID := dstUser.ID
 
 	if srcUserID == dstUserID {
-		return fmt.Errorf("transfer.Transferer: %w", ErrSrcUserAndDstUserEqual)
+		return nil, fmt.Errorf("transfer.Transferer: %w", ErrSrcUserAndDstUserEqual)
 	}
 
 	tx, err := t.db.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("transfer.Transferer: %w", err)
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
 	}
 	defer func() {
 		rollbackErr := tx.Rollback(ctx)
@@ -50,48 +99,323 @@ func (t *Transferer) TransferByUsername(ctx context.Context, dstUsername string,
 
 	usersMap, err := getUsersByIDsForUpdate(ctx, tx, srcUserID, dstUserID)
 	if err != nil {
-		return fmt.Errorf("transfer.Transferer: %w", err)
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
 	}
 	srcUser := usersMap[srcUserID]
 	dstUser = usersMap[dstUserID]
 
+	limits, err := getLimits(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	err = checkLimits(ctx, tx, limits, dstUserID, srcUserID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
 	srcUserBalance := srcUser.Balance
 	srcUserBalance -= amount
 	if srcUserBalance < 0 {
-		return fmt.Errorf("transfer.Transferer: %w", coin.ErrNotEnough)
+		return nil, fmt.Errorf("transfer.Transferer: %w", coin.ErrNotEnough)
+	}
+
+	_, err = updateUserBalance(ctx, tx, srcUserID, srcUserBalance)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	newTransfer := &Transfer{
+		DstUserID: dstUserID,
+		SrcUserID: srcUserID,
+		Amount:    amount,
+		Message:   params.Message,
+		Status:    StatusCompleted,
+	}
+	if limits.NeedsApproval(amount) {
+		expiresAt := time.Now().Add(time.Duration(limits.ApprovalTimeoutHours) * time.Hour)
+		newTransfer.Status = StatusPending
+		newTransfer.ExpiresAt = &expiresAt
+	} else {
+		_, err = updateUserBalance(ctx, tx, dstUserID, dstUser.Balance+amount)
+		if err != nil {
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+	}
+
+	createdTransfer, err := recordTransfer(ctx, tx, newTransfer)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	createdTransfer.DstUsername = dstUser.Username
+	createdTransfer.SrcUsername = usersMap[srcUserID].Username
+	return createdTransfer, nil
+}
+
+// BatchTransferByUsernames makes a transfer from the src user's balance for every item in a single transaction.
+// Either all transfers are made or none of them, in which case the error is a [*BatchItemError]
+// for the first item that failed.
+// Each transfer is subject to [Limits] as if it were made separately.
+func (t *Transferer) BatchTransferByUsernames(ctx context.Context, srcUserID uuid.UUID, items []BatchItem) ([]*Transfer, error) {
+	dstUsernames := make([]string, len(items))
+	for i, item := range items {
+		dstUsernames[i] = item.DstUsername
+	}
+	userIDsMap, err := getUserIDsByUsernames(ctx, t.db, dstUsernames)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	deactivatedUsernames, err := getDeactivatedUsernames(ctx, t.db, dstUsernames)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	userIDs := []uuid.UUID{srcUserID}
+	seenDstUsernames := make(map[string]bool)
+	for i, item := range items {
+		dstUserID, ok := userIDsMap[item.DstUsername]
+		if !ok {
+			err = &BatchItemError{Index: i, DstUsername: item.DstUsername, Err: ErrDstUserNotFound}
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+		if deactivatedUsernames[item.DstUsername] {
+			err = &BatchItemError{Index: i, DstUsername: item.DstUsername, Err: ErrDstUserDeactivated}
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+		if dstUserID == srcUserID {
+			err = &BatchItemError{Index: i, DstUsername: item.DstUsername, Err: ErrSrcUserAndDstUserEqual}
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+		if seenDstUsernames[item.DstUsername] {
+			err = &BatchItemError{Index: i, DstUsername: item.DstUsername, Err: ErrDstUserDuplicate}
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+		seenDstUsernames[item.DstUsername] = true
+		userIDs = append(userIDs, dstUserID)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, userIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	limits, err := getLimits(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	balancesMap := make(map[uuid.UUID]int)
+	for id, u := range usersMap {
+		balancesMap[id] = u.Balance
+	}
+
+	createdTransfers := make([]*Transfer, len(items))
+	for i, item := range items {
+		dstUserID := userIDsMap[item.DstUsername]
+
+		// Transfers created earlier in the batch are visible to the check,
+		// so the limits apply to the batch as a whole.
+		err = checkLimits(ctx, tx, limits, dstUserID, srcUserID, item.Amount)
+		if err != nil {
+			err = &BatchItemError{Index: i, DstUsername: item.DstUsername, Err: err}
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+
+		if balancesMap[srcUserID]-item.Amount < 0 {
+			err = &BatchItemError{Index: i, DstUsername: item.DstUsername, Err: coin.ErrNotEnough}
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+		balancesMap[srcUserID] -= item.Amount
+
+		newTransfer := &Transfer{
+			DstUserID: dstUserID,
+			SrcUserID: srcUserID,
+			Amount:    item.Amount,
+			Message:   item.Message,
+			Status:    StatusCompleted,
+		}
+		if limits.NeedsApproval(item.Amount) {
+			expiresAt := time.Now().Add(time.Duration(limits.ApprovalTimeoutHours) * time.Hour)
+			newTransfer.Status = StatusPending
+			newTransfer.ExpiresAt = &expiresAt
+		} else {
+			balancesMap[dstUserID] += item.Amount
+		}
+
+		createdTransfer, err := recordTransfer(ctx, tx, newTransfer)
+		if err != nil {
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+		createdTransfer.DstUsername = item.DstUsername
+		createdTransfer.SrcUsername = usersMap[srcUserID].Username
+		createdTransfers[i] = createdTransfer
+	}
+
+	for _, id := range userIDs {
+		_, err = updateUserBalance(ctx, tx, id, balancesMap[id])
+		if err != nil {
+			return nil, fmt.Errorf("transfer.Transferer: %w", err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	return createdTransfers, nil
+}
+
+func (t *Transferer) transferFromBudget(ctx context.Context, params *TransfererTransferParams) (*Transfer, error) {
+	dstUsername, srcUserID, amount := params.DstUsername, params.SrcUserID, params.Amount
+
+	dstUser, err := user.NewGetter(t.db).GetActiveUserByUsername(ctx, dstUsername)
+	if err != nil {
+		if errors.Is(err, user.ErrNotExist) {
+			return nil, fmt.Errorf("transfer.Transferer: %w", ErrDstUserNotFound)
+		}
+		if errors.Is(err, user.ErrDeactivated) {
+			return nil, fmt.Errorf("transfer.Transferer: %w", ErrDstUserDeactivated)
+		}
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	dstUserID := dstUser.ID
+
+	if srcUserID == dstUserID {
+		return nil, fmt.Errorf("transfer.Transferer: %w", ErrSrcUserAndDstUserEqual)
+	}
+
+	tx, err := t.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	srcBudget, err := getBudgetForUpdate(ctx, tx, srcUserID)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	srcBudget = srcBudget.At(time.Now())
+
+	// The src user is locked too, so limits are checked consistently
+	// with concurrent transfers from the src user's balance.
+	usersMap, err := getUsersByIDsForUpdate(ctx, tx, srcUserID, dstUserID)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	dstUser = usersMap[dstUserID]
+
+	limits, err := getLimits(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+	err = checkLimits(ctx, tx, limits, dstUserID, srcUserID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
+	}
+
+	srcBudgetRemaining := srcBudget.Remaining
+	srcBudgetRemaining -= amount
+	if srcBudgetRemaining < 0 {
+		return nil, fmt.Errorf("transfer.Transferer: %w", coin.ErrNotEnough)
 	}
 
 	dstUserBalance := dstUser.Balance
 	dstUserBalance += amount
 
-	_, err = createTransfer(ctx, tx, &dstUserID, &srcUserID, amount)
+	createdTransfer, err := recordTransfer(ctx, tx, &Transfer{
+		DstUserID:  dstUserID,
+		SrcUserID:  srcUserID,
+		Amount:     amount,
+		Message:    params.Message,
+		FromBudget: true,
+		Status:     StatusCompleted,
+	})
 	if err != nil {
-		return fmt.Errorf("transfer.Transferer: %w", err)
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
 	}
 
-	_, err = updateUserBalance(ctx, tx, srcUserID, srcUserBalance)
+	_, err = updateBudgetRemaining(ctx, tx, srcUserID, srcBudget.PeriodStart, srcBudgetRemaining)
 	if err != nil {
-		return fmt.Errorf("transfer.Transferer: %w", err)
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
 	}
 
 	_, err = updateUserBalance(ctx, tx, dstUserID, dstUserBalance)
 	if err != nil {
-		return fmt.Errorf("transfer.Transferer: %w", err)
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
 	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
-		return fmt.Errorf("transfer.Transferer: %w", err)
+		return nil, fmt.Errorf("transfer.Transferer: %w", err)
 	}
 
-	return nil
+	createdTransfer.DstUsername = dstUser.Username
+	createdTransfer.SrcUsername = usersMap[srcUserID].Username
+	return createdTransfer, nil
 }
 
+// transferEvents returns the events of the transfer, pending transfers have none.
+func transferEvents(t *Transfer) []*app.Event {
+	if t.Status != StatusCompleted {
+		return nil
+	}
+	data := transferEventData{
+		ID:         t.ID,
+		CreatedAt:  t.CreatedAt,
+		FromUserID: t.SrcUserID,
+		ToUserID:   t.DstUserID,
+		Amount:     t.Amount,
+		Message:    t.Message,
+		FromBudget: t.FromBudget,
+		TeamID:     t.TeamID,
+	}
+	return []*app.Event{
+		{Kind: EventSent, UserID: t.SrcUserID, ID: t.ID, Data: data},
+		{Kind: EventReceived, UserID: t.DstUserID, ID: t.ID, Data: data},
+	}
+}
+
+type transferEventData struct {
+	ID         uuid.UUID  `json:"id"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	FromUserID uuid.UUID  `json:"fromUserId"`
+	ToUserID   uuid.UUID  `json:"toUserId"`
+	Amount     int        `json:"amount"`
+	Message    string     `json:"message,omitempty"`
+	FromBudget bool       `json:"fromBudget,omitempty"`
+	TeamID     *uuid.UUID `json:"teamId,omitempty"`
+}
+
+// getUsersByIDsForUpdate locks the users in the order of their IDs,
+// so concurrent transactions locking overlapping users don't deadlock.
 func getUsersByIDsForUpdate(ctx context.Context, db app.PgxExecutor, ids ...uuid.UUID) (map[uuid.UUID]*user.User, error) {
 	query := `
 		SELECT id, username, password_hash, balance
 		FROM users
 		WHERE id = ANY($1)
+		ORDER BY id
 		FOR UPDATE
 	`
 	args := []any{ids}
@@ -117,13 +441,84 @@ func getUsersByIDsForUpdate(ctx context.Context, db app.PgxExecutor, ids ...uuid
 	return usersMap, nil
 }
 
-func createTransfer(ctx context.Context, db app.PgxExecutor, dstUserID, srcUserID *uuid.UUID, amount int) (*Transfer, error) {
+func getUserIDsByUsernames(ctx context.Context, db app.PgxExecutor, usernames []string) (map[string]uuid.UUID, error) {
+	query := `
+		SELECT id, username, password_hash, balance
+		FROM users
+		WHERE username = ANY($1)
+	`
+	args := []any{usernames}
+
+	rows, _ := db.Query(ctx, query, args...)
+	users, err := pgx.CollectRows(rows, user.RowToUser)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDsMap := make(map[string]uuid.UUID)
+	for _, u := range users {
+		userIDsMap[u.Username] = u.ID
+	}
+
+	return userIDsMap, nil
+}
+
+func getDeactivatedUsernames(ctx context.Context, db app.PgxExecutor, usernames []string) (map[string]bool, error) {
+	query := `
+		SELECT username
+		FROM users
+		WHERE username = ANY($1) AND deactivated_at IS NOT NULL
+	`
+	args := []any{usernames}
+
+	rows, _ := db.Query(ctx, query, args...)
+	deactivated, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+
+	deactivatedMap := make(map[string]bool)
+	for _, username := range deactivated {
+		deactivatedMap[username] = true
+	}
+
+	return deactivatedMap, nil
+}
+
+func createTransfer(
+	ctx context.Context,
+	db app.PgxExecutor,
+	dstUserID, srcUserID *uuid.UUID,
+	amount int,
+	message string,
+	fromBudget bool,
+	teamID *uuid.UUID,
+) (*Transfer, error) {
 	query := `
-		INSERT INTO transfers (dst_user_id, src_user_id, amount)
-		VALUES ($1, $2, $3)
-		RETURNING id, created_at, dst_user_id, src_user_id, amount
+		INSERT INTO transfers (dst_user_id, src_user_id, amount, message, from_budget, team_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, dst_user_id, src_user_id, amount, message, from_budget, team_id,
+				  status, expires_at, decided_at, decided_by
 	`
-	args := []any{dstUserID, srcUserID, amount}
+	args := []any{dstUserID, srcUserID, amount, message, fromBudget, teamID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	t, err := pgx.CollectExactlyOneRow(rows, RowToTransfer)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func createPendingTransfer(ctx context.Context, db app.PgxExecutor, dstUserID, srcUserID uuid.UUID, amount int, message string, expiresAt time.Time) (*Transfer, error) {
+	query := `
+		INSERT INTO transfers (dst_user_id, src_user_id, amount, message, status, expires_at)
+		VALUES ($1, $2, $3, $4, 'pending', $5)
+		RETURNING id, created_at, dst_user_id, src_user_id, amount, message, from_budget, team_id,
+				  status, expires_at, decided_at, decided_by
+	`
+	args := []any{dstUserID, srcUserID, amount, message, expiresAt}
 
 	rows, _ := db.Query(ctx, query, args...)
 	t, err := pgx.CollectExactlyOneRow(rows, RowToTransfer)
@@ -154,3 +549,45 @@ func updateUserBalance(ctx context.Context, db app.PgxExecutor, id uuid.UUID, ba
 
 	return u, nil
 }
+
+func getBudgetForUpdate(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) (*budget.Budget, error) {
+	query := `
+		SELECT user_id, allowance, period, period_start, remaining
+		FROM giving_budgets
+		WHERE user_id = $1
+		FOR UPDATE
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	b, err := pgx.CollectExactlyOneRow(rows, budget.RowToBudget)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, budget.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func updateBudgetRemaining(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, periodStart time.Time, remaining int) (*budget.Budget, error) {
+	query := `
+		UPDATE giving_budgets
+		SET period_start = $2, remaining = $3
+		WHERE user_id = $1
+		RETURNING user_id, allowance, period, period_start, remaining
+	`
+	args := []any{userID, periodStart, remaining}
+
+	rows, _ := db.Query(ctx, query, args...)
+	b, err := pgx.CollectExactlyOneRow(rows, budget.RowToBudget)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, budget.ErrNotExist
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
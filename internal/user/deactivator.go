@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+// Deactivator deactivates users who left.
+// Deactivated users are hidden from the colleague directory, can't sign in and can't receive coins or items.
+type Deactivator struct {
+	db app.PgxExecutor
+}
+
+func NewDeactivator(db app.PgxExecutor) *Deactivator {
+	return &Deactivator{db: db}
+}
+
+// Deactivate deactivates the user. Deactivating a deactivated user keeps the original time.
+func (d *Deactivator) Deactivate(ctx context.Context, username string) error {
+	err := updateUserDeactivatedAt(ctx, d.db, username, true)
+	if err != nil {
+		return fmt.Errorf("user.Deactivator: %w", err)
+	}
+	return nil
+}
+
+// Reactivate reactivates the user.
+func (d *Deactivator) Reactivate(ctx context.Context, username string) error {
+	err := updateUserDeactivatedAt(ctx, d.db, username, false)
+	if err != nil {
+		return fmt.Errorf("user.Deactivator: %w", err)
+	}
+	return nil
+}
+
+func updateUserDeactivatedAt(ctx context.Context, db app.PgxExecutor, username string, deactivated bool) error {
+	query := `
+		UPDATE users
+		SET deactivated_at = CASE WHEN $2 THEN coalesce(deactivated_at, now()) END
+		WHERE username = $1
+		RETURNING id
+	`
+	args := []any{username, deactivated}
+
+	rows, _ := db.Query(ctx, query, args...)
+	_, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotExist
+		}
+		return err
+	}
+
+	return nil
+}
@@ -35,6 +35,33 @@ func (g *Getter) GetUserByUsername(ctx context.Context, username string) (*User,
 	return u, nil
 }
 
+// GetActiveUser is like GetUser but returns [ErrDeactivated] if the user is deactivated.
+func (g *Getter) GetActiveUser(ctx context.Context, id uuid.UUID) (*User, error) {
+	u, err := getUser(ctx, g.db, id)
+	if err != nil {
+		return nil, fmt.Errorf("user.Getter: %w", err)
+	}
+	err = checkUserActive(ctx, g.db, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("user.Getter: %w", err)
+	}
+	return u, nil
+}
+
+// GetActiveUserByUsername is like GetUserByUsername but returns [ErrDeactivated] if the user is deactivated.
+// Use it to find users who receive something, deactivated users shouldn't.
+func (g *Getter) GetActiveUserByUsername(ctx context.Context, username string) (*User, error) {
+	u, err := getUserByUsername(ctx, g.db, username)
+	if err != nil {
+		return nil, fmt.Errorf("user.Getter: %w", err)
+	}
+	err = checkUserActive(ctx, g.db, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("user.Getter: %w", err)
+	}
+	return u, nil
+}
+
 func getUser(ctx context.Context, db app.PgxExecutor, id uuid.UUID) (*User, error) {
 	query := `
 		SELECT id, username, password_hash, balance
@@ -74,3 +101,26 @@ func getUserByUsername(ctx context.Context, db app.PgxExecutor, username string)
 
 	return u, nil
 }
+
+func checkUserActive(ctx context.Context, db app.PgxExecutor, id uuid.UUID) error {
+	query := `
+		SELECT deactivated_at IS NOT NULL
+		FROM users
+		WHERE id = $1
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	deactivated, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotExist
+		}
+		return err
+	}
+	if deactivated {
+		return ErrDeactivated
+	}
+
+	return nil
+}
@@ -8,8 +8,9 @@ import (
 )
 
 var (
-	ErrExist    = errors.New("already exists")
-	ErrNotExist = errors.New("does not exist")
+	ErrExist       = errors.New("already exists")
+	ErrNotExist    = errors.New("does not exist")
+	ErrDeactivated = errors.New("deactivated")
 )
 
 type User struct {
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/k11v/merch/internal/app/apptest"
@@ -58,4 +59,48 @@ func TestUser(t *testing.T) {
 			t.Fatalf("got %d user ID, want %d", got, want)
 		}
 	})
+	t.Run("gets active users until they are deactivated", func(t *testing.T) {
+		var (
+			tx = apptest.BeginPostgresTx(t, ctx, db)
+			ph = NewPasswordHasher(DefaultArgon2IDParams())
+			c  = NewCreator(tx, ph)
+			g  = NewGetter(tx)
+			d  = NewDeactivator(tx)
+		)
+
+		u, err := c.CreateUser(ctx, "alice", "alice123")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = g.GetActiveUserByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		err = d.Deactivate(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = g.GetActiveUserByUsername(ctx, "alice")
+		if got, want := err, ErrDeactivated; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = g.GetActiveUser(ctx, u.ID)
+		if got, want := err, ErrDeactivated; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		_, err = g.GetUserByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		err = d.Reactivate(ctx, "alice")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = g.GetActiveUser(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+	})
 }
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+const secretLen = 32
+
+type Creator struct {
+	db app.PgxExecutor
+}
+
+func NewCreator(db app.PgxExecutor) *Creator {
+	return &Creator{db: db}
+}
+
+type CreatorCreateSubscriptionParams struct {
+	URL        string
+	EventKinds []app.EventKind
+	CreatedBy  uuid.UUID
+
+	// Secret is generated if empty.
+	Secret string
+}
+
+func (c *Creator) CreateSubscription(ctx context.Context, params *CreatorCreateSubscriptionParams) (*Subscription, error) {
+	if len(params.EventKinds) == 0 {
+		return nil, fmt.Errorf("webhook.Creator: %w", ErrInvalidValue)
+	}
+	for _, k := range params.EventKinds {
+		if !slices.Contains(Kinds, k) {
+			return nil, fmt.Errorf("webhook.Creator: %w", ErrInvalidValue)
+		}
+	}
+
+	secret := params.Secret
+	if secret == "" {
+		b := make([]byte, secretLen)
+		_, err := rand.Read(b)
+		if err != nil {
+			return nil, fmt.Errorf("webhook.Creator: %w", err)
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	s, err := createSubscription(ctx, c.db, params.URL, secret, params.EventKinds, params.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("webhook.Creator: %w", err)
+	}
+	return s, nil
+}
+
+func createSubscription(ctx context.Context, db app.PgxExecutor, url string, secret string, eventKinds []app.EventKind, createdBy uuid.UUID) (*Subscription, error) {
+	query := `
+		INSERT INTO webhook_subscriptions (url, secret, event_kinds, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, url, secret, event_kinds, created_by, deleted_at
+	`
+	kinds := make([]string, len(eventKinds))
+	for i, k := range eventKinds {
+		kinds[i] = string(k)
+	}
+	args := []any{url, secret, kinds, createdBy}
+
+	rows, _ := db.Query(ctx, query, args...)
+	s, err := pgx.CollectExactlyOneRow(rows, RowToSubscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
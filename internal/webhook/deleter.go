@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Deleter struct {
+	db app.PgxExecutor
+}
+
+func NewDeleter(db app.PgxExecutor) *Deleter {
+	return &Deleter{db: db}
+}
+
+// DeleteSubscription stops deliveries to the subscription.
+// Its pending deliveries are not attempted anymore, delivered and dead ones are kept.
+func (d *Deleter) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	err := deleteSubscription(ctx, d.db, id)
+	if err != nil {
+		return fmt.Errorf("webhook.Deleter: %w", err)
+	}
+	return nil
+}
+
+func deleteSubscription(ctx context.Context, db app.PgxExecutor, id uuid.UUID) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET deleted_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	_, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotExist
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/outbox"
+)
+
+const (
+	// MaxAttempts is the number of failed attempts after which a delivery is dead.
+	MaxAttempts = 8
+
+	// claimLease is how long a claimed delivery is hidden from other deliverers.
+	// It should be longer than the HTTP client timeout.
+	claimLease = time.Minute
+
+	minBackoff = 30 * time.Second
+	maxBackoff = 6 * time.Hour
+
+	maxResponseBodyLen = 64 << 10
+)
+
+// Deliverer posts the due deliveries to their subscriptions.
+type Deliverer struct {
+	db     app.PgxExecutor
+	client *http.Client
+}
+
+func NewDeliverer(db app.PgxExecutor, client *http.Client) *Deliverer {
+	return &Deliverer{db: db, client: client}
+}
+
+// Payload is the JSON body of a webhook request.
+type Payload struct {
+	ID        uuid.UUID       `json:"id"` // outbox event ID, receivers can use it to ignore redeliveries
+	Kind      app.EventKind   `json:"kind"`
+	UserID    uuid.UUID       `json:"userId"`
+	EntityID  uuid.UUID       `json:"entityId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// DeliverDue attempts every pending delivery whose next attempt is due.
+// Failed attempts are retried with exponential backoff until MaxAttempts, then the delivery is dead.
+// It returns the number of attempted deliveries.
+func (d *Deliverer) DeliverDue(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		delivered, err := d.deliverOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("webhook.Deliverer: %w", err)
+		}
+		if !delivered {
+			return count, nil
+		}
+		count++
+	}
+}
+
+// deliverOne claims a single due delivery and attempts it.
+// The request is made outside of a transaction, the claim lease keeps concurrent deliverers away from it meanwhile.
+func (d *Deliverer) deliverOne(ctx context.Context) (bool, error) {
+	c, err := claimDueDelivery(ctx, d.db)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	attemptErr := d.post(ctx, c)
+	if attemptErr == nil {
+		err = updateDeliveryDelivered(ctx, d.db, c.ID, c.Attempts+1)
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	attempts := c.Attempts + 1
+	status, delay := DeliveryStatusPending, backoff(attempts)
+	if attempts >= MaxAttempts {
+		status, delay = DeliveryStatusDead, 0
+	}
+	err = updateDeliveryFailed(ctx, d.db, c.ID, attempts, status, delay, attemptErr.Error())
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (d *Deliverer) post(ctx context.Context, c *claimedDelivery) error {
+	body, err := json.Marshal(&Payload{
+		ID:        c.Event.ID,
+		Kind:      c.Event.Kind,
+		UserID:    c.Event.UserID,
+		EntityID:  c.Event.EntityID,
+		CreatedAt: c.Event.CreatedAt,
+		Data:      c.Event.Data,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Merch-Event", string(c.Event.Kind))
+	req.Header.Set("X-Merch-Delivery", c.ID.String())
+	req.Header.Set("X-Merch-Timestamp", strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set("X-Merch-Signature", "sha256="+Sign(c.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodyLen))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("got %d status", resp.StatusCode)
+	}
+	return nil
+}
+
+// backoff returns the delay before the next attempt of a delivery that failed attempts times.
+// It doubles from minBackoff and is capped at maxBackoff.
+func backoff(attempts int) time.Duration {
+	delay := minBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return delay
+}
+
+type claimedDelivery struct {
+	ID       uuid.UUID
+	Attempts int
+	URL      string
+	Secret   string
+	Event    *outbox.Event
+}
+
+type claimedDeliveryRow struct {
+	ID             uuid.UUID `db:"id"`
+	Attempts       int       `db:"attempts"`
+	URL            string    `db:"url"`
+	Secret         string    `db:"secret"`
+	EventID        uuid.UUID `db:"event_id"`
+	EventCreatedAt time.Time `db:"event_created_at"`
+	EventKind      string    `db:"event_kind"`
+	EventUserID    uuid.UUID `db:"event_user_id"`
+	EventEntityID  uuid.UUID `db:"event_entity_id"`
+	EventData      []byte    `db:"event_data"`
+}
+
+// claimDueDelivery moves the next attempt of a due delivery by claimLease
+// and returns it with its subscription and event.
+// Deliveries of deleted subscriptions are not claimed.
+func claimDueDelivery(ctx context.Context, db app.PgxExecutor) (*claimedDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = now() + make_interval(secs => $1)
+		FROM webhook_subscriptions s, outbox_events e
+		WHERE d.id = (
+			SELECT dd.id
+			FROM webhook_deliveries dd
+			JOIN webhook_subscriptions ds ON dd.subscription_id = ds.id
+			WHERE dd.status = 'pending' AND dd.next_attempt_at <= now() AND ds.deleted_at IS NULL
+			ORDER BY dd.next_attempt_at
+			LIMIT 1
+			FOR UPDATE OF dd SKIP LOCKED
+		) AND s.id = d.subscription_id AND e.id = d.event_id
+		RETURNING d.id, d.attempts, s.url, s.secret,
+			e.id AS event_id, e.created_at AS event_created_at, e.kind AS event_kind,
+			e.user_id AS event_user_id, e.entity_id AS event_entity_id, e.data AS event_data
+	`
+	args := []any{claimLease.Seconds()}
+
+	rows, _ := db.Query(ctx, query, args...)
+	collected, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[claimedDeliveryRow])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return &claimedDelivery{
+		ID:       collected.ID,
+		Attempts: collected.Attempts,
+		URL:      collected.URL,
+		Secret:   collected.Secret,
+		Event: &outbox.Event{
+			ID:        collected.EventID,
+			CreatedAt: collected.EventCreatedAt,
+			Kind:      app.EventKind(collected.EventKind),
+			UserID:    collected.EventUserID,
+			EntityID:  collected.EventEntityID,
+			Data:      collected.EventData,
+		},
+	}, nil
+}
+
+func updateDeliveryDelivered(ctx context.Context, db app.PgxExecutor, id uuid.UUID, attempts int) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'delivered', attempts = $2, last_error = '', delivered_at = now()
+		WHERE id = $1
+	`
+	args := []any{id, attempts}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func updateDeliveryFailed(ctx context.Context, db app.PgxExecutor, id uuid.UUID, attempts int, status DeliveryStatus, delay time.Duration, lastError string) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $3, attempts = $2, last_error = $5, next_attempt_at = now() + make_interval(secs => $4)
+		WHERE id = $1
+	`
+	args := []any{id, attempts, string(status), delay.Seconds(), lastError}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
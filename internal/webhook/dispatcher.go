@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/outbox"
+)
+
+// Dispatcher creates webhook deliveries for the outbox events.
+type Dispatcher struct {
+	db app.PgxExecutor
+}
+
+func NewDispatcher(db app.PgxExecutor) *Dispatcher {
+	return &Dispatcher{db: db}
+}
+
+// DispatchPending creates a delivery of every undispatched outbox event
+// for every active subscription to its kind and marks the event dispatched.
+// It returns the number of dispatched events.
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		dispatched, err := d.dispatchOne(ctx)
+		if err != nil {
+			return count, fmt.Errorf("webhook.Dispatcher: %w", err)
+		}
+		if !dispatched {
+			return count, nil
+		}
+		count++
+	}
+}
+
+func (d *Dispatcher) dispatchOne(ctx context.Context) (bool, error) {
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	e, err := getUndispatchedEventForUpdate(ctx, tx)
+	if err != nil {
+		if errors.Is(err, ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = createDeliveries(ctx, tx, e)
+	if err != nil {
+		return false, err
+	}
+
+	err = updateEventDispatchedAt(ctx, tx, e)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func getUndispatchedEventForUpdate(ctx context.Context, db app.PgxExecutor) (*outbox.Event, error) {
+	query := `
+		SELECT id, created_at, kind, user_id, entity_id, data, dispatched_at, handled_at, handle_attempts
+		FROM outbox_events
+		WHERE dispatched_at IS NULL
+		ORDER BY created_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	e, err := pgx.CollectExactlyOneRow(rows, outbox.RowToEvent)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// createDeliveries creates a pending delivery of the event for each active subscription to its kind.
+// Subscriptions created after the event get it too, the event is dispatched when the dispatcher gets to it.
+func createDeliveries(ctx context.Context, db app.PgxExecutor, e *outbox.Event) error {
+	query := `
+		INSERT INTO webhook_deliveries (subscription_id, event_id)
+		SELECT id, $1
+		FROM webhook_subscriptions
+		WHERE deleted_at IS NULL AND $2 = ANY(event_kinds)
+		ON CONFLICT (subscription_id, event_id) DO NOTHING
+	`
+	args := []any{e.ID, string(e.Kind)}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func updateEventDispatchedAt(ctx context.Context, db app.PgxExecutor, e *outbox.Event) error {
+	query := `
+		UPDATE outbox_events
+		SET dispatched_at = now()
+		WHERE id = $1
+	`
+	args := []any{e.ID}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
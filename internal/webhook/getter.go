@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetSubscriptions returns the active subscriptions, oldest first.
+func (g *Getter) GetSubscriptions(ctx context.Context) ([]*Subscription, error) {
+	subscriptions, err := getSubscriptions(ctx, g.db)
+	if err != nil {
+		return nil, fmt.Errorf("webhook.Getter: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// GetDeadLetters returns the most recent dead deliveries.
+func (g *Getter) GetDeadLetters(ctx context.Context, limit int) ([]*DeadLetter, error) {
+	deadLetters, err := getDeadLetters(ctx, g.db, limit)
+	if err != nil {
+		return nil, fmt.Errorf("webhook.Getter: %w", err)
+	}
+	return deadLetters, nil
+}
+
+func getSubscriptions(ctx context.Context, db app.PgxExecutor) ([]*Subscription, error) {
+	query := `
+		SELECT id, created_at, url, secret, event_kinds, created_by, deleted_at
+		FROM webhook_subscriptions
+		WHERE deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+
+	rows, _ := db.Query(ctx, query)
+	subscriptions, err := pgx.CollectRows(rows, RowToSubscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func getDeadLetters(ctx context.Context, db app.PgxExecutor, limit int) ([]*DeadLetter, error) {
+	query := `
+		SELECT id, created_at, subscription_id, url, event_id, event_kind, attempts, last_error, last_attempt_at
+		FROM webhook_dead_letters
+		ORDER BY last_attempt_at DESC, id
+		LIMIT $1
+	`
+	args := []any{limit}
+
+	rows, _ := db.Query(ctx, query, args...)
+	deadLetters, err := pgx.CollectRows(rows, RowToDeadLetter)
+	if err != nil {
+		return nil, err
+	}
+
+	return deadLetters, nil
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Retrier struct {
+	db app.PgxExecutor
+}
+
+func NewRetrier(db app.PgxExecutor) *Retrier {
+	return &Retrier{db: db}
+}
+
+// Retry makes the dead delivery pending again with its attempts reset.
+// The deliverer attempts it on its next run.
+func (r *Retrier) Retry(ctx context.Context, deliveryID uuid.UUID) error {
+	err := updateDeliveryRetried(ctx, r.db, deliveryID)
+	if err != nil {
+		return fmt.Errorf("webhook.Retrier: %w", err)
+	}
+	return nil
+}
+
+func updateDeliveryRetried(ctx context.Context, db app.PgxExecutor, id uuid.UUID) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'pending', attempts = 0, next_attempt_at = now()
+		WHERE id = $1 AND status = 'dead'
+		RETURNING id
+	`
+	args := []any{id}
+
+	rows, _ := db.Query(ctx, query, args...)
+	_, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotExist
+		}
+		return err
+	}
+
+	return nil
+}
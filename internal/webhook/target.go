@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// CheckTargetHost returns [ErrPrivateTarget] if the host resolves to an address that isn't public,
+// e.g. a loopback, private or link-local one, so subscriptions can't make the server call internal services.
+// The host may resolve differently when deliveries are made, [NewClient] checks the addresses again then.
+func CheckTargetHost(ctx context.Context, host string) error {
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("webhook.CheckTargetHost: %w", err)
+	}
+	for _, a := range addrs {
+		if !isPublicAddr(a) {
+			return fmt.Errorf("webhook.CheckTargetHost: %s: %w", a, ErrPrivateTarget)
+		}
+	}
+	return nil
+}
+
+// NewClient returns an HTTP client for deliveries that refuses to connect to addresses that aren't public.
+// The addresses are checked when connecting, after they are resolved,
+// so hosts that resolve to other addresses than when the subscription was made and redirects are covered too.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: checkDialAddr}
+	// The transport has no proxy, a proxy would be connected to instead of the target.
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: timeout,
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func checkDialAddr(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !isPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%s: %w", addrPort.Addr(), ErrPrivateTarget)
+	}
+	return nil
+}
+
+// isPublicAddr reports whether a is a public unicast address.
+// Loopback, private, link-local, multicast and unspecified addresses aren't.
+func isPublicAddr(a netip.Addr) bool {
+	a = a.Unmap()
+	return a.IsGlobalUnicast() && !a.IsPrivate()
+}
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestTarget(t *testing.T) {
+	t.Run("reports public addresses", func(t *testing.T) {
+		tests := []struct {
+			addr string
+			want bool
+		}{
+			{"93.184.215.14", true},
+			{"2606:2800:21f:cb07:6820:80da:af6b:8b2c", true},
+			{"127.0.0.1", false},
+			{"::1", false},
+			{"10.0.0.1", false},
+			{"172.16.0.1", false},
+			{"192.168.1.1", false},
+			{"fd00::1", false},
+			{"169.254.169.254", false},
+			{"fe80::1", false},
+			{"0.0.0.0", false},
+			{"::ffff:127.0.0.1", false},
+			{"224.0.0.1", false},
+		}
+		for _, tt := range tests {
+			if got, want := isPublicAddr(netip.MustParseAddr(tt.addr)), tt.want; got != want {
+				t.Errorf("got %t for %s, want %t", got, tt.addr, want)
+			}
+		}
+	})
+	t.Run("rejects hosts resolving to private addresses", func(t *testing.T) {
+		ctx := context.Background()
+
+		err := CheckTargetHost(ctx, "127.0.0.1")
+		if got, want := err, ErrPrivateTarget; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+		err = CheckTargetHost(ctx, "localhost")
+		if got, want := err, ErrPrivateTarget; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+	t.Run("doesn't connect to private addresses", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("got request to private address")
+		}))
+		defer receiver.Close()
+
+		_, err := NewClient(time.Second).Post(receiver.URL, "application/json", http.NoBody)
+		if got, want := err, ErrPrivateTarget; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+}
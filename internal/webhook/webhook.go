@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/achievement"
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/purchase"
+	"github.com/k11v/merch/internal/transfer"
+)
+
+var (
+	ErrNotExist      = errors.New("does not exist")
+	ErrInvalidValue  = errors.New("invalid value")
+	ErrPrivateTarget = errors.New("target is not a public address")
+)
+
+// Kinds are the event kinds written to the outbox, subscriptions can be made to them.
+var Kinds = []app.EventKind{
+	transfer.EventSent,
+	transfer.EventReceived,
+	purchase.EventBought,
+	purchase.EventGiftReceived,
+	achievement.EventBadgeAwarded,
+}
+
+// Subscription delivers events of its kinds to its URL.
+type Subscription struct {
+	ID         uuid.UUID
+	CreatedAt  time.Time
+	URL        string
+	Secret     string // key of the payload signature, see [Sign]
+	EventKinds []app.EventKind
+	CreatedBy  uuid.UUID
+	DeletedAt  *time.Time // nil while active
+}
+
+type SubscriptionRow struct {
+	ID         uuid.UUID  `db:"id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	URL        string     `db:"url"`
+	Secret     string     `db:"secret"`
+	EventKinds []string   `db:"event_kinds"`
+	CreatedBy  uuid.UUID  `db:"created_by"`
+	DeletedAt  *time.Time `db:"deleted_at"`
+}
+
+func RowToSubscription(collectable pgx.CollectableRow) (*Subscription, error) {
+	collected, err := pgx.RowToStructByName[SubscriptionRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	eventKinds := make([]app.EventKind, len(collected.EventKinds))
+	for i, k := range collected.EventKinds {
+		eventKinds[i] = app.EventKind(k)
+	}
+
+	return &Subscription{
+		ID:         collected.ID,
+		CreatedAt:  collected.CreatedAt,
+		URL:        collected.URL,
+		Secret:     collected.Secret,
+		EventKinds: eventKinds,
+		CreatedBy:  collected.CreatedBy,
+		DeletedAt:  collected.DeletedAt,
+	}, nil
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"   // waiting for the next attempt
+	DeliveryStatusDelivered DeliveryStatus = "delivered" // the receiver responded with a 2xx status
+	DeliveryStatusDead      DeliveryStatus = "dead"      // failed MaxAttempts times, waits for an admin to retry it
+)
+
+// DeadLetter is a dead delivery from the webhook_dead_letters view.
+type DeadLetter struct {
+	ID             uuid.UUID
+	CreatedAt      time.Time
+	SubscriptionID uuid.UUID
+	URL            string
+	EventID        uuid.UUID
+	EventKind      app.EventKind
+	Attempts       int
+	LastError      string
+	LastAttemptAt  time.Time
+}
+
+type DeadLetterRow struct {
+	ID             uuid.UUID `db:"id"`
+	CreatedAt      time.Time `db:"created_at"`
+	SubscriptionID uuid.UUID `db:"subscription_id"`
+	URL            string    `db:"url"`
+	EventID        uuid.UUID `db:"event_id"`
+	EventKind      string    `db:"event_kind"`
+	Attempts       int       `db:"attempts"`
+	LastError      string    `db:"last_error"`
+	LastAttemptAt  time.Time `db:"last_attempt_at"`
+}
+
+func RowToDeadLetter(collectable pgx.CollectableRow) (*DeadLetter, error) {
+	collected, err := pgx.RowToStructByName[DeadLetterRow](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeadLetter{
+		ID:             collected.ID,
+		CreatedAt:      collected.CreatedAt,
+		SubscriptionID: collected.SubscriptionID,
+		URL:            collected.URL,
+		EventID:        collected.EventID,
+		EventKind:      app.EventKind(collected.EventKind),
+		Attempts:       collected.Attempts,
+		LastError:      collected.LastError,
+		LastAttemptAt:  collected.LastAttemptAt,
+	}, nil
+}
+
+// Sign returns the hex HMAC-SHA256 of "timestamp.body" keyed with the secret.
+// Receivers compute it the same way to check the X-Merch-Signature header,
+// the timestamp is the X-Merch-Timestamp header and lets them reject replays.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,221 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/transfer"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestWebhook(t *testing.T) {
+	t.Run("signs timestamp and body", func(t *testing.T) {
+		timestamp := time.Unix(1700000000, 0)
+		body := []byte(`{"kind":"transfer.received"}`)
+
+		signature := Sign("secret", timestamp, body)
+		if got, want := len(signature), 64; got != want {
+			t.Errorf("got %d signature length, want %d", got, want)
+		}
+		if got, want := Sign("secret", timestamp, body), signature; got != want {
+			t.Errorf("got %s signature, want %s", got, want)
+		}
+		if got := Sign("other", timestamp, body); got == signature {
+			t.Errorf("got same signature for other secret")
+		}
+		if got := Sign("secret", timestamp.Add(time.Second), body); got == signature {
+			t.Errorf("got same signature for other timestamp")
+		}
+	})
+	t.Run("backs off exponentially up to a cap", func(t *testing.T) {
+		tests := []struct {
+			attempts int
+			want     time.Duration
+		}{
+			{1, 30 * time.Second},
+			{2, time.Minute},
+			{3, 2 * time.Minute},
+			{7, 32 * time.Minute},
+			{20, 6 * time.Hour},
+		}
+		for _, tt := range tests {
+			if got := backoff(tt.attempts); got != tt.want {
+				t.Errorf("got %s backoff after %d attempts, want %s", got, tt.attempts, tt.want)
+			}
+		}
+	})
+	t.Run("delivers signed transfer events to subscriptions", func(t *testing.T) {
+		var (
+			ctx   = context.Background()
+			db    = apptest.NewPostgresPool(t, ctx)
+			alice = usertest.CreateUser(t, ctx, db, "alice")
+			bob   = usertest.CreateUser(t, ctx, db, "bob")
+		)
+
+		var (
+			mu       sync.Mutex
+			requests []*http.Request
+			bodies   [][]byte
+		)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			requests = append(requests, r)
+			bodies = append(bodies, body)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		s, err := NewCreator(db).CreateSubscription(ctx, &CreatorCreateSubscriptionParams{
+			URL:        receiver.URL,
+			EventKinds: []app.EventKind{transfer.EventReceived},
+			CreatedBy:  alice.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		tr, err := transfer.NewTransferer(db).TransferByUsername(ctx, "bob", alice.ID, 10)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		dispatched, err := NewDispatcher(db).DispatchPending(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := dispatched, 2; got != want {
+			t.Errorf("got %d dispatched events, want %d", got, want)
+		}
+		delivered, err := NewDeliverer(db, receiver.Client()).DeliverDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := delivered, 1; got != want {
+			t.Fatalf("got %d deliveries, want %d", got, want)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		r, body := requests[0], bodies[0]
+		timestamp, err := strconv.ParseInt(r.Header.Get("X-Merch-Timestamp"), 10, 64)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		wantSignature := "sha256=" + Sign(s.Secret, time.Unix(timestamp, 0), body)
+		if got, want := r.Header.Get("X-Merch-Signature"), wantSignature; got != want {
+			t.Errorf("got %s signature, want %s", got, want)
+		}
+		var p Payload
+		err = json.Unmarshal(body, &p)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := p.Kind, transfer.EventReceived; got != want {
+			t.Errorf("got %s kind, want %s", got, want)
+		}
+		if got, want := p.UserID, bob.ID; got != want {
+			t.Errorf("got %s user ID, want %s", got, want)
+		}
+		if got, want := p.EntityID, tr.ID; got != want {
+			t.Errorf("got %s entity ID, want %s", got, want)
+		}
+
+		var status string
+		err = db.QueryRow(ctx, `SELECT status FROM webhook_deliveries WHERE event_id = $1`, p.ID).Scan(&status)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := DeliveryStatus(status), DeliveryStatusDelivered; got != want {
+			t.Errorf("got %s status, want %s", got, want)
+		}
+	})
+	t.Run("dead letters deliveries after max attempts and retries them", func(t *testing.T) {
+		var (
+			ctx   = context.Background()
+			db    = apptest.NewPostgresPool(t, ctx)
+			alice = usertest.CreateUser(t, ctx, db, "alice")
+			_     = usertest.CreateUser(t, ctx, db, "bob")
+			g     = NewGetter(db)
+		)
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer receiver.Close()
+		deliverer := NewDeliverer(db, receiver.Client())
+
+		_, err := NewCreator(db).CreateSubscription(ctx, &CreatorCreateSubscriptionParams{
+			URL:        receiver.URL,
+			EventKinds: []app.EventKind{transfer.EventSent},
+			CreatedBy:  alice.ID,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = transfer.NewTransferer(db).TransferByUsername(ctx, "bob", alice.ID, 10)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = NewDispatcher(db).DispatchPending(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = deliverer.DeliverDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		deadLetters, err := g.GetDeadLetters(ctx, 10)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(deadLetters), 0; got != want {
+			t.Fatalf("got %d dead letters after first attempt, want %d", got, want)
+		}
+
+		// Skip the backoff of the attempts in between.
+		_, err = db.Exec(ctx, `UPDATE webhook_deliveries SET attempts = $1, next_attempt_at = now()`, MaxAttempts-1)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = deliverer.DeliverDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		deadLetters, err = g.GetDeadLetters(ctx, 10)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(deadLetters), 1; got != want {
+			t.Fatalf("got %d dead letters, want %d", got, want)
+		}
+		if got, want := deadLetters[0].Attempts, MaxAttempts; got != want {
+			t.Errorf("got %d attempts, want %d", got, want)
+		}
+		if got, want := deadLetters[0].EventKind, transfer.EventSent; got != want {
+			t.Errorf("got %s event kind, want %s", got, want)
+		}
+
+		err = NewRetrier(db).Retry(ctx, deadLetters[0].ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		count, err := deliverer.DeliverDue(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 1; got != want {
+			t.Errorf("got %d deliveries after retry, want %d", got, want)
+		}
+	})
+}
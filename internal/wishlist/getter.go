@@ -0,0 +1,60 @@
+package wishlist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+)
+
+type Getter struct {
+	db app.PgxExecutor
+}
+
+func NewGetter(db app.PgxExecutor) *Getter {
+	return &Getter{db: db}
+}
+
+// GetEntriesByUserID returns the user's wishlist with current prices, oldest first.
+func (g *Getter) GetEntriesByUserID(ctx context.Context, userID uuid.UUID) ([]*Entry, error) {
+	entries, err := getEntriesByUserID(ctx, g.db, userID)
+	if err != nil {
+		return nil, fmt.Errorf("wishlist.Getter: %w", err)
+	}
+
+	for _, e := range entries {
+		e.Price, e.ListPrice, e.InStock, err = currentPrice(ctx, g.db, e.Item)
+		if err != nil {
+			return nil, fmt.Errorf("wishlist.Getter: %w", err)
+		}
+	}
+
+	return entries, nil
+}
+
+func getEntriesByUserID(ctx context.Context, db app.PgxExecutor, userID uuid.UUID) ([]*Entry, error) {
+	query := `
+		SELECT w.id, w.created_at, w.user_id, w.item_id, w.last_price, w.last_in_stock,
+			   i.name as item_name,
+			   i.price as item_price,
+			   i.category as item_category,
+			   i.description as item_description,
+			   i.image_key as item_image_key
+		FROM wishlist_items w
+		JOIN items i ON w.item_id = i.id
+		WHERE w.user_id = $1
+		ORDER BY w.created_at, w.id
+	`
+	args := []any{userID}
+
+	rows, _ := db.Query(ctx, query, args...)
+	entries, err := pgx.CollectRows(rows, RowToEntryWithItem)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,45 @@
+package wishlist
+
+import (
+	"context"
+	"time"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/campaign"
+	"github.com/k11v/merch/internal/item"
+)
+
+// currentPrice returns the price of the cheapest variant of the item in stock with campaigns applied,
+// the same price before campaigns and whether the item is in stock.
+// Items without variants are always in stock.
+// If no variant is in stock, the price of the cheapest variant is returned.
+func currentPrice(ctx context.Context, db app.PgxExecutor, i *item.Item) (price int, listPrice int, inStock bool, err error) {
+	variants, err := item.NewGetter(db).GetVariantsByItemID(ctx, i.ID)
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	listPrice = i.Price
+	inStock = len(variants) == 0
+	for j, v := range variants {
+		variantPrice := v.PriceOr(i.Price)
+		switch {
+		case j == 0:
+			listPrice = variantPrice
+			inStock = v.Stock > 0
+		case v.Stock > 0 && !inStock:
+			listPrice = variantPrice
+			inStock = true
+		case (v.Stock > 0) == inStock:
+			listPrice = min(listPrice, variantPrice)
+		}
+	}
+
+	campaigns, err := campaign.NewGetter(db).GetActiveCampaignsForItem(ctx, i.ID, i.Category, time.Now())
+	if err != nil {
+		return 0, 0, false, err
+	}
+	price, _ = campaign.BestPrice(campaigns, listPrice)
+
+	return price, listPrice, inStock, nil
+}
@@ -0,0 +1,106 @@
+package wishlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/item"
+)
+
+type Updater struct {
+	db app.PgxExecutor
+}
+
+func NewUpdater(db app.PgxExecutor) *Updater {
+	return &Updater{db: db}
+}
+
+// AddItemByName adds the item to the user's wishlist.
+// The current price and stock are remembered, so the user is only notified about later changes.
+func (u *Updater) AddItemByName(ctx context.Context, userID uuid.UUID, itemName string) (*Entry, error) {
+	i, err := item.NewGetter(u.db).GetItemByName(ctx, itemName)
+	if err != nil {
+		return nil, fmt.Errorf("wishlist.Updater: %w", err)
+	}
+
+	price, listPrice, inStock, err := currentPrice(ctx, u.db, i)
+	if err != nil {
+		return nil, fmt.Errorf("wishlist.Updater: %w", err)
+	}
+
+	e, err := createEntry(ctx, u.db, userID, i.ID, price, inStock)
+	if err != nil {
+		return nil, fmt.Errorf("wishlist.Updater: %w", err)
+	}
+	e.Item = i
+	e.Price = price
+	e.ListPrice = listPrice
+	e.InStock = inStock
+
+	return e, nil
+}
+
+// RemoveItemByName removes the item from the user's wishlist.
+func (u *Updater) RemoveItemByName(ctx context.Context, userID uuid.UUID, itemName string) error {
+	i, err := item.NewGetter(u.db).GetItemByName(ctx, itemName)
+	if err != nil {
+		return fmt.Errorf("wishlist.Updater: %w", err)
+	}
+
+	err = deleteEntry(ctx, u.db, userID, i.ID)
+	if err != nil {
+		return fmt.Errorf("wishlist.Updater: %w", err)
+	}
+
+	return nil
+}
+
+func createEntry(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, itemID uuid.UUID, lastPrice int, lastInStock bool) (*Entry, error) {
+	query := `
+		INSERT INTO wishlist_items (user_id, item_id, last_price, last_in_stock)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, user_id, item_id, last_price, last_in_stock
+	`
+	args := []any{userID, itemID, lastPrice, lastInStock}
+
+	rows, _ := db.Query(ctx, query, args...)
+	e, err := pgx.CollectExactlyOneRow(rows, RowToEntry)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && isConstraintPgError(pgErr, "wishlist_items_user_id_item_id_idx") {
+			return nil, ErrExist
+		}
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func deleteEntry(ctx context.Context, db app.PgxExecutor, userID uuid.UUID, itemID uuid.UUID) error {
+	query := `
+		DELETE FROM wishlist_items
+		WHERE user_id = $1 AND item_id = $2
+	`
+	args := []any{userID, itemID}
+
+	tag, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotExist
+	}
+
+	return nil
+}
+
+func isConstraintPgError(e *pgconn.PgError, constraint string) bool {
+	return pgerrcode.IsIntegrityConstraintViolation(e.Code) && e.ConstraintName == constraint
+}
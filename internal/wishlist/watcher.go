@@ -0,0 +1,141 @@
+package wishlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/app"
+	"github.com/k11v/merch/internal/notification"
+)
+
+// Watcher notifies users when wishlisted items get cheaper or are back in stock.
+type Watcher struct {
+	db app.PgxExecutor
+}
+
+func NewWatcher(db app.PgxExecutor) *Watcher {
+	return &Watcher{db: db}
+}
+
+// Watch compares current prices and stock with the ones last seen for each wishlist entry,
+// notifies users about price drops and restocks and returns the number of notifications.
+// Price rises and sellouts are remembered silently, so a later drop is noticed again.
+func (w *Watcher) Watch(ctx context.Context) (int, error) {
+	tx, err := w.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("wishlist.Watcher: %w", err)
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			slog.Error("didn't rollback", "err", rollbackErr)
+		}
+	}()
+
+	// Entries locked by another replica are skipped and watched by it.
+	entries, err := getEntriesForUpdate(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("wishlist.Watcher: %w", err)
+	}
+
+	type state struct {
+		price   int
+		inStock bool
+	}
+	states := make(map[uuid.UUID]state)
+	notificationCreator := notification.NewCreator(tx)
+	count := 0
+	for _, e := range entries {
+		s, ok := states[e.ItemID]
+		if !ok {
+			s.price, _, s.inStock, err = currentPrice(ctx, tx, e.Item)
+			if err != nil {
+				return 0, fmt.Errorf("wishlist.Watcher: %w", err)
+			}
+			states[e.ItemID] = s
+		}
+		if s.price == e.LastPrice && s.inStock == e.LastInStock {
+			continue
+		}
+
+		var params *notification.CreatorCreateNotificationParams
+		switch {
+		case s.inStock && !e.LastInStock:
+			params = &notification.CreatorCreateNotificationParams{
+				Kind:    notification.KindBackInStock,
+				Message: fmt.Sprintf("%s is back in stock for %d coins", e.Item.Name, s.price),
+			}
+		case s.inStock && s.price < e.LastPrice:
+			params = &notification.CreatorCreateNotificationParams{
+				Kind:    notification.KindPriceDrop,
+				Message: fmt.Sprintf("%s is now %d coins instead of %d", e.Item.Name, s.price, e.LastPrice),
+			}
+		}
+		if params != nil {
+			params.UserID = e.UserID
+			params.ItemID = &e.ItemID
+			_, err = notificationCreator.CreateNotification(ctx, params)
+			if err != nil {
+				return 0, fmt.Errorf("wishlist.Watcher: %w", err)
+			}
+			count++
+		}
+
+		err = updateEntryLastSeen(ctx, tx, e.ID, s.price, s.inStock)
+		if err != nil {
+			return 0, fmt.Errorf("wishlist.Watcher: %w", err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("wishlist.Watcher: %w", err)
+	}
+
+	return count, nil
+}
+
+func getEntriesForUpdate(ctx context.Context, db app.PgxExecutor) ([]*Entry, error) {
+	query := `
+		SELECT w.id, w.created_at, w.user_id, w.item_id, w.last_price, w.last_in_stock,
+			   i.name as item_name,
+			   i.price as item_price,
+			   i.category as item_category,
+			   i.description as item_description,
+			   i.image_key as item_image_key
+		FROM wishlist_items w
+		JOIN items i ON w.item_id = i.id
+		ORDER BY w.item_id, w.id
+		FOR UPDATE OF w SKIP LOCKED
+	`
+
+	rows, _ := db.Query(ctx, query)
+	entries, err := pgx.CollectRows(rows, RowToEntryWithItem)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func updateEntryLastSeen(ctx context.Context, db app.PgxExecutor, id uuid.UUID, lastPrice int, lastInStock bool) error {
+	query := `
+		UPDATE wishlist_items
+		SET last_price = $2,
+			last_in_stock = $3
+		WHERE id = $1
+	`
+	args := []any{id, lastPrice, lastInStock}
+
+	_, err := db.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package wishlist
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/k11v/merch/internal/item"
+)
+
+var (
+	ErrNotExist = errors.New("does not exist")
+	ErrExist    = errors.New("already exists")
+)
+
+// Entry is an item on a user's wishlist.
+type Entry struct {
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	UserID      uuid.UUID
+	ItemID      uuid.UUID
+	LastPrice   int  // price the user was last notified about
+	LastInStock bool // stock the user was last notified about
+
+	Item *item.Item
+
+	// Price is the current price of the cheapest variant in stock with campaigns applied.
+	// ListPrice is the same price before campaigns.
+	Price     int
+	ListPrice int
+	InStock   bool
+}
+
+// Missing returns how many more coins the user needs to afford the item.
+func (e *Entry) Missing(balance int) int {
+	return max(e.Price-balance, 0)
+}
+
+// Progress returns how close the user is to affording the item, in percent from 0 to 100.
+func (e *Entry) Progress(balance int) int {
+	if e.Price <= 0 || balance >= e.Price {
+		return 100
+	}
+	return max(balance, 0) * 100 / e.Price
+}
+
+type Row struct {
+	ID          uuid.UUID `db:"id"`
+	CreatedAt   time.Time `db:"created_at"`
+	UserID      uuid.UUID `db:"user_id"`
+	ItemID      uuid.UUID `db:"item_id"`
+	LastPrice   int       `db:"last_price"`
+	LastInStock bool      `db:"last_in_stock"`
+}
+
+func RowToEntry(collectable pgx.CollectableRow) (*Entry, error) {
+	collected, err := pgx.RowToStructByName[Row](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		UserID:      collected.UserID,
+		ItemID:      collected.ItemID,
+		LastPrice:   collected.LastPrice,
+		LastInStock: collected.LastInStock,
+	}, nil
+}
+
+type RowWithItem struct {
+	Row
+	ItemName        string `db:"item_name"`
+	ItemPrice       int    `db:"item_price"`
+	ItemCategory    string `db:"item_category"`
+	ItemDescription string `db:"item_description"`
+	ItemImageKey    string `db:"item_image_key"`
+}
+
+func RowToEntryWithItem(collectable pgx.CollectableRow) (*Entry, error) {
+	collected, err := pgx.RowToStructByName[RowWithItem](collectable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		ID:          collected.ID,
+		CreatedAt:   collected.CreatedAt,
+		UserID:      collected.UserID,
+		ItemID:      collected.ItemID,
+		LastPrice:   collected.LastPrice,
+		LastInStock: collected.LastInStock,
+		Item: &item.Item{
+			ID:          collected.ItemID,
+			Name:        collected.ItemName,
+			Price:       collected.ItemPrice,
+			Category:    collected.ItemCategory,
+			Description: collected.ItemDescription,
+			ImageKey:    collected.ItemImageKey,
+		},
+	}, nil
+}
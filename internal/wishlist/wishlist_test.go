@@ -0,0 +1,148 @@
+package wishlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/k11v/merch/internal/app/apptest"
+	"github.com/k11v/merch/internal/campaign"
+	"github.com/k11v/merch/internal/item"
+	"github.com/k11v/merch/internal/notification"
+	"github.com/k11v/merch/internal/user/usertest"
+)
+
+func TestWishlist(t *testing.T) {
+	t.Run("computes progress", func(t *testing.T) {
+		e := &Entry{Price: 500}
+
+		if got, want := e.Progress(200), 40; got != want {
+			t.Errorf("got %d progress, want %d", got, want)
+		}
+		if got, want := e.Missing(200), 300; got != want {
+			t.Errorf("got %d missing coins, want %d", got, want)
+		}
+		if got, want := e.Progress(1000), 100; got != want {
+			t.Errorf("got %d progress, want %d", got, want)
+		}
+		if got, want := e.Missing(1000), 0; got != want {
+			t.Errorf("got %d missing coins, want %d", got, want)
+		}
+	})
+
+	t.Run("notifies about price drops and restocks", func(t *testing.T) {
+		var (
+			ctx = context.Background()
+			db  = apptest.NewPostgresPool(t, ctx)
+			wu  = NewUpdater(db)
+			wg  = NewGetter(db)
+			ww  = NewWatcher(db)
+			ng  = notification.NewGetter(db)
+			ig  = item.NewGetter(db)
+			ivs = item.NewVariantSetter(db)
+			cc  = campaign.NewCreator(db)
+		)
+		alice := usertest.CreateUser(t, ctx, db, "alice")
+
+		hoody, err := ig.GetItemByName(ctx, "hoody")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		v, err := ivs.CreateVariant(ctx, &item.VariantSetterCreateVariantParams{
+			ItemID:   hoody.ID,
+			Selector: item.VariantSelector{Size: "L"},
+			Stock:    0,
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		_, err = wu.AddItemByName(ctx, alice.ID, "hoody")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = wu.AddItemByName(ctx, alice.ID, "pink-hoody")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = wu.AddItemByName(ctx, alice.ID, "hoody")
+		if got, want := err, ErrExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+
+		count, err := ww.Watch(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 0; got != want {
+			t.Fatalf("got %d notifications without changes, want %d", got, want)
+		}
+
+		_, err = ivs.UpdateVariant(ctx, hoody.ID, v.ID, 5, nil)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		_, err = cc.CreateCampaign(ctx, &campaign.CreatorCreateCampaignParams{
+			Name:     "pink friday",
+			Kind:     campaign.KindFixed,
+			Value:    100,
+			ItemName: "pink-hoody",
+			StartsAt: time.Now().Add(-time.Minute),
+			EndsAt:   time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+
+		count, err = ww.Watch(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 2; got != want {
+			t.Fatalf("got %d notifications, want %d", got, want)
+		}
+		count, err = ww.Watch(ctx)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := count, 0; got != want {
+			t.Fatalf("got %d repeated notifications, want %d", got, want)
+		}
+
+		notifications, err := ng.GetNotificationsByUserID(ctx, alice.ID, true)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		gotKinds := make(map[string]notification.Kind)
+		for _, n := range notifications {
+			gotKinds[n.ItemName] = n.Kind
+		}
+		if got, want := gotKinds["hoody"], notification.KindBackInStock; got != want {
+			t.Errorf("got %s hoody notification, want %s", got, want)
+		}
+		if got, want := gotKinds["pink-hoody"], notification.KindPriceDrop; got != want {
+			t.Errorf("got %s pink-hoody notification, want %s", got, want)
+		}
+
+		entries, err := wg.GetEntriesByUserID(ctx, alice.ID)
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		if got, want := len(entries), 2; got != want {
+			t.Fatalf("got %d entries, want %d", got, want)
+		}
+		if got, want := entries[1].Price, entries[1].ListPrice-100; got != want {
+			t.Errorf("got %d pink-hoody price, want %d", got, want)
+		}
+
+		err = wu.RemoveItemByName(ctx, alice.ID, "hoody")
+		if err != nil {
+			t.Fatalf("got %v error", err)
+		}
+		err = wu.RemoveItemByName(ctx, alice.ID, "hoody")
+		if got, want := err, ErrNotExist; !errors.Is(got, want) {
+			t.Fatalf("got %v error, want %v", got, want)
+		}
+	})
+}
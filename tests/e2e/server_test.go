@@ -35,7 +35,7 @@ func TestServer(t *testing.T) {
 		token := *authResp.JSON200.Token
 
 		// Buy an item.
-		buyResp, err := client.GetAPIBuyItemWithResponse(ctx, "t-shirt", authorization(token))
+		buyResp, err := client.GetAPIBuyItemWithResponse(ctx, "t-shirt", &merch.GetAPIBuyItemParams{}, authorization(token))
 		if err != nil {
 			t.Fatalf("GetAPIBuyItemWithResponse: %v", err)
 		}
@@ -141,18 +141,34 @@ func TestServer(t *testing.T) {
 		coinHistory2 := *info2Resp.JSON200.CoinHistory
 
 		type sentCoinHistoryItem = struct {
-			Amount *int    `json:"amount,omitempty"`
-			ToUser *string `json:"toUser,omitempty"`
+			Amount        *int                  `json:"amount,omitempty"`
+			FromBudget    *bool                 `json:"fromBudget,omitempty"`
+			FromTeam      *string               `json:"fromTeam,omitempty"`
+			Message       *string               `json:"message,omitempty"`
+			Status        *merch.TransferStatus `json:"status,omitempty"`
+			ToDisplayName *string               `json:"toDisplayName,omitempty"`
+			ToUser        *string               `json:"toUser,omitempty"`
 		}
 		type receivedCoinHistoryItem = struct {
-			Amount   *int    `json:"amount,omitempty"`
-			FromUser *string `json:"fromUser,omitempty"`
+			Amount          *int                  `json:"amount,omitempty"`
+			Badge           *string               `json:"badge,omitempty"`
+			FromBudget      *bool                 `json:"fromBudget,omitempty"`
+			FromDisplayName *string               `json:"fromDisplayName,omitempty"`
+			FromTeam        *string               `json:"fromTeam,omitempty"`
+			FromUser        *string               `json:"fromUser,omitempty"`
+			Message         *string               `json:"message,omitempty"`
+			Status          *merch.TransferStatus `json:"status,omitempty"`
 		}
 		var gotSentCoinHistory1 []sentCoinHistoryItem = *coinHistory1.Sent
 		var gotReceivedCoinHistory2 []receivedCoinHistoryItem = *coinHistory2.Received
 
-		wantSentCoinHistory1 := []sentCoinHistoryItem{{Amount: newInt(15), ToUser: newString("testuser2")}}
-		wantReceivedCoinHistory2 := []receivedCoinHistoryItem{{Amount: newInt(15), FromUser: newString("testuser1")}}
+		completed := merch.TransferStatusCompleted
+		wantSentCoinHistory1 := []sentCoinHistoryItem{
+			{Amount: newInt(15), Status: &completed, ToUser: newString("testuser2")},
+		}
+		wantReceivedCoinHistory2 := []receivedCoinHistoryItem{
+			{Amount: newInt(15), FromUser: newString("testuser1"), Status: &completed},
+		}
 
 		if got, want := coins1, 985; got != want {
 			t.Fatalf("got %+v coins, want %+v", got, want)
@@ -167,6 +183,75 @@ func TestServer(t *testing.T) {
 			t.Fatalf("got %+v received coin history, want %+v", got, want)
 		}
 	})
+
+	t.Run("allows to edit own profile and view it as a colleague", func(t *testing.T) {
+		var (
+			ctx    = context.TODO()
+			client = newTestClient(t)
+		)
+
+		// Authenticate as user 1.
+		auth1Resp, err := client.PostAPIAuthWithResponse(ctx, merch.PostAPIAuthJSONRequestBody{
+			Username: "profileuser1",
+			Password: "profilepassword1",
+		})
+		if err != nil {
+			t.Fatalf("PostAPIAuthWithResponse: %v", err)
+		}
+		if auth1Resp.JSON200 == nil {
+			t.Fatalf("PostAPIAuthWithResponse: body is not JSON200")
+		}
+		token1 := *auth1Resp.JSON200.Token
+
+		// Authenticate as user 2.
+		auth2Resp, err := client.PostAPIAuthWithResponse(ctx, merch.PostAPIAuthJSONRequestBody{
+			Username: "profileuser2",
+			Password: "profilepassword2",
+		})
+		if err != nil {
+			t.Fatalf("PostAPIAuthWithResponse: %v", err)
+		}
+		if auth2Resp.JSON200 == nil {
+			t.Fatalf("PostAPIAuthWithResponse: body is not JSON200")
+		}
+		token2 := *auth2Resp.JSON200.Token
+
+		// Edit the profile of user 1.
+		putResp, err := client.PutAPIProfileWithResponse(
+			ctx,
+			merch.PutAPIProfileJSONRequestBody{
+				DisplayName: newString("Profile User"),
+				Department:  newString("Engineering"),
+			},
+			authorization(token1),
+		)
+		if err != nil {
+			t.Fatalf("PutAPIProfileWithResponse: %v", err)
+		}
+		if putResp.JSON200 == nil {
+			t.Fatalf("PutAPIProfileWithResponse: body is not JSON200")
+		}
+
+		// Check that user 2 sees the profile of user 1.
+		getResp, err := client.GetAPIUsersUsernameWithResponse(ctx, "profileuser1", authorization(token2))
+		if err != nil {
+			t.Fatalf("GetAPIUsersUsernameWithResponse: %v", err)
+		}
+		if getResp.JSON200 == nil {
+			t.Fatalf("GetAPIUsersUsernameWithResponse: body is not JSON200")
+		}
+		gotProfile := *getResp.JSON200
+
+		wantProfile := merch.Profile{
+			Username:    newString("profileuser1"),
+			DisplayName: newString("Profile User"),
+			Department:  newString("Engineering"),
+		}
+
+		if got, want := gotProfile, wantProfile; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %+v profile, want %+v", got, want)
+		}
+	})
 }
 
 func newTestClient(tb testing.TB) *merch.ClientWithResponses {